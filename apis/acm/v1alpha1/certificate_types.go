@@ -20,6 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -79,8 +81,9 @@ type CertificateExternalStatus struct {
 
 // An CertificateStatus represents the observed state of an Certificate manager.
 type CertificateStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CertificateExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CertificateExternalStatus `json:"atProvider,omitempty"`
 }
 
 // ResourceRecord Contains a DNS record value that you can use to validate ownership or control of a domain.
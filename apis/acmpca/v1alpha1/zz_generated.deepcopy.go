@@ -272,6 +272,7 @@ func (in *CertificateAuthorityPermissionSpec) DeepCopy() *CertificateAuthorityPe
 func (in *CertificateAuthorityPermissionStatus) DeepCopyInto(out *CertificateAuthorityPermissionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateAuthorityPermissionStatus.
@@ -305,6 +306,7 @@ func (in *CertificateAuthoritySpec) DeepCopy() *CertificateAuthoritySpec {
 func (in *CertificateAuthorityStatus) DeepCopyInto(out *CertificateAuthorityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
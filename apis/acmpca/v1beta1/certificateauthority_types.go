@@ -20,6 +20,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/acmpca/types"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CertificateAuthorityParameters defines the desired state of an AWS CertificateAuthority.
@@ -185,8 +187,9 @@ type CertificateAuthoritySpec struct {
 
 // An CertificateAuthorityStatus represents the observed state of an CertificateAuthority manager.
 type CertificateAuthorityStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CertificateAuthorityExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CertificateAuthorityExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CertificateAuthorityPermissionSpec defines the desired state of CertificateAuthorityPermission
@@ -29,7 +31,8 @@ type CertificateAuthorityPermissionSpec struct {
 
 // An CertificateAuthorityPermissionStatus represents the observed state of an Certificate Authority Permission manager.
 type CertificateAuthorityPermissionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
 }
 
 // CertificateAuthorityPermissionParameters defines the desired state of an AWS CertificateAuthority.
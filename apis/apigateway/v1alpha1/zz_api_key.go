@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // APIKeyParameters defines the desired state of APIKey
@@ -71,8 +73,9 @@ type APIKeyObservation struct {
 
 // APIKeyStatus defines the observed state of APIKey.
 type APIKeyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          APIKeyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  APIKeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AuthorizerParameters defines the desired state of Authorizer
@@ -109,8 +111,9 @@ type AuthorizerObservation struct {
 
 // AuthorizerStatus defines the observed state of Authorizer.
 type AuthorizerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AuthorizerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AuthorizerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // BasePathMappingParameters defines the desired state of BasePathMapping
@@ -58,8 +60,9 @@ type BasePathMappingObservation struct {
 
 // BasePathMappingStatus defines the observed state of BasePathMapping.
 type BasePathMappingStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BasePathMappingObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  BasePathMappingObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
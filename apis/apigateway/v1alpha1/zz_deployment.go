@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DeploymentParameters defines the desired state of Deployment
@@ -71,8 +73,9 @@ type DeploymentObservation struct {
 
 // DeploymentStatus defines the observed state of Deployment.
 type DeploymentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DeploymentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DeploymentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
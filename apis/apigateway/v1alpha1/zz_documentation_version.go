@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DocumentationVersionParameters defines the desired state of DocumentationVersion
@@ -55,8 +57,9 @@ type DocumentationVersionObservation struct {
 
 // DocumentationVersionStatus defines the observed state of DocumentationVersion.
 type DocumentationVersionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DocumentationVersionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DocumentationVersionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DomainNameParameters defines the desired state of DomainName
@@ -122,8 +124,9 @@ type DomainNameObservation struct {
 
 // DomainNameStatus defines the observed state of DomainName.
 type DomainNameStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DomainNameObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DomainNameObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -206,6 +206,7 @@ func (in *APIKeySpec) DeepCopy() *APIKeySpec {
 func (in *APIKeyStatus) DeepCopyInto(out *APIKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -530,6 +531,7 @@ func (in *AuthorizerSpec) DeepCopy() *AuthorizerSpec {
 func (in *AuthorizerStatus) DeepCopyInto(out *AuthorizerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -745,6 +747,7 @@ func (in *BasePathMappingSpec) DeepCopy() *BasePathMappingSpec {
 func (in *BasePathMappingStatus) DeepCopyInto(out *BasePathMappingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1834,6 +1837,7 @@ func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
 func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2067,6 +2071,7 @@ func (in *DocumentationPartSpec) DeepCopy() *DocumentationPartSpec {
 func (in *DocumentationPartStatus) DeepCopyInto(out *DocumentationPartStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2245,6 +2250,7 @@ func (in *DocumentationVersionSpec) DeepCopy() *DocumentationVersionSpec {
 func (in *DocumentationVersionStatus) DeepCopyInto(out *DocumentationVersionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2507,6 +2513,7 @@ func (in *DomainNameSpec) DeepCopy() *DomainNameSpec {
 func (in *DomainNameStatus) DeepCopyInto(out *DomainNameStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2822,6 +2829,7 @@ func (in *GatewayResponseSpec) DeepCopy() *GatewayResponseSpec {
 func (in *GatewayResponseStatus) DeepCopyInto(out *GatewayResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3187,6 +3195,7 @@ func (in *IntegrationResponseSpec) DeepCopy() *IntegrationResponseSpec {
 func (in *IntegrationResponseStatus) DeepCopyInto(out *IntegrationResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3221,6 +3230,7 @@ func (in *IntegrationSpec) DeepCopy() *IntegrationSpec {
 func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3546,6 +3556,7 @@ func (in *MethodResponseSpec) DeepCopy() *MethodResponseSpec {
 func (in *MethodResponseStatus) DeepCopyInto(out *MethodResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3670,6 +3681,7 @@ func (in *MethodSpec) DeepCopy() *MethodSpec {
 func (in *MethodStatus) DeepCopyInto(out *MethodStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3819,6 +3831,7 @@ func (in *ModelSpec) DeepCopy() *ModelSpec {
 func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4129,6 +4142,7 @@ func (in *RequestValidatorSpec) DeepCopy() *RequestValidatorSpec {
 func (in *RequestValidatorStatus) DeepCopyInto(out *RequestValidatorStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4273,6 +4287,7 @@ func (in *ResourceSpec) DeepCopy() *ResourceSpec {
 func (in *ResourceStatus) DeepCopyInto(out *ResourceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4523,6 +4538,7 @@ func (in *RestAPISpec) DeepCopy() *RestAPISpec {
 func (in *RestAPIStatus) DeepCopyInto(out *RestAPIStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4926,6 +4942,7 @@ func (in *StageSpec) DeepCopy() *StageSpec {
 func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -5441,6 +5458,7 @@ func (in *UsagePlanKeySpec) DeepCopy() *UsagePlanKeySpec {
 func (in *UsagePlanKeyStatus) DeepCopyInto(out *UsagePlanKeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -5629,6 +5647,7 @@ func (in *UsagePlanSpec) DeepCopy() *UsagePlanSpec {
 func (in *UsagePlanStatus) DeepCopyInto(out *UsagePlanStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -5875,6 +5894,7 @@ func (in *VPCLinkSpec) DeepCopy() *VPCLinkSpec {
 func (in *VPCLinkStatus) DeepCopyInto(out *VPCLinkStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
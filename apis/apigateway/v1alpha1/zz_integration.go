@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // IntegrationParameters defines the desired state of Integration
@@ -141,8 +143,9 @@ type IntegrationObservation struct {
 
 // IntegrationStatus defines the observed state of Integration.
 type IntegrationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IntegrationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IntegrationObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
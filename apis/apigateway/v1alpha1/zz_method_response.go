@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // MethodResponseParameters defines the desired state of MethodResponse
@@ -66,8 +68,9 @@ type MethodResponseObservation struct {
 
 // MethodResponseStatus defines the observed state of MethodResponse.
 type MethodResponseStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          MethodResponseObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  MethodResponseObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // RequestValidatorParameters defines the desired state of RequestValidator
@@ -54,8 +56,9 @@ type RequestValidatorObservation struct {
 
 // RequestValidatorStatus defines the observed state of RequestValidator.
 type RequestValidatorStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RequestValidatorObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RequestValidatorObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
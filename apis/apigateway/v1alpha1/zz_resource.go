@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResourceParameters defines the desired state of Resource
@@ -53,8 +55,9 @@ type ResourceObservation struct {
 
 // ResourceStatus defines the observed state of Resource.
 type ResourceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResourceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResourceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
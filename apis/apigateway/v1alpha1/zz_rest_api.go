@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // RestAPIParameters defines the desired state of RestAPI
@@ -92,8 +94,9 @@ type RestAPIObservation struct {
 
 // RestAPIStatus defines the observed state of RestAPI.
 type RestAPIStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RestAPIObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RestAPIObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // UsagePlanParameters defines the desired state of UsagePlan
@@ -64,8 +66,9 @@ type UsagePlanObservation struct {
 
 // UsagePlanStatus defines the observed state of UsagePlan.
 type UsagePlanStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UsagePlanObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  UsagePlanObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
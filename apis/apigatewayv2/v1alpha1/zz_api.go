@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // APIParameters defines the desired state of API
@@ -83,8 +85,9 @@ type APIObservation struct {
 
 // APIStatus defines the observed state of API.
 type APIStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          APIObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  APIObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
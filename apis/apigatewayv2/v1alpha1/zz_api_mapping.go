@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // APIMappingParameters defines the desired state of APIMapping
@@ -51,8 +53,9 @@ type APIMappingObservation struct {
 
 // APIMappingStatus defines the observed state of APIMapping.
 type APIMappingStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          APIMappingObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  APIMappingObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -216,6 +216,7 @@ func (in *APIMappingSpec) DeepCopy() *APIMappingSpec {
 func (in *APIMappingStatus) DeepCopyInto(out *APIMappingStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -432,6 +433,7 @@ func (in *APISpec) DeepCopy() *APISpec {
 func (in *APIStatus) DeepCopyInto(out *APIStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -758,6 +760,7 @@ func (in *AuthorizerSpec) DeepCopy() *AuthorizerSpec {
 func (in *AuthorizerStatus) DeepCopyInto(out *AuthorizerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1512,6 +1515,7 @@ func (in *DeploymentSpec) DeepCopy() *DeploymentSpec {
 func (in *DeploymentStatus) DeepCopyInto(out *DeploymentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1785,6 +1789,7 @@ func (in *DomainNameSpec) DeepCopy() *DomainNameSpec {
 func (in *DomainNameStatus) DeepCopyInto(out *DomainNameStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2235,6 +2240,7 @@ func (in *IntegrationResponseSpec) DeepCopy() *IntegrationResponseSpec {
 func (in *IntegrationResponseStatus) DeepCopyInto(out *IntegrationResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2334,6 +2340,7 @@ func (in *IntegrationSpec) DeepCopy() *IntegrationSpec {
 func (in *IntegrationStatus) DeepCopyInto(out *IntegrationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2669,6 +2676,7 @@ func (in *ModelSpec) DeepCopy() *ModelSpec {
 func (in *ModelStatus) DeepCopyInto(out *ModelStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3186,6 +3194,7 @@ func (in *RouteResponseSpec) DeepCopy() *RouteResponseSpec {
 func (in *RouteResponseStatus) DeepCopyInto(out *RouteResponseStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3320,6 +3329,7 @@ func (in *RouteSpec) DeepCopy() *RouteSpec {
 func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3648,6 +3658,7 @@ func (in *StageSpec) DeepCopy() *StageSpec {
 func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3991,6 +4002,7 @@ func (in *VPCLinkSpec) DeepCopy() *VPCLinkSpec {
 func (in *VPCLinkStatus) DeepCopyInto(out *VPCLinkStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ModelParameters defines the desired state of Model
@@ -55,8 +57,9 @@ type ModelObservation struct {
 
 // ModelStatus defines the observed state of Model.
 type ModelStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ModelObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ModelObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
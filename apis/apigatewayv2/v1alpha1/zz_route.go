@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // RouteParameters defines the desired state of Route
@@ -70,8 +72,9 @@ type RouteObservation struct {
 
 // RouteStatus defines the observed state of Route.
 type RouteStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RouteObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // StageParameters defines the desired state of Stage
@@ -71,8 +73,9 @@ type StageObservation struct {
 
 // StageStatus defines the observed state of Stage.
 type StageStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          StageObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  StageObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -1468,6 +1468,7 @@ func (in *VPCLinkSpec) DeepCopy() *VPCLinkSpec {
 func (in *VPCLinkStatus) DeepCopyInto(out *VPCLinkStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
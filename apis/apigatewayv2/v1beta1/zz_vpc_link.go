@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // VPCLinkParameters defines the desired state of VPCLink
@@ -29,45 +31,44 @@ type VPCLinkParameters struct {
 	// Region is which region the VPCLink will be created.
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
-	
-	 // +kubebuilder:validation:Required
+
+	// +kubebuilder:validation:Required
 	Name *string `json:"name"`
-	
-	 Tags map[string]*string `json:"tags,omitempty"` 
+
+	Tags                    map[string]*string `json:"tags,omitempty"`
 	CustomVPCLinkParameters `json:",inline"`
 }
 
 // VPCLinkSpec defines the desired state of VPCLink
 type VPCLinkSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	ForProvider VPCLinkParameters `json:"forProvider"`
+	ForProvider       VPCLinkParameters `json:"forProvider"`
 }
 
 // VPCLinkObservation defines the observed state of VPCLink
 type VPCLinkObservation struct {
-	
 	CreatedDate *metav1.Time `json:"createdDate,omitempty"`
-	
+
 	SecurityGroupIDs []*string `json:"securityGroupIDs,omitempty"`
-	
+
 	SubnetIDs []*string `json:"subnetIDs,omitempty"`
-	
+
 	VPCLinkID *string `json:"vpcLinkID,omitempty"`
-	
+
 	VPCLinkStatus *string `json:"vpcLinkStatus,omitempty"`
-	
+
 	VPCLinkStatusMessage *string `json:"vpcLinkStatusMessage,omitempty"`
-	
+
 	VPCLinkVersion *string `json:"vpcLinkVersion,omitempty"`
 }
 
 // VPCLinkStatus defines the observed state of VPCLink.
 type VPCLinkStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider VPCLinkObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  VPCLinkObservation `json:"atProvider,omitempty"`
 }
 
-
 // +kubebuilder:object:root=true
 
 // VPCLink is the Schema for the VPCLinks API
@@ -80,8 +81,8 @@ type VPCLinkStatus struct {
 type VPCLink struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec   VPCLinkSpec   `json:"spec"`
-	Status VPCLinkStatus `json:"status,omitempty"`
+	Spec              VPCLinkSpec   `json:"spec"`
+	Status            VPCLinkStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -90,7 +91,7 @@ type VPCLink struct {
 type VPCLinkList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items []VPCLink `json:"items"`
+	Items           []VPCLink `json:"items"`
 }
 
 // Repository type metadata.
@@ -104,4 +105,3 @@ var (
 func init() {
 	SchemeBuilder.Register(&VPCLink{}, &VPCLinkList{})
 }
-
@@ -653,6 +653,7 @@ func (in *WorkGroupSpec) DeepCopy() *WorkGroupSpec {
 func (in *WorkGroupStatus) DeepCopyInto(out *WorkGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // WorkGroupParameters defines the desired state of WorkGroup
@@ -56,8 +58,9 @@ type WorkGroupObservation struct {
 
 // WorkGroupStatus defines the observed state of WorkGroup.
 type WorkGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          WorkGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  WorkGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
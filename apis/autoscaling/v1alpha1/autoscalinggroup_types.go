@@ -0,0 +1,372 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// Tag is a key-value pair attached to an AutoScalingGroup.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+
+	// PropagateAtLaunch determines whether the tag is added to the instances
+	// launched by the group.
+	// +optional
+	PropagateAtLaunch *bool `json:"propagateAtLaunch,omitempty"`
+}
+
+// LaunchTemplateSpecification identifies the launch template and the version
+// of it used to launch the instances of an AutoScalingGroup. Either
+// LaunchTemplateID or LaunchTemplateName must be given.
+type LaunchTemplateSpecification struct {
+	// LaunchTemplateID is the ID of the launch template.
+	// +optional
+	LaunchTemplateID *string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateName is the name of the launch template.
+	// +optional
+	LaunchTemplateName *string `json:"launchTemplateName,omitempty"`
+
+	// LaunchTemplateNameRef is a reference to an ec2/v1alpha1.LaunchTemplate
+	// used to set the LaunchTemplateName.
+	// +optional
+	LaunchTemplateNameRef *xpv1.Reference `json:"launchTemplateNameRef,omitempty"`
+
+	// LaunchTemplateNameSelector selects references to an
+	// ec2/v1alpha1.LaunchTemplate used to set the LaunchTemplateName.
+	// +optional
+	LaunchTemplateNameSelector *xpv1.Selector `json:"launchTemplateNameSelector,omitempty"`
+
+	// Version of the launch template. It can be a version number, $Latest
+	// or $Default. Defaults to $Default.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// VersionRef is a reference to an ec2/v1alpha1.LaunchTemplateVersion used
+	// to set the Version.
+	// +optional
+	VersionRef *xpv1.Reference `json:"versionRef,omitempty"`
+
+	// VersionSelector selects references to an
+	// ec2/v1alpha1.LaunchTemplateVersion used to set the Version.
+	// +optional
+	VersionSelector *xpv1.Selector `json:"versionSelector,omitempty"`
+}
+
+// InstanceRefresh configures the instance refresh that replaces the instances
+// of an AutoScalingGroup when its launch template changes.
+type InstanceRefresh struct {
+	// MinHealthyPercentage is the percentage of the desired capacity that
+	// must remain in service during the refresh. Defaults to 90.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MinHealthyPercentage *int64 `json:"minHealthyPercentage,omitempty"`
+
+	// InstanceWarmup is the number of seconds until a new instance is
+	// considered to have finished initializing. Defaults to the health check
+	// grace period of the group.
+	// +optional
+	InstanceWarmup *int64 `json:"instanceWarmup,omitempty"`
+
+	// CheckpointPercentages are the percentages of replaced instances at
+	// which the refresh waits for CheckpointDelay seconds. The last value
+	// must be 100.
+	// +optional
+	CheckpointPercentages []int64 `json:"checkpointPercentages,omitempty"`
+
+	// CheckpointDelay is the number of seconds to wait after a checkpoint is
+	// reached.
+	// +optional
+	CheckpointDelay *int64 `json:"checkpointDelay,omitempty"`
+
+	// SkipMatching skips replacing instances that already run the desired
+	// launch template version.
+	// +optional
+	SkipMatching *bool `json:"skipMatching,omitempty"`
+}
+
+// AutoScalingGroupParameters define the desired state of an Amazon EC2 Auto
+// Scaling group. The name of the group is taken from the
+// crossplane.io/external-name annotation, which defaults to the name of the
+// resource.
+type AutoScalingGroupParameters struct {
+	// Region is the region you'd like your AutoScalingGroup to be created in.
+	Region string `json:"region"`
+
+	// LaunchTemplate used to launch the instances of the group.
+	LaunchTemplate LaunchTemplateSpecification `json:"launchTemplate"`
+
+	// MinSize is the minimum number of instances in the group.
+	// +kubebuilder:validation:Minimum=0
+	MinSize int64 `json:"minSize"`
+
+	// MaxSize is the maximum number of instances in the group.
+	// +kubebuilder:validation:Minimum=0
+	MaxSize int64 `json:"maxSize"`
+
+	// DesiredCapacity is the number of instances the group should run.
+	// Leave it empty if the capacity is managed by scaling policies,
+	// otherwise the capacity they set is reverted. Defaults to MinSize when
+	// the group is created.
+	// +optional
+	DesiredCapacity *int64 `json:"desiredCapacity,omitempty"`
+
+	// AvailabilityZones the instances are launched in. Only needed if no
+	// subnets are given.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// SubnetIDs of the subnets the instances are launched in.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs are references to ec2/v1beta1.Subnet used to set the
+	// SubnetIDs.
+	// +optional
+	SubnetIDRefs []xpv1.Reference `json:"subnetIdRefs,omitempty"`
+
+	// SubnetIDSelector selects references to ec2/v1beta1.Subnet used to set
+	// the SubnetIDs.
+	// +optional
+	SubnetIDSelector *xpv1.Selector `json:"subnetIdSelector,omitempty"`
+
+	// TargetGroupARNs are the ARNs of the Elastic Load Balancing target
+	// groups the instances are registered with.
+	// +optional
+	TargetGroupARNs []string `json:"targetGroupArns,omitempty"`
+
+	// TargetGroupARNRefs are references to elbv2/v1alpha1.TargetGroup used
+	// to set the TargetGroupARNs.
+	// +optional
+	TargetGroupARNRefs []xpv1.Reference `json:"targetGroupArnRefs,omitempty"`
+
+	// TargetGroupARNSelector selects references to elbv2/v1alpha1.TargetGroup
+	// used to set the TargetGroupARNs.
+	// +optional
+	TargetGroupARNSelector *xpv1.Selector `json:"targetGroupArnSelector,omitempty"`
+
+	// HealthCheckType determines whether the health of the instances is
+	// checked by EC2 only or also by the target groups. Defaults to EC2.
+	// +kubebuilder:validation:Enum=EC2;ELB
+	// +optional
+	HealthCheckType *string `json:"healthCheckType,omitempty"`
+
+	// HealthCheckGracePeriod is the number of seconds before the health of a
+	// new instance is checked.
+	// +optional
+	HealthCheckGracePeriod *int64 `json:"healthCheckGracePeriod,omitempty"`
+
+	// DefaultCooldown is the number of seconds after a scaling activity
+	// before another one can start. Defaults to 300.
+	// +optional
+	DefaultCooldown *int64 `json:"defaultCooldown,omitempty"`
+
+	// DefaultInstanceWarmup is the number of seconds until a new instance is
+	// considered to have finished initializing.
+	// +optional
+	DefaultInstanceWarmup *int64 `json:"defaultInstanceWarmup,omitempty"`
+
+	// TerminationPolicies determine which instances are terminated first
+	// when the group scales in, e.g. OldestLaunchTemplate or
+	// OldestInstance. Defaults to Default.
+	// +optional
+	TerminationPolicies []string `json:"terminationPolicies,omitempty"`
+
+	// NewInstancesProtectedFromScaleIn protects new instances from being
+	// terminated when the group scales in.
+	// +optional
+	NewInstancesProtectedFromScaleIn *bool `json:"newInstancesProtectedFromScaleIn,omitempty"`
+
+	// CapacityRebalance replaces Spot Instances at an elevated risk of
+	// interruption.
+	// +optional
+	CapacityRebalance *bool `json:"capacityRebalance,omitempty"`
+
+	// MaxInstanceLifetime is the maximum number of seconds an instance can
+	// be in service. It must be 0 or at least 86400.
+	// +optional
+	MaxInstanceLifetime *int64 `json:"maxInstanceLifetime,omitempty"`
+
+	// PlacementGroup the instances are launched in.
+	// +optional
+	PlacementGroup *string `json:"placementGroup,omitempty"`
+
+	// ServiceLinkedRoleARN is the ARN of the role the group uses to call
+	// other AWS services. Defaults to AWSServiceRoleForAutoScaling.
+	// +optional
+	ServiceLinkedRoleARN *string `json:"serviceLinkedRoleArn,omitempty"`
+
+	// InstanceRefresh enables replacing the instances of the group with an
+	// instance refresh when the launch template or its version changes. If
+	// not given, only new instances use the new launch template. The group
+	// keeps its previous launch template until the refresh succeeds. A failed
+	// or cancelled refresh is not retried until the launch template in the
+	// spec changes again.
+	// +optional
+	InstanceRefresh *InstanceRefresh `json:"instanceRefresh,omitempty"`
+
+	// ForceDelete terminates the instances of the group without waiting for
+	// their lifecycle hooks when the group is deleted. Otherwise the group
+	// is scaled to zero first.
+	// +optional
+	ForceDelete *bool `json:"forceDelete,omitempty"`
+
+	// Tags to add to the group.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// AutoScalingGroupSpec defines the desired state of an AutoScalingGroup.
+type AutoScalingGroupSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       AutoScalingGroupParameters `json:"forProvider"`
+}
+
+// LaunchTemplateObservation is the launch template an AutoScalingGroup or
+// one of its instances is using.
+type LaunchTemplateObservation struct {
+	// LaunchTemplateID is the ID of the launch template.
+	LaunchTemplateID string `json:"launchTemplateId,omitempty"`
+
+	// LaunchTemplateName is the name of the launch template.
+	LaunchTemplateName string `json:"launchTemplateName,omitempty"`
+
+	// Version of the launch template.
+	Version string `json:"version,omitempty"`
+}
+
+// InstanceObservation is the observed state of an instance of an
+// AutoScalingGroup.
+type InstanceObservation struct {
+	// InstanceID is the ID of the instance.
+	InstanceID string `json:"instanceId"`
+
+	// InstanceType is the type of the instance.
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// AvailabilityZone the instance is running in.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// LifecycleState of the instance, e.g. Pending or InService.
+	LifecycleState string `json:"lifecycleState,omitempty"`
+
+	// HealthStatus of the instance, either Healthy or Unhealthy.
+	HealthStatus string `json:"healthStatus,omitempty"`
+
+	// LaunchTemplateVersion is the version of the launch template the
+	// instance was launched with.
+	LaunchTemplateVersion string `json:"launchTemplateVersion,omitempty"`
+
+	// ProtectedFromScaleIn is whether the instance is protected from being
+	// terminated when the group scales in.
+	ProtectedFromScaleIn bool `json:"protectedFromScaleIn,omitempty"`
+}
+
+// InstanceRefreshObservation is the observed state of an instance refresh.
+type InstanceRefreshObservation struct {
+	// InstanceRefreshID is the ID of the instance refresh.
+	InstanceRefreshID string `json:"instanceRefreshId"`
+
+	// Status of the instance refresh, e.g. InProgress, Successful or Failed.
+	Status string `json:"status,omitempty"`
+
+	// StatusReason explains the status of the instance refresh.
+	StatusReason string `json:"statusReason,omitempty"`
+
+	// PercentageComplete is the percentage of instances that have been
+	// replaced.
+	PercentageComplete int64 `json:"percentageComplete,omitempty"`
+
+	// StartTime is the time the instance refresh started.
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is the time the instance refresh ended.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+// AutoScalingGroupObservation is the observed state of an AutoScalingGroup.
+type AutoScalingGroupObservation struct {
+	// ARN of the group.
+	ARN string `json:"arn,omitempty"`
+
+	// Status of the group. It is only set while the group is being deleted.
+	Status string `json:"status,omitempty"`
+
+	// DesiredCapacity is the current desired capacity of the group.
+	DesiredCapacity int64 `json:"desiredCapacity,omitempty"`
+
+	// LaunchTemplate is the launch template the group is using.
+	LaunchTemplate *LaunchTemplateObservation `json:"launchTemplate,omitempty"`
+
+	// Instances of the group.
+	Instances []InstanceObservation `json:"instances,omitempty"`
+
+	// WarmPoolSize is the number of instances in the warm pool of the group.
+	WarmPoolSize int64 `json:"warmPoolSize,omitempty"`
+
+	// LatestInstanceRefresh is the most recent instance refresh of the
+	// group. It is only observed if InstanceRefresh is configured.
+	LatestInstanceRefresh *InstanceRefreshObservation `json:"latestInstanceRefresh,omitempty"`
+
+	// CreatedTime is the time the group was created.
+	CreatedTime *metav1.Time `json:"createdTime,omitempty"`
+}
+
+// AutoScalingGroupStatus is the status of an AutoScalingGroup.
+type AutoScalingGroupStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AutoScalingGroupObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An AutoScalingGroup is a managed resource that represents an Amazon EC2
+// Auto Scaling group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="DESIRED",type="integer",JSONPath=".status.atProvider.desiredCapacity"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type AutoScalingGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AutoScalingGroupSpec   `json:"spec"`
+	Status AutoScalingGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AutoScalingGroupList contains a list of AutoScalingGroup
+type AutoScalingGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AutoScalingGroup `json:"items"`
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// Lifecycle transitions of a LifecycleHook.
+const (
+	LifecycleTransitionInstanceLaunching   = "autoscaling:EC2_INSTANCE_LAUNCHING"
+	LifecycleTransitionInstanceTerminating = "autoscaling:EC2_INSTANCE_TERMINATING"
+)
+
+// LifecycleHookParameters define the desired state of an Amazon EC2 Auto
+// Scaling lifecycle hook. The name of the hook is taken from the
+// crossplane.io/external-name annotation, which defaults to the name of the
+// resource.
+type LifecycleHookParameters struct {
+	// Region is the region you'd like your LifecycleHook to be created in.
+	Region string `json:"region"`
+
+	// AutoScalingGroupName is the name of the group the hook is added to.
+	// +immutable
+	// +optional
+	AutoScalingGroupName *string `json:"autoScalingGroupName,omitempty"`
+
+	// AutoScalingGroupNameRef is a reference to an AutoScalingGroup used to
+	// set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameRef *xpv1.Reference `json:"autoScalingGroupNameRef,omitempty"`
+
+	// AutoScalingGroupNameSelector selects references to an AutoScalingGroup
+	// used to set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameSelector *xpv1.Selector `json:"autoScalingGroupNameSelector,omitempty"`
+
+	// LifecycleTransition is the transition of the instances that pauses
+	// them until the hook completes.
+	// +kubebuilder:validation:Enum="autoscaling:EC2_INSTANCE_LAUNCHING";"autoscaling:EC2_INSTANCE_TERMINATING"
+	LifecycleTransition string `json:"lifecycleTransition"`
+
+	// DefaultResult is the action the group takes when the heartbeat
+	// timeout elapses or an unexpected failure occurs. Defaults to ABANDON.
+	// +kubebuilder:validation:Enum=CONTINUE;ABANDON
+	// +optional
+	DefaultResult *string `json:"defaultResult,omitempty"`
+
+	// HeartbeatTimeout is the number of seconds the instance stays paused
+	// before DefaultResult is applied. Defaults to 3600.
+	// +kubebuilder:validation:Minimum=30
+	// +kubebuilder:validation:Maximum=7200
+	// +optional
+	HeartbeatTimeout *int64 `json:"heartbeatTimeout,omitempty"`
+
+	// NotificationMetadata is additional information included in the
+	// notifications sent to the NotificationTargetARN.
+	// +optional
+	NotificationMetadata *string `json:"notificationMetadata,omitempty"`
+
+	// NotificationTargetARN is the ARN of the SQS queue or SNS topic
+	// notified when an instance enters the paused state.
+	// +optional
+	NotificationTargetARN *string `json:"notificationTargetArn,omitempty"`
+
+	// RoleARN is the ARN of the IAM role that allows the group to publish to
+	// the NotificationTargetARN.
+	// +optional
+	RoleARN *string `json:"roleArn,omitempty"`
+
+	// RoleARNRef is a reference to an iam/v1beta1.Role used to set the
+	// RoleARN.
+	// +optional
+	RoleARNRef *xpv1.Reference `json:"roleArnRef,omitempty"`
+
+	// RoleARNSelector selects references to an iam/v1beta1.Role used to set
+	// the RoleARN.
+	// +optional
+	RoleARNSelector *xpv1.Selector `json:"roleArnSelector,omitempty"`
+}
+
+// LifecycleHookSpec defines the desired state of a LifecycleHook.
+type LifecycleHookSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       LifecycleHookParameters `json:"forProvider"`
+}
+
+// LifecycleHookObservation is the observed state of a LifecycleHook.
+type LifecycleHookObservation struct {
+	// GlobalTimeout is the maximum number of seconds an instance can remain
+	// paused by the hook.
+	GlobalTimeout int64 `json:"globalTimeout,omitempty"`
+}
+
+// LifecycleHookStatus is the status of a LifecycleHook.
+type LifecycleHookStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  LifecycleHookObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A LifecycleHook is a managed resource that represents an Amazon EC2 Auto
+// Scaling lifecycle hook.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="GROUP",type="string",JSONPath=".spec.forProvider.autoScalingGroupName"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type LifecycleHook struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   LifecycleHookSpec   `json:"spec"`
+	Status LifecycleHookStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// LifecycleHookList contains a list of LifecycleHook
+type LifecycleHookList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LifecycleHook `json:"items"`
+}
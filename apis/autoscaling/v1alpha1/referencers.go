@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ec2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
+	ec2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	elbv2 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
+	iam "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+)
+
+// ResolveReferences of this AutoScalingGroup
+func (mg *AutoScalingGroup) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.launchTemplate.launchTemplateName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateName),
+		Reference:    mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateNameRef,
+		Selector:     mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateNameSelector,
+		To:           reference.To{Managed: &ec2v1alpha1.LaunchTemplate{}, List: &ec2v1alpha1.LaunchTemplateList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.launchTemplate.launchTemplateName")
+	}
+	mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LaunchTemplate.LaunchTemplateNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.launchTemplate.version
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.LaunchTemplate.Version),
+		Reference:    mg.Spec.ForProvider.LaunchTemplate.VersionRef,
+		Selector:     mg.Spec.ForProvider.LaunchTemplate.VersionSelector,
+		To:           reference.To{Managed: &ec2v1alpha1.LaunchTemplateVersion{}, List: &ec2v1alpha1.LaunchTemplateVersionList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.launchTemplate.version")
+	}
+	mg.Spec.ForProvider.LaunchTemplate.Version = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.LaunchTemplate.VersionRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.subnetIds
+	mrsp, err := r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.SubnetIDs,
+		References:    mg.Spec.ForProvider.SubnetIDRefs,
+		Selector:      mg.Spec.ForProvider.SubnetIDSelector,
+		To:            reference.To{Managed: &ec2v1beta1.Subnet{}, List: &ec2v1beta1.SubnetList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.subnetIds")
+	}
+	mg.Spec.ForProvider.SubnetIDs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.SubnetIDRefs = mrsp.ResolvedReferences
+
+	// Resolve spec.forProvider.targetGroupArns
+	mrsp, err = r.ResolveMultiple(ctx, reference.MultiResolutionRequest{
+		CurrentValues: mg.Spec.ForProvider.TargetGroupARNs,
+		References:    mg.Spec.ForProvider.TargetGroupARNRefs,
+		Selector:      mg.Spec.ForProvider.TargetGroupARNSelector,
+		To:            reference.To{Managed: &elbv2.TargetGroup{}, List: &elbv2.TargetGroupList{}},
+		Extract:       reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.targetGroupArns")
+	}
+	mg.Spec.ForProvider.TargetGroupARNs = mrsp.ResolvedValues
+	mg.Spec.ForProvider.TargetGroupARNRefs = mrsp.ResolvedReferences
+
+	return nil
+}
+
+// ResolveReferences of this LifecycleHook
+func (mg *LifecycleHook) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.autoScalingGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AutoScalingGroupName),
+		Reference:    mg.Spec.ForProvider.AutoScalingGroupNameRef,
+		Selector:     mg.Spec.ForProvider.AutoScalingGroupNameSelector,
+		To:           reference.To{Managed: &AutoScalingGroup{}, List: &AutoScalingGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.autoScalingGroupName")
+	}
+	mg.Spec.ForProvider.AutoScalingGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AutoScalingGroupNameRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.roleArn
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RoleARN),
+		Reference:    mg.Spec.ForProvider.RoleARNRef,
+		Selector:     mg.Spec.ForProvider.RoleARNSelector,
+		To:           reference.To{Managed: &iam.Role{}, List: &iam.RoleList{}},
+		Extract:      iam.RoleARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.roleArn")
+	}
+	mg.Spec.ForProvider.RoleARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RoleARNRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this ScalingPolicy
+func (mg *ScalingPolicy) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.autoScalingGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AutoScalingGroupName),
+		Reference:    mg.Spec.ForProvider.AutoScalingGroupNameRef,
+		Selector:     mg.Spec.ForProvider.AutoScalingGroupNameSelector,
+		To:           reference.To{Managed: &AutoScalingGroup{}, List: &AutoScalingGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.autoScalingGroupName")
+	}
+	mg.Spec.ForProvider.AutoScalingGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AutoScalingGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this WarmPool
+func (mg *WarmPool) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	// Resolve spec.forProvider.autoScalingGroupName
+	rsp, err := r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.AutoScalingGroupName),
+		Reference:    mg.Spec.ForProvider.AutoScalingGroupNameRef,
+		Selector:     mg.Spec.ForProvider.AutoScalingGroupNameSelector,
+		To:           reference.To{Managed: &AutoScalingGroup{}, List: &AutoScalingGroupList{}},
+		Extract:      reference.ExternalName(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.autoScalingGroupName")
+	}
+	mg.Spec.ForProvider.AutoScalingGroupName = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.AutoScalingGroupNameRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "autoscaling.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// AutoScalingGroup type metadata.
+var (
+	AutoScalingGroupKind             = reflect.TypeOf(AutoScalingGroup{}).Name()
+	AutoScalingGroupGroupKind        = schema.GroupKind{Group: Group, Kind: AutoScalingGroupKind}.String()
+	AutoScalingGroupKindAPIVersion   = AutoScalingGroupKind + "." + SchemeGroupVersion.String()
+	AutoScalingGroupGroupVersionKind = SchemeGroupVersion.WithKind(AutoScalingGroupKind)
+)
+
+// LifecycleHook type metadata.
+var (
+	LifecycleHookKind             = reflect.TypeOf(LifecycleHook{}).Name()
+	LifecycleHookGroupKind        = schema.GroupKind{Group: Group, Kind: LifecycleHookKind}.String()
+	LifecycleHookKindAPIVersion   = LifecycleHookKind + "." + SchemeGroupVersion.String()
+	LifecycleHookGroupVersionKind = SchemeGroupVersion.WithKind(LifecycleHookKind)
+)
+
+// ScalingPolicy type metadata.
+var (
+	ScalingPolicyKind             = reflect.TypeOf(ScalingPolicy{}).Name()
+	ScalingPolicyGroupKind        = schema.GroupKind{Group: Group, Kind: ScalingPolicyKind}.String()
+	ScalingPolicyKindAPIVersion   = ScalingPolicyKind + "." + SchemeGroupVersion.String()
+	ScalingPolicyGroupVersionKind = SchemeGroupVersion.WithKind(ScalingPolicyKind)
+)
+
+// WarmPool type metadata.
+var (
+	WarmPoolKind             = reflect.TypeOf(WarmPool{}).Name()
+	WarmPoolGroupKind        = schema.GroupKind{Group: Group, Kind: WarmPoolKind}.String()
+	WarmPoolKindAPIVersion   = WarmPoolKind + "." + SchemeGroupVersion.String()
+	WarmPoolGroupVersionKind = SchemeGroupVersion.WithKind(WarmPoolKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&AutoScalingGroup{}, &AutoScalingGroupList{})
+	SchemeBuilder.Register(&LifecycleHook{}, &LifecycleHookList{})
+	SchemeBuilder.Register(&ScalingPolicy{}, &ScalingPolicyList{})
+	SchemeBuilder.Register(&WarmPool{}, &WarmPoolList{})
+}
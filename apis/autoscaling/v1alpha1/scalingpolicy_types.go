@@ -0,0 +1,247 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// Types of a ScalingPolicy.
+const (
+	PolicyTypeSimpleScaling         = "SimpleScaling"
+	PolicyTypeStepScaling           = "StepScaling"
+	PolicyTypeTargetTrackingScaling = "TargetTrackingScaling"
+)
+
+// StepAdjustment scales the group by ScalingAdjustment when the alarm metric
+// is between the lower and upper bound, relative to the alarm threshold.
+type StepAdjustment struct {
+	// MetricIntervalLowerBound is the lower bound of the interval. Empty
+	// means negative infinity.
+	// +optional
+	MetricIntervalLowerBound *float64 `json:"metricIntervalLowerBound,omitempty"`
+
+	// MetricIntervalUpperBound is the upper bound of the interval. Empty
+	// means positive infinity.
+	// +optional
+	MetricIntervalUpperBound *float64 `json:"metricIntervalUpperBound,omitempty"`
+
+	// ScalingAdjustment is the amount to scale by, interpreted according to
+	// the AdjustmentType of the policy.
+	ScalingAdjustment int64 `json:"scalingAdjustment"`
+}
+
+// PredefinedMetricSpecification is a metric predefined by Amazon EC2 Auto
+// Scaling.
+type PredefinedMetricSpecification struct {
+	// PredefinedMetricType is the metric to track.
+	// +kubebuilder:validation:Enum=ASGAverageCPUUtilization;ASGAverageNetworkIn;ASGAverageNetworkOut;ALBRequestCountPerTarget
+	PredefinedMetricType string `json:"predefinedMetricType"`
+
+	// ResourceLabel identifies the target group of an
+	// ALBRequestCountPerTarget metric, in the format
+	// app/<load-balancer-name>/<load-balancer-id>/targetgroup/<target-group-name>/<target-group-id>.
+	// +optional
+	ResourceLabel *string `json:"resourceLabel,omitempty"`
+}
+
+// MetricDimension is a dimension of a CloudWatch metric.
+type MetricDimension struct {
+	// Name of the dimension.
+	Name string `json:"name"`
+
+	// Value of the dimension.
+	Value string `json:"value"`
+}
+
+// CustomizedMetricSpecification is a CloudWatch metric.
+type CustomizedMetricSpecification struct {
+	// MetricName is the name of the metric.
+	MetricName string `json:"metricName"`
+
+	// Namespace of the metric.
+	Namespace string `json:"namespace"`
+
+	// Statistic of the metric.
+	// +kubebuilder:validation:Enum=Average;Minimum;Maximum;SampleCount;Sum
+	Statistic string `json:"statistic"`
+
+	// Dimensions of the metric.
+	// +optional
+	Dimensions []MetricDimension `json:"dimensions,omitempty"`
+
+	// Unit of the metric.
+	// +optional
+	Unit *string `json:"unit,omitempty"`
+}
+
+// TargetTrackingConfiguration keeps a metric at a target value.
+type TargetTrackingConfiguration struct {
+	// PredefinedMetricSpecification is the predefined metric to track.
+	// +optional
+	PredefinedMetricSpecification *PredefinedMetricSpecification `json:"predefinedMetricSpecification,omitempty"`
+
+	// CustomizedMetricSpecification is the CloudWatch metric to track.
+	// +optional
+	CustomizedMetricSpecification *CustomizedMetricSpecification `json:"customizedMetricSpecification,omitempty"`
+
+	// TargetValue is the value the metric is kept at.
+	TargetValue float64 `json:"targetValue"`
+
+	// DisableScaleIn prevents the policy from scaling in the group.
+	// +optional
+	DisableScaleIn *bool `json:"disableScaleIn,omitempty"`
+}
+
+// ScalingPolicyParameters define the desired state of an Amazon EC2 Auto
+// Scaling policy. The name of the policy is taken from the
+// crossplane.io/external-name annotation, which defaults to the name of the
+// resource.
+type ScalingPolicyParameters struct {
+	// Region is the region you'd like your ScalingPolicy to be created in.
+	Region string `json:"region"`
+
+	// AutoScalingGroupName is the name of the group the policy scales.
+	// +immutable
+	// +optional
+	AutoScalingGroupName *string `json:"autoScalingGroupName,omitempty"`
+
+	// AutoScalingGroupNameRef is a reference to an AutoScalingGroup used to
+	// set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameRef *xpv1.Reference `json:"autoScalingGroupNameRef,omitempty"`
+
+	// AutoScalingGroupNameSelector selects references to an AutoScalingGroup
+	// used to set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameSelector *xpv1.Selector `json:"autoScalingGroupNameSelector,omitempty"`
+
+	// PolicyType is the type of the policy. Defaults to SimpleScaling.
+	// +kubebuilder:validation:Enum=SimpleScaling;StepScaling;TargetTrackingScaling
+	// +optional
+	PolicyType *string `json:"policyType,omitempty"`
+
+	// AdjustmentType determines how ScalingAdjustment is interpreted. Only
+	// used by SimpleScaling and StepScaling policies.
+	// +kubebuilder:validation:Enum=ChangeInCapacity;ExactCapacity;PercentChangeInCapacity
+	// +optional
+	AdjustmentType *string `json:"adjustmentType,omitempty"`
+
+	// ScalingAdjustment is the amount to scale by. Required by SimpleScaling
+	// policies.
+	// +optional
+	ScalingAdjustment *int64 `json:"scalingAdjustment,omitempty"`
+
+	// Cooldown is the number of seconds after a scaling activity before
+	// another one can start. Only used by SimpleScaling policies.
+	// +optional
+	Cooldown *int64 `json:"cooldown,omitempty"`
+
+	// MinAdjustmentMagnitude is the minimum number of instances to scale by
+	// for a PercentChangeInCapacity adjustment.
+	// +optional
+	MinAdjustmentMagnitude *int64 `json:"minAdjustmentMagnitude,omitempty"`
+
+	// MetricAggregationType is the aggregation type of the CloudWatch
+	// metric of a StepScaling policy. Defaults to Average.
+	// +kubebuilder:validation:Enum=Minimum;Maximum;Average
+	// +optional
+	MetricAggregationType *string `json:"metricAggregationType,omitempty"`
+
+	// StepAdjustments of a StepScaling policy.
+	// +optional
+	StepAdjustments []StepAdjustment `json:"stepAdjustments,omitempty"`
+
+	// EstimatedInstanceWarmup is the number of seconds until a new instance
+	// contributes to the CloudWatch metrics. Only used by StepScaling and
+	// TargetTrackingScaling policies.
+	// +optional
+	EstimatedInstanceWarmup *int64 `json:"estimatedInstanceWarmup,omitempty"`
+
+	// TargetTrackingConfiguration of a TargetTrackingScaling policy.
+	// +optional
+	TargetTrackingConfiguration *TargetTrackingConfiguration `json:"targetTrackingConfiguration,omitempty"`
+
+	// Enabled determines whether the policy is enabled. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// ScalingPolicySpec defines the desired state of a ScalingPolicy.
+type ScalingPolicySpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ScalingPolicyParameters `json:"forProvider"`
+}
+
+// Alarm is a CloudWatch alarm of a ScalingPolicy.
+type Alarm struct {
+	// AlarmName is the name of the alarm.
+	AlarmName string `json:"alarmName,omitempty"`
+
+	// AlarmARN is the ARN of the alarm.
+	AlarmARN string `json:"alarmArn,omitempty"`
+}
+
+// ScalingPolicyObservation is the observed state of a ScalingPolicy.
+type ScalingPolicyObservation struct {
+	// PolicyARN is the ARN of the policy. It is used as the action of
+	// CloudWatch alarms triggering SimpleScaling and StepScaling policies.
+	PolicyARN string `json:"policyArn,omitempty"`
+
+	// Alarms of the policy. TargetTrackingScaling policies create and
+	// manage their own alarms.
+	Alarms []Alarm `json:"alarms,omitempty"`
+}
+
+// ScalingPolicyStatus is the status of a ScalingPolicy.
+type ScalingPolicyStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ScalingPolicyObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ScalingPolicy is a managed resource that represents an Amazon EC2 Auto
+// Scaling policy.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.policyType"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ScalingPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ScalingPolicySpec   `json:"spec"`
+	Status ScalingPolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScalingPolicyList contains a list of ScalingPolicy
+type ScalingPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScalingPolicy `json:"items"`
+}
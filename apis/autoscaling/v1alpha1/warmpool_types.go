@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// InstanceReusePolicy determines what happens to instances of the group when
+// it scales in.
+type InstanceReusePolicy struct {
+	// ReuseOnScaleIn returns instances to the warm pool when the group scales
+	// in instead of terminating them.
+	// +optional
+	ReuseOnScaleIn *bool `json:"reuseOnScaleIn,omitempty"`
+}
+
+// WarmPoolParameters define the desired state of the warm pool of an Amazon
+// EC2 Auto Scaling group. A group has at most one warm pool, the external
+// name of a WarmPool is the name of its group.
+type WarmPoolParameters struct {
+	// Region is the region you'd like your WarmPool to be created in.
+	Region string `json:"region"`
+
+	// AutoScalingGroupName is the name of the group the warm pool is added
+	// to.
+	// +immutable
+	// +optional
+	AutoScalingGroupName *string `json:"autoScalingGroupName,omitempty"`
+
+	// AutoScalingGroupNameRef is a reference to an AutoScalingGroup used to
+	// set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameRef *xpv1.Reference `json:"autoScalingGroupNameRef,omitempty"`
+
+	// AutoScalingGroupNameSelector selects references to an AutoScalingGroup
+	// used to set the AutoScalingGroupName.
+	// +optional
+	AutoScalingGroupNameSelector *xpv1.Selector `json:"autoScalingGroupNameSelector,omitempty"`
+
+	// MaxGroupPreparedCapacity is the maximum number of instances allowed in
+	// the warm pool and the group together. Defaults to the MaxSize of the
+	// group, -1 resets it to the default.
+	// +optional
+	MaxGroupPreparedCapacity *int64 `json:"maxGroupPreparedCapacity,omitempty"`
+
+	// MinSize is the minimum number of instances in the warm pool. Defaults
+	// to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MinSize *int64 `json:"minSize,omitempty"`
+
+	// PoolState is the state instances in the warm pool are kept in.
+	// Defaults to Stopped.
+	// +kubebuilder:validation:Enum=Stopped;Running;Hibernated
+	// +optional
+	PoolState *string `json:"poolState,omitempty"`
+
+	// InstanceReusePolicy determines whether instances are returned to the
+	// warm pool when the group scales in.
+	// +optional
+	InstanceReusePolicy *InstanceReusePolicy `json:"instanceReusePolicy,omitempty"`
+
+	// ForceDelete terminates the instances in the warm pool without waiting
+	// for their lifecycle hooks when the WarmPool is deleted.
+	// +optional
+	ForceDelete *bool `json:"forceDelete,omitempty"`
+}
+
+// WarmPoolSpec defines the desired state of a WarmPool.
+type WarmPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WarmPoolParameters `json:"forProvider"`
+}
+
+// WarmPoolObservation is the observed state of a WarmPool.
+type WarmPoolObservation struct {
+	// Status of the warm pool. It is only set while the warm pool is being
+	// deleted.
+	Status string `json:"status,omitempty"`
+
+	// Instances is the number of instances in the warm pool.
+	Instances int64 `json:"instances,omitempty"`
+}
+
+// WarmPoolStatus is the status of a WarmPool.
+type WarmPoolStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  WarmPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WarmPool is a managed resource that represents the warm pool of an
+// Amazon EC2 Auto Scaling group.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="INSTANCES",type="integer",JSONPath=".status.atProvider.instances"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type WarmPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WarmPoolSpec   `json:"spec"`
+	Status WarmPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WarmPoolList contains a list of WarmPool
+type WarmPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WarmPool `json:"items"`
+}
@@ -28,6 +28,7 @@ import (
 	apigatewayv2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/apigatewayv2/v1alpha1"
 	apigatewayv2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/apigatewayv2/v1beta1"
 	athenav1alpha1 "github.com/crossplane-contrib/provider-aws/apis/athena/v1alpha1"
+	autoscalingv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
 	cachev1alpha1 "github.com/crossplane-contrib/provider-aws/apis/cache/v1alpha1"
 	cachev1beta1 "github.com/crossplane-contrib/provider-aws/apis/cache/v1beta1"
 	cloudfrontv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/cloudfront/v1alpha1"
@@ -41,6 +42,7 @@ import (
 	docdbv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/docdb/v1alpha1"
 	dynamodbv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/dynamodb/v1alpha1"
 	ec2manualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	ec2networkv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
 	ec2v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1alpha1"
 	ec2v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	ecrv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ecr/v1alpha1"
@@ -84,6 +86,7 @@ import (
 	sfnv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/sfn/v1alpha1"
 	snsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sns/v1beta1"
 	sqsv1beta1 "github.com/crossplane-contrib/provider-aws/apis/sqs/v1beta1"
+	ssmv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
 	transferv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/transfer/v1alpha1"
 	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsv1alpha3 "github.com/crossplane-contrib/provider-aws/apis/v1alpha3"
@@ -135,6 +138,7 @@ func init() {
 		efsv1alpha1.SchemeBuilder.AddToScheme,
 		rdsv1alpha1.SchemeBuilder.AddToScheme,
 		ec2manualv1alpha1.SchemeBuilder.AddToScheme,
+		ec2networkv1alpha1.SchemeBuilder.AddToScheme,
 		ec2v1alpha1.SchemeBuilder.AddToScheme,
 		lambdav1alpha1.SchemeBuilder.AddToScheme,
 		lambdamanualv1alpha1.SchemeBuilder.AddToScheme,
@@ -161,6 +165,8 @@ func init() {
 		apigatewayv1alpha1.AddToScheme,
 		cognitoidentityv1alpha1.AddToScheme,
 		opensearchv1alpha1.AddToScheme,
+		ssmv1alpha1.SchemeBuilder.AddToScheme,
+		autoscalingv1alpha1.SchemeBuilder.AddToScheme,
 	)
 }
 
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CacheSubnetGroupParameters define the desired state of an AWS ElasticCache Subnet Group.
@@ -57,8 +59,9 @@ type CacheSubnetGroupExternalStatus struct {
 
 // A CacheSubnetGroupStatus represents the observed state of a Subnet Group.
 type CacheSubnetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CacheSubnetGroupExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CacheSubnetGroupExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -281,6 +281,7 @@ func (in *CacheClusterSpec) DeepCopy() *CacheClusterSpec {
 func (in *CacheClusterStatus) DeepCopyInto(out *CacheClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -466,6 +467,7 @@ func (in *CacheSubnetGroupSpec) DeepCopy() *CacheSubnetGroupSpec {
 func (in *CacheSubnetGroupStatus) DeepCopyInto(out *CacheSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ReplicationGroup states.
@@ -592,8 +594,9 @@ type ReplicationGroupSpec struct {
 
 // A ReplicationGroupStatus defines the observed state of a ReplicationGroup.
 type ReplicationGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ReplicationGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ReplicationGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -429,6 +429,7 @@ func (in *ReplicationGroupSpec) DeepCopy() *ReplicationGroupSpec {
 func (in *ReplicationGroupStatus) DeepCopyInto(out *ReplicationGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CachePolicyParameters defines the desired state of CachePolicy
@@ -53,8 +55,9 @@ type CachePolicyObservation struct {
 
 // CachePolicyStatus defines the observed state of CachePolicy.
 type CachePolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CachePolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CachePolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DistributionParameters defines the desired state of Distribution
@@ -53,8 +55,9 @@ type DistributionObservation struct {
 
 // DistributionStatus defines the observed state of Distribution.
 type DistributionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DistributionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DistributionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
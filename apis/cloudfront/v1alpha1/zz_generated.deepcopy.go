@@ -607,6 +607,7 @@ func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
 func (in *CachePolicyStatus) DeepCopyInto(out *CachePolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -831,6 +832,7 @@ func (in *CloudFrontOriginAccessIdentitySpec) DeepCopy() *CloudFrontOriginAccess
 func (in *CloudFrontOriginAccessIdentityStatus) DeepCopyInto(out *CloudFrontOriginAccessIdentityStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1664,6 +1666,7 @@ func (in *DistributionSpec) DeepCopy() *DistributionSpec {
 func (in *DistributionStatus) DeepCopyInto(out *DistributionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -4284,6 +4287,7 @@ func (in *ResponseHeadersPolicySpec) DeepCopy() *ResponseHeadersPolicySpec {
 func (in *ResponseHeadersPolicyStatus) DeepCopyInto(out *ResponseHeadersPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResponseHeadersPolicyParameters defines the desired state of ResponseHeadersPolicy
@@ -54,8 +56,9 @@ type ResponseHeadersPolicyObservation struct {
 
 // ResponseHeadersPolicyStatus defines the observed state of ResponseHeadersPolicy.
 type ResponseHeadersPolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResponseHeadersPolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResponseHeadersPolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
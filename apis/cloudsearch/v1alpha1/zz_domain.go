@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DomainParameters defines the desired state of Domain
@@ -79,8 +81,9 @@ type DomainObservation struct {
 
 // DomainStatus defines the observed state of Domain.
 type DomainStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DomainObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DomainObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -321,6 +321,7 @@ func (in *DomainSpec) DeepCopy() *DomainSpec {
 func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -352,6 +352,7 @@ func (in *LogGroupSpec) DeepCopy() *LogGroupSpec {
 func (in *LogGroupStatus) DeepCopyInto(out *LogGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // LogGroupParameters defines the desired state of LogGroup
@@ -69,8 +71,9 @@ type LogGroupObservation struct {
 
 // LogGroupStatus defines the observed state of LogGroup.
 type LogGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LogGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  LogGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -304,6 +304,7 @@ func (in *IdentityPoolSpec) DeepCopy() *IdentityPoolSpec {
 func (in *IdentityPoolStatus) DeepCopyInto(out *IdentityPoolStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // IdentityPoolParameters defines the desired state of IdentityPool
@@ -76,8 +78,9 @@ type IdentityPoolObservation struct {
 
 // IdentityPoolStatus defines the observed state of IdentityPool.
 type IdentityPoolStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IdentityPoolObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IdentityPoolObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ExternalAnnotation defines a virtual external name annotation for importing existing resources.
@@ -87,8 +89,9 @@ type GroupUserMembershipObservation struct {
 // An GroupUserMembershipStatus represents the observed state of an
 // GroupUserMembership.
 type GroupUserMembershipStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GroupUserMembershipObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  GroupUserMembershipObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
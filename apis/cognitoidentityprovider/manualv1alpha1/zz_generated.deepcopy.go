@@ -191,6 +191,7 @@ func (in *GroupUserMembershipSpec) DeepCopy() *GroupUserMembershipSpec {
 func (in *GroupUserMembershipStatus) DeepCopyInto(out *GroupUserMembershipStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
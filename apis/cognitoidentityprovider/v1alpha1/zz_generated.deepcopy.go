@@ -897,6 +897,7 @@ func (in *GroupSpec) DeepCopy() *GroupSpec {
 func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1143,6 +1144,7 @@ func (in *IdentityProviderSpec) DeepCopy() *IdentityProviderSpec {
 func (in *IdentityProviderStatus) DeepCopyInto(out *IdentityProviderStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1700,6 +1702,7 @@ func (in *ResourceServerSpec) DeepCopy() *ResourceServerSpec {
 func (in *ResourceServerStatus) DeepCopyInto(out *ResourceServerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -2452,6 +2455,7 @@ func (in *UserPoolClientSpec) DeepCopy() *UserPoolClientSpec {
 func (in *UserPoolClientStatus) DeepCopyInto(out *UserPoolClientStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2805,6 +2809,7 @@ func (in *UserPoolDomainSpec) DeepCopy() *UserPoolDomainSpec {
 func (in *UserPoolDomainStatus) DeepCopyInto(out *UserPoolDomainStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3125,6 +3130,7 @@ func (in *UserPoolSpec) DeepCopy() *UserPoolSpec {
 func (in *UserPoolStatus) DeepCopyInto(out *UserPoolStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
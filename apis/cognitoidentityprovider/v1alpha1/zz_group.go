@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // GroupParameters defines the desired state of Group
@@ -72,8 +74,9 @@ type GroupObservation struct {
 
 // GroupStatus defines the observed state of Group.
 type GroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  GroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
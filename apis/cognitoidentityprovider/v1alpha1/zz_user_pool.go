@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // UserPoolParameters defines the desired state of UserPool
@@ -159,8 +161,9 @@ type UserPoolObservation struct {
 
 // UserPoolStatus defines the observed state of UserPool.
 type UserPoolStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserPoolObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  UserPoolObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
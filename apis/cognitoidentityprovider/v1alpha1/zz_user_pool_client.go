@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // UserPoolClientParameters defines the desired state of UserPoolClient
@@ -202,8 +204,9 @@ type UserPoolClientObservation struct {
 
 // UserPoolClientStatus defines the observed state of UserPoolClient.
 type UserPoolClientStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserPoolClientObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  UserPoolClientObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // UserPoolDomainParameters defines the desired state of UserPoolDomain
@@ -57,8 +59,9 @@ type UserPoolDomainObservation struct {
 
 // UserPoolDomainStatus defines the observed state of UserPoolDomain.
 type UserPoolDomainStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          UserPoolDomainObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  UserPoolDomainObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
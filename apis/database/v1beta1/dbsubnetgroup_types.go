@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DBSubnetGroupStateAvailable states that a DBSubnet Group is healthy and available
@@ -89,8 +91,9 @@ type DBSubnetGroupObservation struct {
 
 // A DBSubnetGroupStatus represents the observed state of a DBSubnetGroup.
 type DBSubnetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBSubnetGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DBSubnetGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
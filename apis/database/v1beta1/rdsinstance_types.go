@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SQL database engines.
@@ -106,6 +108,23 @@ type ScalingConfiguration struct {
 	SecondsUntilAutoPause *int `json:"secondsUntilAutoPause,omitempty"`
 }
 
+// PasswordRotation configures the rotation of the master password.
+type PasswordRotation struct {
+	// Interval after which the master password is replaced with a newly
+	// generated one, e.g. 720h. The new password is written to the secret
+	// referenced by MasterPasswordSecretRef and to the connection secret.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// GetInterval returns the rotation interval, or nil if the password is not
+// rotated.
+func (r *PasswordRotation) GetInterval() *metav1.Duration {
+	if r == nil {
+		return nil
+	}
+	return &r.Interval
+}
+
 // S3RestoreBackupConfiguration defines the details of the S3 backup to restore from.
 type S3RestoreBackupConfiguration struct {
 	// BucketName is the name of the S3 bucket containing the backup to restore.
@@ -564,6 +583,28 @@ type RDSInstanceParameters struct {
 	// +immutable
 	MasterPasswordSecretRef *xpv1.SecretKeySelector `json:"masterPasswordSecretRef,omitempty"`
 
+	// PasswordRotation configures the periodic rotation of the master
+	// password. It requires a MasterPasswordSecretRef unless the password is
+	// managed in Secrets Manager. The time of the last rotation is recorded
+	// in the passwordRotatedAt field of the status.
+	// +optional
+	PasswordRotation *PasswordRotation `json:"passwordRotation,omitempty"`
+
+	// ManageMasterUserPassword indicates whether to manage the master user
+	// password with Amazon Web Services Secrets Manager. If enabled, the
+	// password is neither read from MasterPasswordSecretRef nor generated by
+	// the controller; the username and password in the connection secret are
+	// read from the secret that RDS creates in Secrets Manager.
+	// +optional
+	ManageMasterUserPassword *bool `json:"manageMasterUserPassword,omitempty"`
+
+	// MasterUserSecretKMSKeyID is the Amazon Web Services KMS key identifier
+	// used to encrypt the secret that is managed by RDS in Secrets Manager if
+	// ManageMasterUserPassword is enabled. If not set, the aws/secretsmanager
+	// KMS key is used.
+	// +optional
+	MasterUserSecretKMSKeyID *string `json:"masterUserSecretKmsKeyId,omitempty"`
+
 	// The upper limit to which Amazon RDS can automatically scale the storage of
 	// the DB instance.
 	//
@@ -974,6 +1015,21 @@ type SubnetInRDS struct {
 	SubnetStatus string `json:"subnetStatus,omitempty"`
 }
 
+// MasterUserSecret contains the secret managed by RDS in Amazon Web Services
+// Secrets Manager for the master user password.
+type MasterUserSecret struct {
+	// SecretARN is the Amazon Resource Name (ARN) of the secret.
+	SecretARN string `json:"secretArn,omitempty"`
+
+	// SecretStatus is the status of the secret. One of creating, active,
+	// rotating or impaired.
+	SecretStatus string `json:"secretStatus,omitempty"`
+
+	// KMSKeyID is the Amazon Web Services KMS key identifier that is used to
+	// encrypt the secret.
+	KMSKeyID string `json:"kmsKeyId,omitempty"`
+}
+
 // DBSubnetGroupInRDS contains the details of an Amazon RDS DB subnet group.
 // This data type is used as a response element in the DescribeDBSubnetGroups
 // action.
@@ -1197,9 +1253,17 @@ type RDSInstanceObservation struct {
 	// restored with point-in-time restore.
 	LatestRestorableTime *metav1.Time `json:"latestRestorableTime,omitempty"`
 
+	// MasterUserSecret contains the secret in Secrets Manager that stores the
+	// master user password if it is managed by RDS.
+	MasterUserSecret *MasterUserSecret `json:"masterUserSecret,omitempty"`
+
 	// OptionGroupMemberships provides the list of option group memberships for this DB instance.
 	OptionGroupMemberships []OptionGroupMembership `json:"optionGroupMemberships,omitempty"`
 
+	// PasswordRotatedAt is the time the master password was last rotated by
+	// the provider.
+	PasswordRotatedAt *metav1.Time `json:"passwordRotatedAt,omitempty"`
+
 	// PendingModifiedValues specifies that changes to the DB instance are pending. This element is only
 	// included when changes are pending. Specific changes are identified by subelements.
 	PendingModifiedValues PendingModifiedValues `json:"pendingModifiedValues,omitempty"`
@@ -1238,8 +1302,9 @@ type RDSInstanceObservation struct {
 
 // An RDSInstanceStatus represents the observed state of an RDSInstance.
 type RDSInstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RDSInstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RDSInstanceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
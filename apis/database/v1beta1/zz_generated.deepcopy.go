@@ -273,6 +273,7 @@ func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
 func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -316,6 +317,21 @@ func (in *Endpoint) DeepCopy() *Endpoint {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasterUserSecret) DeepCopyInto(out *MasterUserSecret) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecret.
+func (in *MasterUserSecret) DeepCopy() *MasterUserSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(MasterUserSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OptionGroupMembership) DeepCopyInto(out *OptionGroupMembership) {
 	*out = *in
@@ -331,6 +347,22 @@ func (in *OptionGroupMembership) DeepCopy() *OptionGroupMembership {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotation) DeepCopyInto(out *PasswordRotation) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotation.
+func (in *PasswordRotation) DeepCopy() *PasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingCloudwatchLogsExports) DeepCopyInto(out *PendingCloudwatchLogsExports) {
 	*out = *in
@@ -513,11 +545,20 @@ func (in *RDSInstanceObservation) DeepCopyInto(out *RDSInstanceObservation) {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
 	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		**out = **in
+	}
 	if in.OptionGroupMemberships != nil {
 		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
 		*out = make([]OptionGroupMembership, len(*in))
 		copy(*out, *in)
 	}
+	if in.PasswordRotatedAt != nil {
+		in, out := &in.PasswordRotatedAt, &out.PasswordRotatedAt
+		*out = (*in).DeepCopy()
+	}
 	in.PendingModifiedValues.DeepCopyInto(&out.PendingModifiedValues)
 	if in.ReadReplicaDBClusterIdentifiers != nil {
 		in, out := &in.ReadReplicaDBClusterIdentifiers, &out.ReadReplicaDBClusterIdentifiers
@@ -684,6 +725,21 @@ func (in *RDSInstanceParameters) DeepCopyInto(out *RDSInstanceParameters) {
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotation)
+		**out = **in
+	}
+	if in.ManageMasterUserPassword != nil {
+		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserSecretKMSKeyID != nil {
+		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
 	if in.MaxAllocatedStorage != nil {
 		in, out := &in.MaxAllocatedStorage, &out.MaxAllocatedStorage
 		*out = new(int)
@@ -889,6 +945,7 @@ func (in *RDSInstanceSpec) DeepCopy() *RDSInstanceSpec {
 func (in *RDSInstanceStatus) DeepCopyInto(out *RDSInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
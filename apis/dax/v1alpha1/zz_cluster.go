@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ClusterParameters defines the desired state of Cluster
@@ -140,8 +142,9 @@ type ClusterObservation struct {
 
 // ClusterStatus defines the observed state of Cluster.
 type ClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
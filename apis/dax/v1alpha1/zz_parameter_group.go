@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ParameterGroupParameters defines the desired state of ParameterGroup
@@ -48,8 +50,9 @@ type ParameterGroupObservation struct {
 
 // ParameterGroupStatus defines the observed state of ParameterGroup.
 type ParameterGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ParameterGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ParameterGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
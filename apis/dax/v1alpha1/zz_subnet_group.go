@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SubnetGroupParameters defines the desired state of SubnetGroup
@@ -52,8 +54,9 @@ type SubnetGroupObservation struct {
 
 // SubnetGroupStatus defines the observed state of SubnetGroup.
 type SubnetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SubnetGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SubnetGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DBInstanceParameters defines the desired state of DBInstance
@@ -138,8 +140,9 @@ type DBInstanceObservation struct {
 
 // DBInstanceStatus defines the observed state of DBInstance.
 type DBInstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBInstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DBInstanceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -685,6 +685,7 @@ func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
 func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1063,6 +1064,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1603,6 +1605,7 @@ func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
 func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1970,6 +1973,7 @@ func (in *DBSubnetGroupSpec) DeepCopy() *DBSubnetGroupSpec {
 func (in *DBSubnetGroupStatus) DeepCopyInto(out *DBSubnetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // BackupParameters defines the desired state of Backup
@@ -69,8 +71,9 @@ type BackupObservation struct {
 
 // BackupStatus defines the observed state of Backup.
 type BackupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BackupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  BackupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // GlobalTableParameters defines the desired state of GlobalTable
@@ -63,8 +65,9 @@ type GlobalTableObservation struct {
 
 // GlobalTableStatus defines the observed state of GlobalTable.
 type GlobalTableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          GlobalTableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  GlobalTableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TableParameters defines the desired state of Table
@@ -235,8 +237,9 @@ type TableObservation struct {
 
 // TableStatus defines the observed state of Table.
 type TableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -19,6 +19,8 @@ package manualv1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // InstanceParameters define the desired state of the Instances
@@ -281,8 +283,27 @@ type InstanceParameters struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(?:[A-Za-z0-9+/]{4})*(?:[A-Za-z0-9+/]{2}==|[A-Za-z0-9+/]{3}=)?$`
 	UserData *string `json:"userData,omitempty"`
+
+	// DesiredState is the desired power state of the instance. Changing
+	// instanceType, ebsOptimized or userData requires the instance to be
+	// stopped, it is stopped, modified and brought back to this state.
+	// hibernated requires hibernation to be enabled for the instance.
+	// Defaults to the state the instance is in when it is first observed.
+	// +kubebuilder:validation:Enum=running;stopped;hibernated
+	// +optional
+	DesiredState *string `json:"desiredState,omitempty"`
 }
 
+// Desired power states of an Instance.
+const (
+	// InstanceDesiredStateRunning keeps the instance running.
+	InstanceDesiredStateRunning = "running"
+	// InstanceDesiredStateStopped keeps the instance stopped.
+	InstanceDesiredStateStopped = "stopped"
+	// InstanceDesiredStateHibernated hibernates the instance.
+	InstanceDesiredStateHibernated = "hibernated"
+)
+
 // An InstanceSpec defines the desired state of Instances.
 type InstanceSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
@@ -291,8 +312,9 @@ type InstanceSpec struct {
 
 // An InstanceStatus represents the observed state of Instances.
 type InstanceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          InstanceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  InstanceObservation `json:"atProvider,omitempty"`
 }
 
 // InstanceObservation keeps the state for the external resource. The below fields
@@ -20,6 +20,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SecurityGroupRuleParameters define the desired state of the SecurityGroupRule
@@ -109,8 +111,9 @@ type SecurityGroupRuleState struct {
 
 // A SecurityGroupRuleStatus represents the observed state of a SecurityGroupRule.
 type SecurityGroupRuleStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SecurityGroupRuleObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SecurityGroupRuleObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// IPAMPoolParameters define the desired state of an IPAMPool.
+type IPAMPoolParameters struct {
+	// Region is the region you'd like your IPAMPool to be created in. It
+	// must be the home region of the IPAM.
+	// +kubebuilder:validation:Required
+	Region *string `json:"region"`
+
+	// The ID of the scope in which to create the pool.
+	// +immutable
+	// +crossplane:generate:reference:type=IPAMScope
+	// +optional
+	IPAMScopeID *string `json:"ipamScopeId,omitempty"`
+
+	// IPAMScopeIDRef references an IPAMScope to retrieve its ID.
+	// +optional
+	IPAMScopeIDRef *xpv1.Reference `json:"ipamScopeIdRef,omitempty"`
+
+	// IPAMScopeIDSelector selects a reference to an IPAMScope to retrieve its
+	// ID.
+	// +optional
+	IPAMScopeIDSelector *xpv1.Selector `json:"ipamScopeIdSelector,omitempty"`
+
+	// The ID of the pool from which the CIDRs of this pool are allocated.
+	// Only set for nested pools.
+	// +immutable
+	// +crossplane:generate:reference:type=IPAMPool
+	// +optional
+	SourceIPAMPoolID *string `json:"sourceIpamPoolId,omitempty"`
+
+	// SourceIPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	SourceIPAMPoolIDRef *xpv1.Reference `json:"sourceIpamPoolIdRef,omitempty"`
+
+	// SourceIPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	SourceIPAMPoolIDSelector *xpv1.Selector `json:"sourceIpamPoolIdSelector,omitempty"`
+
+	// The IP protocol of the pool.
+	// +kubebuilder:validation:Enum=ipv4;ipv6
+	// +immutable
+	AddressFamily string `json:"addressFamily"`
+
+	// The region of the pool. Only resources in this region can be allocated
+	// CIDRs from the pool. It must be one of the operating regions of the
+	// IPAM.
+	// +immutable
+	// +optional
+	Locale *string `json:"locale,omitempty"`
+
+	// A description for the pool.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// If set, IPAM continuously imports the CIDRs of existing resources within
+	// the range of the pool as allocations.
+	// +optional
+	AutoImport *bool `json:"autoImport,omitempty"`
+
+	// Determines if the pool is publicly advertisable. Only valid for IPv6
+	// pools.
+	// +immutable
+	// +optional
+	PubliclyAdvertisable *bool `json:"publiclyAdvertisable,omitempty"`
+
+	// The default netmask length of allocations from the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationDefaultNetmaskLength *int32 `json:"allocationDefaultNetmaskLength,omitempty"`
+
+	// The minimum netmask length of allocations from the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationMinNetmaskLength *int32 `json:"allocationMinNetmaskLength,omitempty"`
+
+	// The maximum netmask length of allocations from the pool.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=128
+	// +optional
+	AllocationMaxNetmaskLength *int32 `json:"allocationMaxNetmaskLength,omitempty"`
+
+	// Tags to add to the pool.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMPoolSpec defines the desired state of an IPAMPool.
+type IPAMPoolSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMPoolParameters `json:"forProvider"`
+}
+
+// IPAMPoolObservation keeps the state for the external resource
+type IPAMPoolObservation struct {
+	// The ID of the pool.
+	IPAMPoolID string `json:"ipamPoolId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the pool.
+	IPAMPoolARN string `json:"ipamPoolArn,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IPAM of the pool.
+	IPAMARN string `json:"ipamArn,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the scope of the pool.
+	IPAMScopeARN string `json:"ipamScopeArn,omitempty"`
+
+	// The type of the scope of the pool, either public or private.
+	IPAMScopeType string `json:"ipamScopeType,omitempty"`
+
+	// The depth of the pool in the pool hierarchy.
+	PoolDepth int32 `json:"poolDepth,omitempty"`
+
+	// The ID of the owner of the pool.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The state of the pool.
+	State string `json:"state,omitempty"`
+
+	// The state message.
+	StateMessage string `json:"stateMessage,omitempty"`
+}
+
+// An IPAMPoolStatus represents the observed state of an IPAMPool.
+type IPAMPoolStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IPAMPoolObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMPool is a managed resource that represents an AWS IPAM pool, a
+// collection of contiguous CIDRs from which VPCs and subnets are allocated
+// their CIDRs.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMPool struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMPoolSpec   `json:"spec"`
+	Status IPAMPoolStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMPoolList contains a list of IPAMPools
+type IPAMPoolList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMPool `json:"items"`
+}
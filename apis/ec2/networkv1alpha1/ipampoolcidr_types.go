@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// IPAMPoolCIDRParameters define the desired state of an IPAMPoolCIDR.
+type IPAMPoolCIDRParameters struct {
+	// Region is the region of the IPAM of the pool.
+	// +kubebuilder:validation:Required
+	Region *string `json:"region"`
+
+	// The ID of the pool to which the CIDR is provisioned.
+	// +immutable
+	// +crossplane:generate:reference:type=IPAMPool
+	// +optional
+	IPAMPoolID *string `json:"ipamPoolId,omitempty"`
+
+	// IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPAMPoolIDRef *xpv1.Reference `json:"ipamPoolIdRef,omitempty"`
+
+	// IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve its
+	// ID.
+	// +optional
+	IPAMPoolIDSelector *xpv1.Selector `json:"ipamPoolIdSelector,omitempty"`
+
+	// The CIDR to provision to the pool. For a nested pool it must be within
+	// a CIDR of the source pool.
+	// +immutable
+	CIDR string `json:"cidr"`
+}
+
+// An IPAMPoolCIDRSpec defines the desired state of an IPAMPoolCIDR.
+type IPAMPoolCIDRSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMPoolCIDRParameters `json:"forProvider"`
+}
+
+// IPAMPoolCIDRObservation keeps the state for the external resource
+type IPAMPoolCIDRObservation struct {
+	// The state of the CIDR.
+	State string `json:"state,omitempty"`
+
+	// The reason the CIDR could not be provisioned or deprovisioned.
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+// An IPAMPoolCIDRStatus represents the observed state of an IPAMPoolCIDR.
+type IPAMPoolCIDRStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IPAMPoolCIDRObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMPoolCIDR is a managed resource that represents a CIDR provisioned to
+// an AWS IPAM pool.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="CIDR",type="string",JSONPath=".spec.forProvider.cidr"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMPoolCIDR struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMPoolCIDRSpec   `json:"spec"`
+	Status IPAMPoolCIDRStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMPoolCIDRList contains a list of IPAMPoolCIDRs
+type IPAMPoolCIDRList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMPoolCIDR `json:"items"`
+}
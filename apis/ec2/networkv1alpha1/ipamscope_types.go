@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// IPAMScopeParameters define the desired state of an IPAMScope.
+type IPAMScopeParameters struct {
+	// Region is the region you'd like your IPAMScope to be created in. It
+	// must be the home region of the IPAM.
+	// +kubebuilder:validation:Required
+	Region *string `json:"region"`
+
+	// The ID of the IPAM for which to create the scope.
+	// +immutable
+	// +crossplane:generate:reference:type=IPAM
+	// +optional
+	IPAMID *string `json:"ipamId,omitempty"`
+
+	// IPAMIDRef references an IPAM to retrieve its ID.
+	// +optional
+	IPAMIDRef *xpv1.Reference `json:"ipamIdRef,omitempty"`
+
+	// IPAMIDSelector selects a reference to an IPAM to retrieve its ID.
+	// +optional
+	IPAMIDSelector *xpv1.Selector `json:"ipamIdSelector,omitempty"`
+
+	// A description for the scope.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// Tags to add to the scope.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An IPAMScopeSpec defines the desired state of an IPAMScope.
+type IPAMScopeSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IPAMScopeParameters `json:"forProvider"`
+}
+
+// IPAMScopeObservation keeps the state for the external resource
+type IPAMScopeObservation struct {
+	// The ID of the scope.
+	IPAMScopeID string `json:"ipamScopeId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the scope.
+	IPAMScopeARN string `json:"ipamScopeArn,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the IPAM of the scope.
+	IPAMARN string `json:"ipamArn,omitempty"`
+
+	// The type of the scope, either public or private.
+	IPAMScopeType string `json:"ipamScopeType,omitempty"`
+
+	// Indicates whether the scope is the default scope of the IPAM.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The ID of the owner of the scope.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The number of pools in the scope.
+	PoolCount int32 `json:"poolCount,omitempty"`
+
+	// The state of the scope.
+	State string `json:"state,omitempty"`
+}
+
+// An IPAMScopeStatus represents the observed state of an IPAMScope.
+type IPAMScopeStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IPAMScopeObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An IPAMScope is a managed resource that represents an AWS IPAM scope, the
+// highest-level container within an IPAM holding its pools.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type IPAMScope struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IPAMScopeSpec   `json:"spec"`
+	Status IPAMScopeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IPAMScopeList contains a list of IPAMScopes
+type IPAMScopeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []IPAMScope `json:"items"`
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkv1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// PrefixListEntry is an entry of a managed prefix list.
+type PrefixListEntry struct {
+	// The CIDR block of the entry.
+	CIDR string `json:"cidr"`
+
+	// A description for the entry.
+	//
+	// Constraints: Up to 255 characters in length.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ManagedPrefixListParameters define the desired state of a ManagedPrefixList.
+type ManagedPrefixListParameters struct {
+	// Region is the region you'd like your ManagedPrefixList to be created in.
+	// +kubebuilder:validation:Required
+	Region *string `json:"region"`
+
+	// A name for the prefix list.
+	//
+	// Constraints: Up to 255 characters in length. The name cannot start with
+	// com.amazonaws.
+	PrefixListName string `json:"prefixListName"`
+
+	// The IP address type of the entries.
+	// +kubebuilder:validation:Enum=IPv4;IPv6
+	// +immutable
+	AddressFamily string `json:"addressFamily"`
+
+	// The maximum number of entries for the prefix list. The size of a prefix
+	// list counts against the rule and route quotas of the security groups and
+	// route tables that reference it.
+	// +kubebuilder:validation:Minimum=1
+	MaxEntries int32 `json:"maxEntries"`
+
+	// The entries of the prefix list.
+	// +optional
+	Entries []PrefixListEntry `json:"entries,omitempty"`
+
+	// Tags to add to the prefix list.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A ManagedPrefixListSpec defines the desired state of a ManagedPrefixList.
+type ManagedPrefixListSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ManagedPrefixListParameters `json:"forProvider"`
+}
+
+// ManagedPrefixListObservation keeps the state for the external resource
+type ManagedPrefixListObservation struct {
+	// The ID of the prefix list.
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// The Amazon Resource Name (ARN) of the prefix list.
+	PrefixListARN string `json:"prefixListArn,omitempty"`
+
+	// The ID of the owner of the prefix list.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// The state of the prefix list.
+	State string `json:"state,omitempty"`
+
+	// The state message.
+	StateMessage string `json:"stateMessage,omitempty"`
+
+	// The version of the prefix list. Every modification of the entries
+	// creates a new version.
+	Version int64 `json:"version,omitempty"`
+}
+
+// A ManagedPrefixListStatus represents the observed state of a ManagedPrefixList.
+type ManagedPrefixListStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ManagedPrefixListObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A ManagedPrefixList is a managed resource that represents an AWS managed
+// prefix list, a set of CIDR blocks that can be referenced by security group
+// rules and routes.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="VERSION",type="integer",JSONPath=".status.atProvider.version"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type ManagedPrefixList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ManagedPrefixListSpec   `json:"spec"`
+	Status ManagedPrefixListStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ManagedPrefixListList contains a list of ManagedPrefixLists
+type ManagedPrefixListList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ManagedPrefixList `json:"items"`
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package networkv1alpha1 contains the ec2 v1alpha1 kinds that the v1beta1
+// VPC, Subnet and SecurityGroup reference. It must not import v1beta1.
+// +kubebuilder:object:generate=true
+// +groupName=ec2.aws.crossplane.io
+// +versionName=v1alpha1
+package networkv1alpha1
+
+import (
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/scheme"
+)
+
+// Package type metadata.
+const (
+	Group   = "ec2.aws.crossplane.io"
+	Version = "v1alpha1"
+)
+
+var (
+	// SchemeGroupVersion is group version used to register these objects
+	SchemeGroupVersion = schema.GroupVersion{Group: Group, Version: Version}
+
+	// SchemeBuilder is used to add go types to the GroupVersionKind scheme
+	SchemeBuilder = &scheme.Builder{GroupVersion: SchemeGroupVersion}
+)
+
+// ManagedPrefixList type metadata.
+var (
+	ManagedPrefixListKind             = reflect.TypeOf(ManagedPrefixList{}).Name()
+	ManagedPrefixListGroupKind        = schema.GroupKind{Group: Group, Kind: ManagedPrefixListKind}.String()
+	ManagedPrefixListKindAPIVersion   = ManagedPrefixListKind + "." + SchemeGroupVersion.String()
+	ManagedPrefixListGroupVersionKind = SchemeGroupVersion.WithKind(ManagedPrefixListKind)
+)
+
+// IPAM type metadata.
+var (
+	IPAMKind             = reflect.TypeOf(IPAM{}).Name()
+	IPAMGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMKind}.String()
+	IPAMKindAPIVersion   = IPAMKind + "." + SchemeGroupVersion.String()
+	IPAMGroupVersionKind = SchemeGroupVersion.WithKind(IPAMKind)
+)
+
+// IPAMScope type metadata.
+var (
+	IPAMScopeKind             = reflect.TypeOf(IPAMScope{}).Name()
+	IPAMScopeGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMScopeKind}.String()
+	IPAMScopeKindAPIVersion   = IPAMScopeKind + "." + SchemeGroupVersion.String()
+	IPAMScopeGroupVersionKind = SchemeGroupVersion.WithKind(IPAMScopeKind)
+)
+
+// IPAMPool type metadata.
+var (
+	IPAMPoolKind             = reflect.TypeOf(IPAMPool{}).Name()
+	IPAMPoolGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMPoolKind}.String()
+	IPAMPoolKindAPIVersion   = IPAMPoolKind + "." + SchemeGroupVersion.String()
+	IPAMPoolGroupVersionKind = SchemeGroupVersion.WithKind(IPAMPoolKind)
+)
+
+// IPAMPoolCIDR type metadata.
+var (
+	IPAMPoolCIDRKind             = reflect.TypeOf(IPAMPoolCIDR{}).Name()
+	IPAMPoolCIDRGroupKind        = schema.GroupKind{Group: Group, Kind: IPAMPoolCIDRKind}.String()
+	IPAMPoolCIDRKindAPIVersion   = IPAMPoolCIDRKind + "." + SchemeGroupVersion.String()
+	IPAMPoolCIDRGroupVersionKind = SchemeGroupVersion.WithKind(IPAMPoolCIDRKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ManagedPrefixList{}, &ManagedPrefixListList{})
+	SchemeBuilder.Register(&IPAM{}, &IPAMList{})
+	SchemeBuilder.Register(&IPAMScope{}, &IPAMScopeList{})
+	SchemeBuilder.Register(&IPAMPool{}, &IPAMPoolList{})
+	SchemeBuilder.Register(&IPAMPoolCIDR{}, &IPAMPoolCIDRList{})
+}
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // FlowLogParameters defines the desired state of FlowLog
@@ -116,8 +118,9 @@ type FlowLogObservation struct {
 
 // FlowLogStatus defines the observed state of FlowLog.
 type FlowLogStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FlowLogObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  FlowLogObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -2740,6 +2740,21 @@ func (in *CustomRouteParameters) DeepCopyInto(out *CustomRouteParameters) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DestinationPrefixListID != nil {
+		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPrefixListIDRef != nil {
+		in, out := &in.DestinationPrefixListIDRef, &out.DestinationPrefixListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DestinationPrefixListIDSelector != nil {
+		in, out := &in.DestinationPrefixListIDSelector, &out.DestinationPrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRouteParameters.
@@ -5425,6 +5440,7 @@ func (in *FlowLogSpec) DeepCopy() *FlowLogSpec {
 func (in *FlowLogStatus) DeepCopyInto(out *FlowLogStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -9714,6 +9730,7 @@ func (in *LaunchTemplateSpotMarketOptionsRequest) DeepCopy() *LaunchTemplateSpot
 func (in *LaunchTemplateStatus) DeepCopyInto(out *LaunchTemplateStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -9925,6 +9942,7 @@ func (in *LaunchTemplateVersionSpec) DeepCopy() *LaunchTemplateVersionSpec {
 func (in *LaunchTemplateVersionStatus) DeepCopyInto(out *LaunchTemplateVersionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -13835,11 +13853,6 @@ func (in *RouteParameters) DeepCopyInto(out *RouteParameters) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.DestinationPrefixListID != nil {
-		in, out := &in.DestinationPrefixListID, &out.DestinationPrefixListID
-		*out = new(string)
-		**out = **in
-	}
 	if in.EgressOnlyInternetGatewayID != nil {
 		in, out := &in.EgressOnlyInternetGatewayID, &out.EgressOnlyInternetGatewayID
 		*out = new(string)
@@ -13894,6 +13907,7 @@ func (in *RouteSpec) DeepCopy() *RouteSpec {
 func (in *RouteStatus) DeepCopyInto(out *RouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18249,6 +18263,7 @@ func (in *TransitGatewayRouteSpec) DeepCopy() *TransitGatewayRouteSpec {
 func (in *TransitGatewayRouteStatus) DeepCopyInto(out *TransitGatewayRouteStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18484,6 +18499,7 @@ func (in *TransitGatewayRouteTableSpec) DeepCopy() *TransitGatewayRouteTableSpec
 func (in *TransitGatewayRouteTableStatus) DeepCopyInto(out *TransitGatewayRouteTableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18619,6 +18635,7 @@ func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
 func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18840,6 +18857,7 @@ func (in *TransitGatewayVPCAttachmentSpec) DeepCopy() *TransitGatewayVPCAttachme
 func (in *TransitGatewayVPCAttachmentStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -19991,6 +20009,7 @@ func (in *VPCEndpointServiceConfigurationSpec) DeepCopy() *VPCEndpointServiceCon
 func (in *VPCEndpointServiceConfigurationStatus) DeepCopyInto(out *VPCEndpointServiceConfigurationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20025,6 +20044,7 @@ func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
 func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20430,6 +20450,7 @@ func (in *VPCPeeringConnectionStateReason) DeepCopy() *VPCPeeringConnectionState
 func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -21298,6 +21319,7 @@ func (in *VolumeSpec) DeepCopy() *VolumeSpec {
 func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
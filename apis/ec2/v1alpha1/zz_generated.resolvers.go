@@ -22,6 +22,7 @@ import (
 	"context"
 	v1alpha1 "github.com/crossplane-contrib/provider-aws/apis/cloudwatchlogs/v1alpha1"
 	manualv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/manualv1alpha1"
+	networkv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
 	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
 	v1alpha11 "github.com/crossplane-contrib/provider-aws/apis/elbv2/v1alpha1"
 	v1beta11 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
@@ -299,6 +300,22 @@ func (mg *Route) ResolveReferences(ctx context.Context, c client.Reader) error {
 	mg.Spec.ForProvider.CustomRouteParameters.GatewayID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.CustomRouteParameters.GatewayIDRef = rsp.ResolvedReference
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef,
+		Selector:     mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDSelector,
+		To: reference.To{
+			List:    &networkv1alpha1.ManagedPrefixListList{},
+			Managed: &networkv1alpha1.ManagedPrefixList{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID")
+	}
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.CustomRouteParameters.DestinationPrefixListIDRef = rsp.ResolvedReference
+
 	return nil
 }
 
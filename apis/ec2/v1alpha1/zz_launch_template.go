@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // LaunchTemplateParameters defines the desired state of LaunchTemplate
@@ -60,8 +62,9 @@ type LaunchTemplateObservation struct {
 
 // LaunchTemplateStatus defines the observed state of LaunchTemplate.
 type LaunchTemplateStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LaunchTemplateObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  LaunchTemplateObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
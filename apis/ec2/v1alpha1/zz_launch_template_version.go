@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // LaunchTemplateVersionParameters defines the desired state of LaunchTemplateVersion
@@ -61,8 +63,9 @@ type LaunchTemplateVersionObservation struct {
 
 // LaunchTemplateVersionStatus defines the observed state of LaunchTemplateVersion.
 type LaunchTemplateVersionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LaunchTemplateVersionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  LaunchTemplateVersionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // RouteParameters defines the desired state of Route
@@ -42,8 +44,6 @@ type RouteParameters struct {
 	// The IPv6 CIDR block used for the destination match. Routing decisions are
 	// based on the most specific match.
 	DestinationIPv6CIDRBlock *string `json:"destinationIPv6CIDRBlock,omitempty"`
-	// The ID of a prefix list used for the destination match.
-	DestinationPrefixListID *string `json:"destinationPrefixListID,omitempty"`
 	// [IPv6 traffic only] The ID of an egress-only internet gateway.
 	EgressOnlyInternetGatewayID *string `json:"egressOnlyInternetGatewayID,omitempty"`
 	// The ID of the local gateway.
@@ -69,8 +69,9 @@ type RouteObservation struct {
 
 // RouteStatus defines the observed state of Route.
 type RouteStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RouteObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TransitGatewayParameters defines the desired state of TransitGateway
@@ -62,8 +64,9 @@ type TransitGatewayObservation struct {
 
 // TransitGatewayStatus defines the observed state of TransitGateway.
 type TransitGatewayStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TransitGatewayObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TransitGatewayObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TransitGatewayRouteTableParameters defines the desired state of TransitGatewayRouteTable
@@ -62,8 +64,9 @@ type TransitGatewayRouteTableObservation struct {
 
 // TransitGatewayRouteTableStatus defines the observed state of TransitGatewayRouteTable.
 type TransitGatewayRouteTableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TransitGatewayRouteTableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TransitGatewayRouteTableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
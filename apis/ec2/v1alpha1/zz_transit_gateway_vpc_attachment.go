@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TransitGatewayVPCAttachmentParameters defines the desired state of TransitGatewayVPCAttachment
@@ -65,8 +67,9 @@ type TransitGatewayVPCAttachmentObservation struct {
 
 // TransitGatewayVPCAttachmentStatus defines the observed state of TransitGatewayVPCAttachment.
 type TransitGatewayVPCAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TransitGatewayVPCAttachmentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TransitGatewayVPCAttachmentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // VolumeParameters defines the desired state of Volume
@@ -144,8 +146,9 @@ type VolumeObservation struct {
 
 // VolumeStatus defines the observed state of Volume.
 type VolumeStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          VolumeObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  VolumeObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
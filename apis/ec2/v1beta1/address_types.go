@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AddressParameters define the desired state of an AWS Elastic IP
@@ -125,8 +127,9 @@ type AddressObservation struct {
 
 // An AddressStatus represents the observed state of an Address.
 type AddressStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AddressObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AddressObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
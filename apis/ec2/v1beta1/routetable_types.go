@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ToDo (haarchri): changed Route to RouteBeta otherwise we got error "CRD for Route.ec2.aws.crossplane.io has no storage version"
@@ -231,8 +233,9 @@ type RouteTableObservation struct {
 
 // A RouteTableStatus represents the observed state of a RouteTable.
 type RouteTableStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RouteTableObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RouteTableObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,19 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// Rule management policies of a SecurityGroup.
+const (
+	// RuleManagementPolicyAuthoritative revokes every rule of the security
+	// group that is not part of its ingress or egress.
+	RuleManagementPolicyAuthoritative = "Authoritative"
+
+	// RuleManagementPolicyAdditive only revokes rules that were authorized by
+	// the SecurityGroup itself.
+	RuleManagementPolicyAdditive = "Additive"
 )
 
 // SecurityGroupParameters define the desired state of an AWS VPC Security
@@ -73,6 +86,16 @@ type SecurityGroupParameters struct {
 
 	// Dont manage the egress settings for the created resource
 	IgnorEgress *bool `json:"ignoreEgress,omitempty"`
+
+	// RuleManagementPolicy determines which rules of the security group are
+	// managed by this resource. Authoritative, the default, revokes all rules
+	// that are not part of ingress and egress. Additive only revokes rules
+	// that were authorized by this resource and have since been removed from
+	// ingress or egress, so that rules added by SecurityGroupRule resources or
+	// other tools are left in place.
+	// +kubebuilder:validation:Enum=Authoritative;Additive
+	// +optional
+	RuleManagementPolicy *string `json:"ruleManagementPolicy,omitempty"`
 }
 
 // IPRange describes an IPv4 range.
@@ -116,7 +139,18 @@ type PrefixListID struct {
 	Description *string `json:"description,omitempty"`
 
 	// The ID of the prefix.
-	PrefixListID string `json:"prefixListId"`
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1.ManagedPrefixList
+	PrefixListID string `json:"prefixListId,omitempty"`
+
+	// PrefixListIDRef references a ManagedPrefixList to retrieve its ID.
+	// +optional
+	PrefixListIDRef *xpv1.Reference `json:"prefixListIdRef,omitempty"`
+
+	// PrefixListIDSelector selects a reference to a ManagedPrefixList to
+	// retrieve its ID.
+	// +optional
+	PrefixListIDSelector *xpv1.Selector `json:"prefixListIdSelector,omitempty"`
 }
 
 // UserIDGroupPair describes a security group and AWS account ID pair.
@@ -253,12 +287,23 @@ type SecurityGroupObservation struct {
 
 	// SecurityGroupID is the ID of the SecurityGroup.
 	SecurityGroupID string `json:"securityGroupID"`
+
+	// OwnedIngressRules identifies the ingress rules that are owned by this
+	// resource, one entry per protocol, port range and source.
+	// +optional
+	OwnedIngressRules []string `json:"ownedIngressRules,omitempty"`
+
+	// OwnedEgressRules identifies the egress rules that are owned by this
+	// resource, one entry per protocol, port range and destination.
+	// +optional
+	OwnedEgressRules []string `json:"ownedEgressRules,omitempty"`
 }
 
 // A SecurityGroupStatus represents the observed state of a SecurityGroup.
 type SecurityGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SecurityGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SecurityGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
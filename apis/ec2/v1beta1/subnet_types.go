@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SubnetParameters define the desired state of an AWS VPC Subnet.
@@ -34,8 +36,35 @@ type SubnetParameters struct {
 	Region *string `json:"region,omitempty"`
 
 	// CIDRBlock is the IPv4 network range for the Subnet, in CIDR notation. For example, 10.0.0.0/18.
+	// Either CIDRBlock or IPv4IPAMPoolID has to be set. If the CIDR is
+	// allocated from an IPAM pool, it is filled in once the Subnet is created.
+	// +optional
+	// +immutable
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// The ID of an IPv4 IPAM pool from which to allocate the CIDR of the
+	// Subnet. The allocation is released when the Subnet is deleted.
+	// +optional
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1.IPAMPool
+	IPv4IPAMPoolID *string `json:"ipv4IpamPoolId,omitempty"`
+
+	// IPv4IPAMPoolIDRef references an IPAMPool to retrieve its ID.
+	// +optional
+	IPv4IPAMPoolIDRef *xpv1.Reference `json:"ipv4IpamPoolIdRef,omitempty"`
+
+	// IPv4IPAMPoolIDSelector selects a reference to an IPAMPool to retrieve
+	// its ID.
+	// +optional
+	IPv4IPAMPoolIDSelector *xpv1.Selector `json:"ipv4IpamPoolIdSelector,omitempty"`
+
+	// The netmask length of the CIDR to allocate from the IPAM pool. Defaults
+	// to the allocation default netmask length of the pool.
+	// +kubebuilder:validation:Minimum=16
+	// +kubebuilder:validation:Maximum=28
+	// +optional
 	// +immutable
-	CIDRBlock string `json:"cidrBlock"`
+	IPv4NetmaskLength *int32 `json:"ipv4NetmaskLength,omitempty"`
 
 	// The Availability Zone for the subnet.
 	// Default: AWS selects one for you. If you create more than one subnet in your
@@ -109,8 +138,9 @@ type SubnetObservation struct {
 
 // A SubnetStatus represents the observed state of a Subnet.
 type SubnetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SubnetObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SubnetObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
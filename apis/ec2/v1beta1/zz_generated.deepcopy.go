@@ -166,6 +166,7 @@ func (in *AddressSpec) DeepCopy() *AddressSpec {
 func (in *AddressStatus) DeepCopyInto(out *AddressStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -477,6 +478,7 @@ func (in *InternetGatewaySpec) DeepCopy() *InternetGatewaySpec {
 func (in *InternetGatewayStatus) DeepCopyInto(out *InternetGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -663,6 +665,7 @@ func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
 func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -684,6 +687,16 @@ func (in *PrefixListID) DeepCopyInto(out *PrefixListID) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PrefixListIDRef != nil {
+		in, out := &in.PrefixListIDRef, &out.PrefixListIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PrefixListIDSelector != nil {
+		in, out := &in.PrefixListIDSelector, &out.PrefixListIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListID.
@@ -957,6 +970,7 @@ func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
 func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1032,6 +1046,16 @@ func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupObservation) DeepCopyInto(out *SecurityGroupObservation) {
 	*out = *in
+	if in.OwnedIngressRules != nil {
+		in, out := &in.OwnedIngressRules, &out.OwnedIngressRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OwnedEgressRules != nil {
+		in, out := &in.OwnedEgressRules, &out.OwnedEgressRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupObservation.
@@ -1096,6 +1120,11 @@ func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.RuleManagementPolicy != nil {
+		in, out := &in.RuleManagementPolicy, &out.RuleManagementPolicy
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupParameters.
@@ -1129,7 +1158,8 @@ func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
 func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
@@ -1224,6 +1254,26 @@ func (in *SubnetParameters) DeepCopyInto(out *SubnetParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolIDRef != nil {
+		in, out := &in.IPv4IPAMPoolIDRef, &out.IPv4IPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4IPAMPoolIDSelector != nil {
+		in, out := &in.IPv4IPAMPoolIDSelector, &out.IPv4IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.AvailabilityZone != nil {
 		in, out := &in.AvailabilityZone, &out.AvailabilityZone
 		*out = new(string)
@@ -1302,6 +1352,7 @@ func (in *SubnetSpec) DeepCopy() *SubnetSpec {
 func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1605,6 +1656,7 @@ func (in *VPCCIDRBlockState) DeepCopy() *VPCCIDRBlockState {
 func (in *VPCCIDRBlockStatus) DeepCopyInto(out *VPCCIDRBlockStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -1699,6 +1751,26 @@ func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv4IPAMPoolID != nil {
+		in, out := &in.IPv4IPAMPoolID, &out.IPv4IPAMPoolID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv4IPAMPoolIDRef != nil {
+		in, out := &in.IPv4IPAMPoolIDRef, &out.IPv4IPAMPoolIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4IPAMPoolIDSelector != nil {
+		in, out := &in.IPv4IPAMPoolIDSelector, &out.IPv4IPAMPoolIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IPv4NetmaskLength != nil {
+		in, out := &in.IPv4NetmaskLength, &out.IPv4NetmaskLength
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Ipv6CIDRBlock != nil {
 		in, out := &in.Ipv6CIDRBlock, &out.Ipv6CIDRBlock
 		*out = new(string)
@@ -1767,6 +1839,7 @@ func (in *VPCSpec) DeepCopy() *VPCSpec {
 func (in *VPCStatus) DeepCopyInto(out *VPCStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1beta1
 
 import (
 	"context"
+	networkv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -180,6 +181,26 @@ func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader)
 	var rsp reference.ResolutionResponse
 	var err error
 
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Ingress); i3++ {
+		for i4 := 0; i4 < len(mg.Spec.ForProvider.Ingress[i3].PrefixListIDs); i4++ {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListID,
+				Extract:      reference.ExternalName(),
+				Reference:    mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListIDRef,
+				Selector:     mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListIDSelector,
+				To: reference.To{
+					List:    &networkv1alpha1.ManagedPrefixListList{},
+					Managed: &networkv1alpha1.ManagedPrefixList{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListID")
+			}
+			mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListID = rsp.ResolvedValue
+			mg.Spec.ForProvider.Ingress[i3].PrefixListIDs[i4].PrefixListIDRef = rsp.ResolvedReference
+
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Ingress); i3++ {
 		for i4 := 0; i4 < len(mg.Spec.ForProvider.Ingress[i3].UserIDGroupPairs); i4++ {
 			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
@@ -220,6 +241,26 @@ func (mg *SecurityGroup) ResolveReferences(ctx context.Context, c client.Reader)
 
 		}
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.Egress); i3++ {
+		for i4 := 0; i4 < len(mg.Spec.ForProvider.Egress[i3].PrefixListIDs); i4++ {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListID,
+				Extract:      reference.ExternalName(),
+				Reference:    mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListIDRef,
+				Selector:     mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListIDSelector,
+				To: reference.To{
+					List:    &networkv1alpha1.ManagedPrefixListList{},
+					Managed: &networkv1alpha1.ManagedPrefixList{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListID")
+			}
+			mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListID = rsp.ResolvedValue
+			mg.Spec.ForProvider.Egress[i3].PrefixListIDs[i4].PrefixListIDRef = rsp.ResolvedReference
+
+		}
+	}
 	for i3 := 0; i3 < len(mg.Spec.ForProvider.Egress); i3++ {
 		for i4 := 0; i4 < len(mg.Spec.ForProvider.Egress[i3].UserIDGroupPairs); i4++ {
 			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
@@ -286,6 +327,22 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 	var rsp reference.ResolutionResponse
 	var err error
 
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IPv4IPAMPoolID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.IPv4IPAMPoolIDRef,
+		Selector:     mg.Spec.ForProvider.IPv4IPAMPoolIDSelector,
+		To: reference.To{
+			List:    &networkv1alpha1.IPAMPoolList{},
+			Managed: &networkv1alpha1.IPAMPool{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.IPv4IPAMPoolID")
+	}
+	mg.Spec.ForProvider.IPv4IPAMPoolID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IPv4IPAMPoolIDRef = rsp.ResolvedReference
+
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.VPCID),
 		Extract:      reference.ExternalName(),
@@ -305,6 +362,32 @@ func (mg *Subnet) ResolveReferences(ctx context.Context, c client.Reader) error
 	return nil
 }
 
+// ResolveReferences of this VPC.
+func (mg *VPC) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.IPv4IPAMPoolID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.IPv4IPAMPoolIDRef,
+		Selector:     mg.Spec.ForProvider.IPv4IPAMPoolIDSelector,
+		To: reference.To{
+			List:    &networkv1alpha1.IPAMPoolList{},
+			Managed: &networkv1alpha1.IPAMPool{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.IPv4IPAMPoolID")
+	}
+	mg.Spec.ForProvider.IPv4IPAMPoolID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.IPv4IPAMPoolIDRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this VPCCIDRBlock.
 func (mg *VPCCIDRBlock) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
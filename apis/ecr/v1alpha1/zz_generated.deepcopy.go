@@ -328,6 +328,7 @@ func (in *LifecyclePolicySpec) DeepCopy() *LifecyclePolicySpec {
 func (in *LifecyclePolicyStatus) DeepCopyInto(out *LifecyclePolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -683,6 +684,7 @@ func (in *RepositoryPolicyStatement) DeepCopy() *RepositoryPolicyStatement {
 func (in *RepositoryPolicyStatus) DeepCopyInto(out *RepositoryPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -754,6 +756,7 @@ func (in *RepositorySpec) DeepCopy() *RepositorySpec {
 func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // LifecyclePolicyParameters defines the desired state of LifecyclePolicy
@@ -53,8 +55,9 @@ type LifecyclePolicyObservation struct {
 
 // LifecyclePolicyStatus defines the observed state of LifecyclePolicy.
 type LifecyclePolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          LifecyclePolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  LifecyclePolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ServiceParameters defines the desired state of Service
@@ -257,8 +259,9 @@ type ServiceObservation struct {
 
 // ServiceStatus defines the observed state of Service.
 type ServiceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ServiceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ServiceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TaskDefinitionParameters defines the desired state of TaskDefinition
@@ -260,8 +262,9 @@ type TaskDefinitionObservation struct {
 
 // TaskDefinitionStatus defines the observed state of TaskDefinition.
 type TaskDefinitionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TaskDefinitionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TaskDefinitionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
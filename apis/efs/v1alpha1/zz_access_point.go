@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AccessPointParameters defines the desired state of AccessPoint
@@ -79,8 +81,9 @@ type AccessPointObservation struct {
 
 // AccessPointStatus defines the observed state of AccessPoint.
 type AccessPointStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AccessPointObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AccessPointObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
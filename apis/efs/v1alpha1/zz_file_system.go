@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // FileSystemParameters defines the desired state of FileSystem
@@ -153,8 +155,9 @@ type FileSystemObservation struct {
 
 // FileSystemStatus defines the observed state of FileSystem.
 type FileSystemStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FileSystemObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  FileSystemObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -264,6 +264,7 @@ func (in *AccessPointSpec) DeepCopy() *AccessPointSpec {
 func (in *AccessPointStatus) DeepCopyInto(out *AccessPointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -764,6 +765,7 @@ func (in *FileSystemSpec) DeepCopy() *FileSystemSpec {
 func (in *FileSystemStatus) DeepCopyInto(out *FileSystemStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1003,6 +1005,7 @@ func (in *MountTargetSpec) DeepCopy() *MountTargetSpec {
 func (in *MountTargetStatus) DeepCopyInto(out *MountTargetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // MountTargetParameters defines the desired state of MountTarget
@@ -71,8 +73,9 @@ type MountTargetObservation struct {
 
 // MountTargetStatus defines the observed state of MountTarget.
 type MountTargetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          MountTargetObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  MountTargetObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
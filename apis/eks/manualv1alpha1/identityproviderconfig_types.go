@@ -19,6 +19,8 @@ package manualv1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // IdentityProviderConfigType is a type of IdentityProviderConfig
@@ -148,8 +150,9 @@ type IdentityProviderConfigSpec struct {
 
 // An IdentityProviderConfigStatus represents the observed state of an EKS associated identity provider.
 type IdentityProviderConfigStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          IdentityProviderConfigObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  IdentityProviderConfigObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
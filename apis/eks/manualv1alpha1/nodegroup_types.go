@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // NodeGroupStatusType is a type of NodeGroup status.
@@ -492,8 +494,9 @@ type NodeGroupSpec struct {
 
 // A NodeGroupStatus represents the observed state of an EKS NodeGroup.
 type NodeGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          NodeGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  NodeGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
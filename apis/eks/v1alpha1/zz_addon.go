@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AddonParameters defines the desired state of Addon
@@ -81,8 +83,9 @@ type AddonObservation struct {
 
 // AddonStatus defines the observed state of Addon.
 type AddonStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AddonObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AddonObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
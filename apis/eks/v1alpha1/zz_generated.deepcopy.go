@@ -287,6 +287,7 @@ func (in *AddonSpec) DeepCopy() *AddonSpec {
 func (in *AddonStatus) DeepCopyInto(out *AddonStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // FargateProfileStatusType is a type of FargateProfile status.
@@ -154,8 +156,9 @@ type FargateProfileSpec struct {
 
 // A FargateProfileStatus represents the observed state of an EKS FargateProfile.
 type FargateProfileStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FargateProfileObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  FargateProfileObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
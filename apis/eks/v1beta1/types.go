@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ClusterStatusType is the status of an EKS cluster.
@@ -303,12 +305,54 @@ type VpcConfigResponse struct {
 type ClusterSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
 	ForProvider       ClusterParameters `json:"forProvider"`
+
+	// Kubeconfig configures the kubeconfig that is written to the connection
+	// secret of the cluster.
+	// +optional
+	Kubeconfig *Kubeconfig `json:"kubeconfig,omitempty"`
+}
+
+// A KubeconfigMode determines how the kubeconfig in the connection secret of
+// a cluster authenticates.
+type KubeconfigMode string
+
+// Kubeconfig modes.
+const (
+	// KubeconfigModeToken authenticates with a token that is valid for 15
+	// minutes. The token is refreshed every time the cluster is observed,
+	// which happens at least every 10 minutes.
+	KubeconfigModeToken KubeconfigMode = "Token"
+
+	// KubeconfigModeExec authenticates with a token that is returned by
+	// `aws eks get-token`. The AWS CLI must be installed and configured
+	// wherever the kubeconfig is used.
+	KubeconfigModeExec KubeconfigMode = "Exec"
+)
+
+// Kubeconfig configures the kubeconfig that is written to the connection
+// secret of a cluster.
+type Kubeconfig struct {
+	// Mode determines how the kubeconfig authenticates.
+	// +kubebuilder:validation:Enum=Token;Exec
+	// +kubebuilder:default=Token
+	// +optional
+	Mode KubeconfigMode `json:"mode,omitempty"`
+
+	// RoleARN is the ARN of an IAM role the kubeconfig authenticates as. In
+	// Token mode the provider assumes the role to create the token, with the
+	// identity it manages the cluster with and the role session options of
+	// the ProviderConfig. In Exec mode the role is assumed by `aws eks
+	// get-token`. Defaults to the identity the provider manages the cluster
+	// with.
+	// +optional
+	RoleARN *string `json:"roleARN,omitempty"`
 }
 
 // A ClusterStatus represents the observed state of an EKS Cluster.
 type ClusterStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClusterObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ClusterObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CacheParameterGroupParameters defines the desired state of CacheParameterGroup
@@ -63,8 +65,9 @@ type CacheParameterGroupObservation struct {
 
 // CacheParameterGroupStatus defines the observed state of CacheParameterGroup.
 type CacheParameterGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CacheParameterGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CacheParameterGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
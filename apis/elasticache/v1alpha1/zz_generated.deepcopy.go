@@ -492,6 +492,7 @@ func (in *CacheParameterGroupSpec) DeepCopy() *CacheParameterGroupSpec {
 func (in *CacheParameterGroupStatus) DeepCopyInto(out *CacheParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ELBAttachmentParameters define the desired state of an AWS ELBAttachment.
@@ -58,8 +60,9 @@ type ELBAttachmentObservation struct {
 
 // An ELBAttachmentStatus represents the observed state of an ELBAttachmentAttachment.
 type ELBAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ELBAttachmentObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ELBAttachmentObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Tag defines a key value pair that can be attached to an ELB
@@ -165,8 +167,9 @@ type ELBObservation struct {
 
 // An ELBStatus represents the observed state of an ELB.
 type ELBStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ELBObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ELBObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
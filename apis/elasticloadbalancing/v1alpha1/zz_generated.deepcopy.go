@@ -193,6 +193,7 @@ func (in *ELBAttachmentSpec) DeepCopy() *ELBAttachmentSpec {
 func (in *ELBAttachmentStatus) DeepCopyInto(out *ELBAttachmentStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -359,6 +360,7 @@ func (in *ELBSpec) DeepCopy() *ELBSpec {
 func (in *ELBStatus) DeepCopyInto(out *ELBStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
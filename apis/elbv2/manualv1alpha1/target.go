@@ -19,6 +19,8 @@ package manualv1alpha1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Defines the possible state of a target.
@@ -197,8 +199,9 @@ func (s *TargetObservation) GetState() string {
 // TargetStatus defines the observed state of a
 // Target
 type TargetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TargetObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TargetObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
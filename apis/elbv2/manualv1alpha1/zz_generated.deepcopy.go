@@ -216,6 +216,7 @@ func (in *TargetSpec) DeepCopy() *TargetSpec {
 func (in *TargetStatus) DeepCopyInto(out *TargetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -811,6 +811,7 @@ func (in *ListenerSpec) DeepCopy() *ListenerSpec {
 func (in *ListenerStatus) DeepCopyInto(out *ListenerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1182,6 +1183,7 @@ func (in *LoadBalancerState) DeepCopy() *LoadBalancerState {
 func (in *LoadBalancerStatus) DeepCopyInto(out *LoadBalancerStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1726,6 +1728,7 @@ func (in *TargetGroupSpec) DeepCopy() *TargetGroupSpec {
 func (in *TargetGroupStatus) DeepCopyInto(out *TargetGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
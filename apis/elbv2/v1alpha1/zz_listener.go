@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ListenerParameters defines the desired state of Listener
@@ -86,8 +88,9 @@ type ListenerObservation struct {
 
 // ListenerStatus defines the observed state of Listener.
 type ListenerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ListenerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ListenerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // TargetGroupParameters defines the desired state of TargetGroup
@@ -143,8 +145,9 @@ type TargetGroupObservation struct {
 
 // TargetGroupStatus defines the observed state of TargetGroup.
 type TargetGroupStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TargetGroupObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TargetGroupObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ClassifierParameters defines the desired state of Classifier
@@ -50,8 +52,9 @@ type ClassifierObservation struct {
 
 // ClassifierStatus defines the observed state of Classifier.
 type ClassifierStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ClassifierObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ClassifierObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
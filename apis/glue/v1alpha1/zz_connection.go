@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ConnectionParameters defines the desired state of Connection
@@ -55,8 +57,9 @@ type ConnectionObservation struct {
 
 // ConnectionStatus defines the observed state of Connection.
 type ConnectionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ConnectionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ConnectionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // CrawlerParameters defines the desired state of Crawler
@@ -85,8 +87,9 @@ type CrawlerObservation struct {
 
 // CrawlerStatus defines the observed state of Crawler.
 type CrawlerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          CrawlerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  CrawlerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
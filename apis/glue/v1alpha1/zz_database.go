@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DatabaseParameters defines the desired state of Database
@@ -49,8 +51,9 @@ type DatabaseObservation struct {
 
 // DatabaseStatus defines the observed state of Database.
 type DatabaseStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DatabaseObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DatabaseObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
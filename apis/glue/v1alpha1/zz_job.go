@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // JobParameters defines the desired state of Job
@@ -147,8 +149,9 @@ type JobObservation struct {
 
 // JobStatus defines the observed state of Job.
 type JobStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          JobObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  JobObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SecurityConfigurationParameters defines the desired state of SecurityConfiguration
@@ -48,8 +50,9 @@ type SecurityConfigurationObservation struct {
 
 // SecurityConfigurationStatus defines the observed state of SecurityConfiguration.
 type SecurityConfigurationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SecurityConfigurationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SecurityConfigurationObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -491,6 +491,7 @@ func (in *InstanceProfileSpec) DeepCopy() *InstanceProfileSpec {
 func (in *InstanceProfileStatus) DeepCopyInto(out *InstanceProfileStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // InstanceProfileParameters defines the desired state of InstanceProfile
@@ -78,8 +80,9 @@ type InstanceProfileObservation struct {
 
 // InstanceProfileStatus defines the observed state of InstanceProfile.
 type InstanceProfileStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          InstanceProfileObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  InstanceProfileObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
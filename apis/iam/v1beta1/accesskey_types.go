@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AccessKeyParameters define the desired state of an AWS IAM Access Key.
@@ -52,7 +54,8 @@ type AccessKeySpec struct {
 
 // AccessKeyStatus represents the observed state of an IAM Access Key.
 type AccessKeyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // PolicyParameters define the desired state of an AWS IAM Policy.
@@ -73,12 +75,30 @@ type PolicyObservation struct {
 
 	// The stable and unique string identifying the policy.
 	PolicyID string `json:"policyId,omitempty"`
+
+	// The versions of the policy. IAM keeps at most five versions of a
+	// policy, the oldest non-default version is deleted before a new one
+	// is created.
+	Versions []PolicyVersion `json:"versions,omitempty"`
+}
+
+// PolicyVersion describes a version of a managed policy.
+type PolicyVersion struct {
+	// The identifier for the policy version.
+	VersionID string `json:"versionId"`
+
+	// Specifies whether the policy version is set as the policy's default version.
+	IsDefaultVersion bool `json:"isDefaultVersion,omitempty"`
+
+	// The date and time when the policy version was created.
+	CreateDate *metav1.Time `json:"createDate,omitempty"`
 }
 
 // An PolicyStatus represents the observed state of an Policy.
 type PolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  PolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
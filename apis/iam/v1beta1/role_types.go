@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Tag represents user-provided metadata that can be associated
@@ -103,8 +105,9 @@ type RoleExternalStatus struct {
 
 // An RoleStatus represents the observed state of an Role.
 type RoleStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RoleExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RoleExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
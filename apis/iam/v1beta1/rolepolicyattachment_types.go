@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // RolePolicyAttachmentParameters define the desired state of an AWS IAM
@@ -73,8 +75,9 @@ type RolePolicyAttachmentExternalStatus struct {
 // An RolePolicyAttachmentStatus represents the observed state of an
 // RolePolicyAttachment.
 type RolePolicyAttachmentStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          RolePolicyAttachmentExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  RolePolicyAttachmentExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // PolicyParameters defines the desired state of Policy
@@ -60,8 +62,9 @@ type PolicyObservation struct {
 
 // PolicyStatus defines the observed state of Policy.
 type PolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          PolicyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  PolicyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
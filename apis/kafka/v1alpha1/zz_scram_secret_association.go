@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ScramSecretAssociationParameters defines the desired state of ScramSecretAssociation
@@ -46,8 +48,9 @@ type ScramSecretAssociationObservation struct {
 
 // ScramSecretAssociationStatus defines the observed state of ScramSecretAssociation.
 type ScramSecretAssociationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ScramSecretAssociationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ScramSecretAssociationObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // StreamParameters defines the desired state of Stream
@@ -100,8 +102,9 @@ type StreamObservation struct {
 
 // StreamStatus defines the observed state of Stream.
 type StreamStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          StreamObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  StreamObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -23,6 +23,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AliasParameters defines the desired state of Alias
@@ -73,8 +75,9 @@ type AliasObservation struct {
 
 // AliasStatus defines the observed state of Alias.
 type AliasStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AliasObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AliasObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
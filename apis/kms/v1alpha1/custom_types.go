@@ -1,5 +1,9 @@
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 // CustomKeyParameters are custom parameters for Key.
 type CustomKeyParameters struct {
 	// Specifies whether the CMK is enabled.
@@ -8,6 +12,203 @@ type CustomKeyParameters struct {
 	// Specifies how many days the Key is retained when scheduled for deletion. Defaults to 30 days.
 	PendingWindowInDays *int64 `json:"pendingWindowInDays,omitempty"`
 
-	// Specifies if key rotation is enabled for the corresponding key
+	// Specifies if key rotation is enabled for the corresponding key.
+	// Rotation is left untouched if this field is not set.
 	EnableKeyRotation *bool `json:"enableKeyRotation,omitempty"`
+
+	// KeyPolicy is a structured alternative to Policy. Statements and
+	// principals are compared independent of their order. Only one of
+	// policy or keyPolicy should be specified.
+	// +optional
+	KeyPolicy *KeyPolicyBody `json:"keyPolicy,omitempty"`
+
+	// ReplicaRegions is the list of regions a replica of this multi-Region
+	// key is created in. Requires multiRegion to be true.
+	// Replica keys are not deleted when a region is removed from this list,
+	// they have to be scheduled for deletion in their own region.
+	// +optional
+	ReplicaRegions []string `json:"replicaRegions,omitempty"`
+
+	// Grants is the list of grants on this key. Grants are identified by
+	// their name. If set, grants that were created for this list and are no
+	// longer in it are revoked, while other grants are left untouched.
+	// +optional
+	Grants []KeyGrant `json:"grants,omitempty"`
+}
+
+// KeyGrant defines a grant on a Key.
+type KeyGrant struct {
+	// Name is a friendly name for the grant. It is used to identify the grant
+	// and has to be unique within the key.
+	Name string `json:"name"`
+
+	// GranteePrincipal is the identity that gets the permissions specified
+	// in the grant.
+	GranteePrincipal string `json:"granteePrincipal"`
+
+	// Operations is the list of grant operations that the grant permits.
+	// +kubebuilder:validation:MinItems=1
+	Operations []string `json:"operations"`
+
+	// RetiringPrincipal is the principal that has permission to use the
+	// RetireGrant operation to retire the grant.
+	// +optional
+	RetiringPrincipal *string `json:"retiringPrincipal,omitempty"`
+
+	// Constraints specifies a grant constraint based on the encryption
+	// context of a cryptographic operation.
+	// +optional
+	Constraints *KeyGrantConstraints `json:"constraints,omitempty"`
+}
+
+// KeyGrantConstraints defines the encryption context constraints of a
+// KeyGrant. Only one of the fields should be set.
+type KeyGrantConstraints struct {
+	// EncryptionContextEquals is the encryption context that must be present
+	// in a request, without any additional pairs.
+	// +optional
+	EncryptionContextEquals map[string]string `json:"encryptionContextEquals,omitempty"`
+
+	// EncryptionContextSubset is a list of key-value pairs that must be
+	// included in the encryption context of a request.
+	// +optional
+	EncryptionContextSubset map[string]string `json:"encryptionContextSubset,omitempty"`
+}
+
+// KeyPolicyBody represents the key policy of a Key.
+type KeyPolicyBody struct {
+	// Version is the current IAM policy version
+	// +kubebuilder:validation:Enum="2012-10-17";"2008-10-17"
+	// +kubebuilder:default:="2012-10-17"
+	Version string `json:"version"`
+
+	// ID is the policy's optional identifier
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// Statements is the list of statement this policy applies
+	// +optional
+	Statements []KeyPolicyStatement `json:"statements,omitempty"`
+}
+
+// KeyPolicyStatement defines an individual statement within the
+// KeyPolicyBody
+type KeyPolicyStatement struct {
+	// Optional identifier for this statement, must be unique within the
+	// policy if provided.
+	// +optional
+	SID *string `json:"sid,omitempty"`
+
+	// The effect is required and specifies whether the statement results
+	// in an allow or an explicit deny. Valid values for Effect are Allow and Deny.
+	// +kubebuilder:validation:Enum=Allow;Deny
+	Effect string `json:"effect"`
+
+	// Used with the key policy to specify the principal that is allowed
+	// or denied access to a resource.
+	// +optional
+	Principal *KeyPolicyPrincipal `json:"principal,omitempty"`
+
+	// Used with the key policy to specify the users which are not included
+	// in this policy
+	// +optional
+	NotPrincipal *KeyPolicyPrincipal `json:"notPrincipal,omitempty"`
+
+	// Each element of the PolicyAction array describes the specific
+	// action or actions that will be allowed or denied with this PolicyStatement.
+	// +optional
+	Action []string `json:"action,omitempty"`
+
+	// Each element of the NotPolicyAction array will allow the property to match
+	// all but the listed actions.
+	// +optional
+	NotAction []string `json:"notAction,omitempty"`
+
+	// The resources on which this statement will apply. In a key policy this
+	// is usually "*", which refers to the key itself.
+	// +optional
+	Resource []string `json:"resource,omitempty"`
+
+	// This will explicitly match all resources except the ones
+	// specified in this array
+	// +optional
+	NotResource []string `json:"notResource,omitempty"`
+
+	// Condition specifies where conditions for policy are in effect.
+	// https://docs.aws.amazon.com/kms/latest/developerguide/policy-conditions.html
+	// +optional
+	Condition []Condition `json:"condition,omitempty"`
+}
+
+// KeyPolicyPrincipal defines the principal users affected by
+// the KeyPolicyStatement
+// Please see the AWS KMS docs for more information
+// https://docs.aws.amazon.com/kms/latest/developerguide/key-policy-overview.html
+type KeyPolicyPrincipal struct {
+	// This flag indicates if the policy should be made available
+	// to all anonymous users. Principal: "*"
+	// +optional
+	AllowAnon *bool `json:"allowAnon,omitempty"`
+
+	// This list contains the all of the AWS IAM users which are affected
+	// by the policy statement.
+	// +optional
+	AWSPrincipals []AWSPrincipal `json:"awsPrincipals,omitempty"`
+
+	// Service define the services which can have access to this key
+	// +optional
+	Service []string `json:"service,omitempty"`
+}
+
+// AWSPrincipal wraps the potential values a policy
+// principal can take. Only one of the values should be set.
+type AWSPrincipal struct {
+	// UserARN contains the ARN of an IAM user
+	// +optional
+	UserARN *string `json:"iamUserArn,omitempty"`
+
+	// AWSAccountID identifies an AWS account as the principal
+	// +optional
+	AWSAccountID *string `json:"awsAccountId,omitempty"`
+
+	// IAMRoleARN contains the ARN of an IAM role
+	// +optional
+	IAMRoleARN *string `json:"iamRoleArn,omitempty"`
+}
+
+// Condition represents a set of condition pairs for a key policy
+type Condition struct {
+	// OperatorKey matches the condition key and value in the policy against values in the request context
+	OperatorKey string `json:"operatorKey"`
+
+	// Conditions represents each of the key/value pairs for the operator key
+	Conditions []ConditionPair `json:"conditions"`
+}
+
+// ConditionPair represents one condition inside of the set of conditions for
+// a key policy
+type ConditionPair struct {
+	// ConditionKey is the key condition being applied to the parent condition
+	ConditionKey string `json:"key"`
+
+	// ConditionStringValue is the expected string value of the key from the parent condition
+	// +optional
+	ConditionStringValue *string `json:"stringValue,omitempty"`
+
+	// ConditionDateValue is the expected string value of the key from the parent condition. The
+	// date value must be in ISO 8601 format. The time is always midnight UTC.
+	// +optional
+	ConditionDateValue *metav1.Time `json:"dateValue,omitempty"`
+
+	// ConditionNumericValue is the expected string value of the key from the parent condition
+	// +optional
+	ConditionNumericValue *int64 `json:"numericValue,omitempty"`
+
+	// ConditionBooleanValue is the expected boolean value of the key from the parent condition
+	// +optional
+	ConditionBooleanValue *bool `json:"booleanValue,omitempty"`
+
+	// ConditionListValue is the list value of the key from the parent condition
+	// +optional
+	ConditionListValue []string `json:"listValue,omitempty"`
 }
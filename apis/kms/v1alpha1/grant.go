@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// ResourceCredentialsSecretGrantTokenKey is the key of the grant token in the
+// connection secret of a Grant.
+const ResourceCredentialsSecretGrantTokenKey = "grantToken"
+
+// GrantParameters defines the desired state of Grant
+type GrantParameters struct {
+	// Region is which region the Grant will be created.
+	// +kubebuilder:validation:Required
+	Region string `json:"region"`
+
+	// KeyID is the ID or ARN of the KMS key the grant applies to.
+	// +immutable
+	// +crossplane:generate:reference:type=Key
+	KeyID *string `json:"keyId,omitempty"`
+
+	// KeyIDRef is a reference to a KMS Key used to set KeyID.
+	// +optional
+	KeyIDRef *xpv1.Reference `json:"keyIdRef,omitempty"`
+
+	// KeyIDSelector selects a reference to a KMS Key used to set KeyID.
+	// +optional
+	KeyIDSelector *xpv1.Selector `json:"keyIdSelector,omitempty"`
+
+	// GranteePrincipal is the identity that gets the permissions specified
+	// in the grant, for example an IAM role ARN, an account root ARN or a
+	// service principal.
+	// +immutable
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	GranteePrincipal *string `json:"granteePrincipal,omitempty"`
+
+	// GranteePrincipalRef is a reference to an IAM Role used to set
+	// GranteePrincipal.
+	// +optional
+	GranteePrincipalRef *xpv1.Reference `json:"granteePrincipalRef,omitempty"`
+
+	// GranteePrincipalSelector selects a reference to an IAM Role used to set
+	// GranteePrincipal.
+	// +optional
+	GranteePrincipalSelector *xpv1.Selector `json:"granteePrincipalSelector,omitempty"`
+
+	// RetiringPrincipal is the principal that has permission to use the
+	// RetireGrant operation to retire the grant.
+	// +immutable
+	// +optional
+	// +crossplane:generate:reference:type=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.Role
+	// +crossplane:generate:reference:extractor=github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1.RoleARN()
+	RetiringPrincipal *string `json:"retiringPrincipal,omitempty"`
+
+	// RetiringPrincipalRef is a reference to an IAM Role used to set
+	// RetiringPrincipal.
+	// +optional
+	RetiringPrincipalRef *xpv1.Reference `json:"retiringPrincipalRef,omitempty"`
+
+	// RetiringPrincipalSelector selects a reference to an IAM Role used to
+	// set RetiringPrincipal.
+	// +optional
+	RetiringPrincipalSelector *xpv1.Selector `json:"retiringPrincipalSelector,omitempty"`
+
+	// Operations is the list of grant operations that the grant permits.
+	// +immutable
+	// +kubebuilder:validation:MinItems=1
+	Operations []string `json:"operations"`
+
+	// Constraints specifies a grant constraint based on the encryption
+	// context of a cryptographic operation.
+	// +immutable
+	// +optional
+	Constraints *KeyGrantConstraints `json:"constraints,omitempty"`
+
+	// Name is a friendly name for the grant.
+	// +immutable
+	// +optional
+	Name *string `json:"name,omitempty"`
+}
+
+// GrantSpec defines the desired state of Grant
+type GrantSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       GrantParameters `json:"forProvider"`
+}
+
+// GrantObservation defines the observed state of Grant
+type GrantObservation struct {
+	// GrantID is the unique identifier of the grant.
+	GrantID *string `json:"grantId,omitempty"`
+
+	// KeyARN is the ARN of the KMS key the grant applies to.
+	KeyARN *string `json:"keyArn,omitempty"`
+
+	// IssuingAccount is the Amazon Web Services account under which the grant
+	// was issued.
+	IssuingAccount *string `json:"issuingAccount,omitempty"`
+
+	// CreationDate is the date and time when the grant was created.
+	CreationDate *metav1.Time `json:"creationDate,omitempty"`
+}
+
+// GrantStatus defines the observed state of Grant.
+type GrantStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  GrantObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Grant is a managed resource that represents a grant on an AWS KMS Key.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Grant struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              GrantSpec   `json:"spec"`
+	Status            GrantStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GrantList contains a list of Grants
+type GrantList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Grant `json:"items"`
+}
+
+// Grant type metadata.
+var (
+	GrantKind             = "Grant"
+	GrantGroupKind        = schema.GroupKind{Group: CRDGroup, Kind: GrantKind}.String()
+	GrantKindAPIVersion   = GrantKind + "." + GroupVersion.String()
+	GrantGroupVersionKind = GroupVersion.WithKind(GrantKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Grant{}, &GrantList{})
+}
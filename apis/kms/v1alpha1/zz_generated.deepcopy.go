@@ -26,6 +26,36 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPrincipal) DeepCopyInto(out *AWSPrincipal) {
+	*out = *in
+	if in.UserARN != nil {
+		in, out := &in.UserARN, &out.UserARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.AWSAccountID != nil {
+		in, out := &in.AWSAccountID, &out.AWSAccountID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IAMRoleARN != nil {
+		in, out := &in.IAMRoleARN, &out.IAMRoleARN
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPrincipal.
+func (in *AWSPrincipal) DeepCopy() *AWSPrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Alias) DeepCopyInto(out *Alias) {
 	*out = *in
@@ -184,6 +214,7 @@ func (in *AliasSpec) DeepCopy() *AliasSpec {
 func (in *AliasStatus) DeepCopyInto(out *AliasStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -197,6 +228,67 @@ func (in *AliasStatus) DeepCopy() *AliasStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Condition) DeepCopyInto(out *Condition) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]ConditionPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
+func (in *Condition) DeepCopy() *Condition {
+	if in == nil {
+		return nil
+	}
+	out := new(Condition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionPair) DeepCopyInto(out *ConditionPair) {
+	*out = *in
+	if in.ConditionStringValue != nil {
+		in, out := &in.ConditionStringValue, &out.ConditionStringValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConditionDateValue != nil {
+		in, out := &in.ConditionDateValue, &out.ConditionDateValue
+		*out = (*in).DeepCopy()
+	}
+	if in.ConditionNumericValue != nil {
+		in, out := &in.ConditionNumericValue, &out.ConditionNumericValue
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ConditionBooleanValue != nil {
+		in, out := &in.ConditionBooleanValue, &out.ConditionBooleanValue
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConditionListValue != nil {
+		in, out := &in.ConditionListValue, &out.ConditionListValue
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionPair.
+func (in *ConditionPair) DeepCopy() *ConditionPair {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomKeyParameters) DeepCopyInto(out *CustomKeyParameters) {
 	*out = *in
@@ -215,6 +307,23 @@ func (in *CustomKeyParameters) DeepCopyInto(out *CustomKeyParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.KeyPolicy != nil {
+		in, out := &in.KeyPolicy, &out.KeyPolicy
+		*out = new(KeyPolicyBody)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ReplicaRegions != nil {
+		in, out := &in.ReplicaRegions, &out.ReplicaRegions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]KeyGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomKeyParameters.
@@ -256,6 +365,65 @@ func (in *CustomKeyStoresListEntry) DeepCopy() *CustomKeyStoresListEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Grant) DeepCopyInto(out *Grant) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Grant.
+func (in *Grant) DeepCopy() *Grant {
+	if in == nil {
+		return nil
+	}
+	out := new(Grant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Grant) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantList) DeepCopyInto(out *GrantList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Grant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantList.
+func (in *GrantList) DeepCopy() *GrantList {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GrantList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GrantListEntry) DeepCopyInto(out *GrantListEntry) {
 	*out = *in
@@ -280,6 +448,150 @@ func (in *GrantListEntry) DeepCopy() *GrantListEntry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantObservation) DeepCopyInto(out *GrantObservation) {
+	*out = *in
+	if in.GrantID != nil {
+		in, out := &in.GrantID, &out.GrantID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyARN != nil {
+		in, out := &in.KeyARN, &out.KeyARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.IssuingAccount != nil {
+		in, out := &in.IssuingAccount, &out.IssuingAccount
+		*out = new(string)
+		**out = **in
+	}
+	if in.CreationDate != nil {
+		in, out := &in.CreationDate, &out.CreationDate
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantObservation.
+func (in *GrantObservation) DeepCopy() *GrantObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantParameters) DeepCopyInto(out *GrantParameters) {
+	*out = *in
+	if in.KeyID != nil {
+		in, out := &in.KeyID, &out.KeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeyIDRef != nil {
+		in, out := &in.KeyIDRef, &out.KeyIDRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KeyIDSelector != nil {
+		in, out := &in.KeyIDSelector, &out.KeyIDSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GranteePrincipal != nil {
+		in, out := &in.GranteePrincipal, &out.GranteePrincipal
+		*out = new(string)
+		**out = **in
+	}
+	if in.GranteePrincipalRef != nil {
+		in, out := &in.GranteePrincipalRef, &out.GranteePrincipalRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GranteePrincipalSelector != nil {
+		in, out := &in.GranteePrincipalSelector, &out.GranteePrincipalSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetiringPrincipal != nil {
+		in, out := &in.RetiringPrincipal, &out.RetiringPrincipal
+		*out = new(string)
+		**out = **in
+	}
+	if in.RetiringPrincipalRef != nil {
+		in, out := &in.RetiringPrincipalRef, &out.RetiringPrincipalRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetiringPrincipalSelector != nil {
+		in, out := &in.RetiringPrincipalSelector, &out.RetiringPrincipalSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(KeyGrantConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantParameters.
+func (in *GrantParameters) DeepCopy() *GrantParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantSpec) DeepCopyInto(out *GrantSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantSpec.
+func (in *GrantSpec) DeepCopy() *GrantSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GrantStatus) DeepCopyInto(out *GrantStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GrantStatus.
+func (in *GrantStatus) DeepCopy() *GrantStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GrantStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Key) DeepCopyInto(out *Key) {
 	*out = *in
@@ -307,6 +619,65 @@ func (in *Key) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGrant) DeepCopyInto(out *KeyGrant) {
+	*out = *in
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetiringPrincipal != nil {
+		in, out := &in.RetiringPrincipal, &out.RetiringPrincipal
+		*out = new(string)
+		**out = **in
+	}
+	if in.Constraints != nil {
+		in, out := &in.Constraints, &out.Constraints
+		*out = new(KeyGrantConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGrant.
+func (in *KeyGrant) DeepCopy() *KeyGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyGrantConstraints) DeepCopyInto(out *KeyGrantConstraints) {
+	*out = *in
+	if in.EncryptionContextEquals != nil {
+		in, out := &in.EncryptionContextEquals, &out.EncryptionContextEquals
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.EncryptionContextSubset != nil {
+		in, out := &in.EncryptionContextSubset, &out.EncryptionContextSubset
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyGrantConstraints.
+func (in *KeyGrantConstraints) DeepCopy() *KeyGrantConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyGrantConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyList) DeepCopyInto(out *KeyList) {
 	*out = *in
@@ -545,6 +916,17 @@ func (in *KeyObservation) DeepCopyInto(out *KeyObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.GrantIDs != nil {
+		in, out := &in.GrantIDs, &out.GrantIDs
+		*out = make([]*string, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(string)
+				**out = **in
+			}
+		}
+	}
 	if in.KeyID != nil {
 		in, out := &in.KeyID, &out.KeyID
 		*out = new(string)
@@ -669,6 +1051,122 @@ func (in *KeyParameters) DeepCopy() *KeyParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPolicyBody) DeepCopyInto(out *KeyPolicyBody) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Statements != nil {
+		in, out := &in.Statements, &out.Statements
+		*out = make([]KeyPolicyStatement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPolicyBody.
+func (in *KeyPolicyBody) DeepCopy() *KeyPolicyBody {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPolicyBody)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPolicyPrincipal) DeepCopyInto(out *KeyPolicyPrincipal) {
+	*out = *in
+	if in.AllowAnon != nil {
+		in, out := &in.AllowAnon, &out.AllowAnon
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AWSPrincipals != nil {
+		in, out := &in.AWSPrincipals, &out.AWSPrincipals
+		*out = make([]AWSPrincipal, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPolicyPrincipal.
+func (in *KeyPolicyPrincipal) DeepCopy() *KeyPolicyPrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPolicyPrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPolicyStatement) DeepCopyInto(out *KeyPolicyStatement) {
+	*out = *in
+	if in.SID != nil {
+		in, out := &in.SID, &out.SID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(KeyPolicyPrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NotPrincipal != nil {
+		in, out := &in.NotPrincipal, &out.NotPrincipal
+		*out = new(KeyPolicyPrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Action != nil {
+		in, out := &in.Action, &out.Action
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotAction != nil {
+		in, out := &in.NotAction, &out.NotAction
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NotResource != nil {
+		in, out := &in.NotResource, &out.NotResource
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPolicyStatement.
+func (in *KeyPolicyStatement) DeepCopy() *KeyPolicyStatement {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPolicyStatement)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeySpec) DeepCopyInto(out *KeySpec) {
 	*out = *in
@@ -690,6 +1188,7 @@ func (in *KeySpec) DeepCopy() *KeySpec {
 func (in *KeyStatus) DeepCopyInto(out *KeyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,7 @@ package v1alpha1
 
 import (
 	"context"
+	v1beta1 "github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
 	reference "github.com/crossplane/crossplane-runtime/pkg/reference"
 	errors "github.com/pkg/errors"
 	client "sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,3 +51,61 @@ func (mg *Alias) ResolveReferences(ctx context.Context, c client.Reader) error {
 
 	return nil
 }
+
+// ResolveReferences of this Grant.
+func (mg *Grant) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.KeyID),
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.KeyIDRef,
+		Selector:     mg.Spec.ForProvider.KeyIDSelector,
+		To: reference.To{
+			List:    &KeyList{},
+			Managed: &Key{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.KeyID")
+	}
+	mg.Spec.ForProvider.KeyID = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.KeyIDRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.GranteePrincipal),
+		Extract:      v1beta1.RoleARN(),
+		Reference:    mg.Spec.ForProvider.GranteePrincipalRef,
+		Selector:     mg.Spec.ForProvider.GranteePrincipalSelector,
+		To: reference.To{
+			List:    &v1beta1.RoleList{},
+			Managed: &v1beta1.Role{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.GranteePrincipal")
+	}
+	mg.Spec.ForProvider.GranteePrincipal = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.GranteePrincipalRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RetiringPrincipal),
+		Extract:      v1beta1.RoleARN(),
+		Reference:    mg.Spec.ForProvider.RetiringPrincipalRef,
+		Selector:     mg.Spec.ForProvider.RetiringPrincipalSelector,
+		To: reference.To{
+			List:    &v1beta1.RoleList{},
+			Managed: &v1beta1.Role{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.RetiringPrincipal")
+	}
+	mg.Spec.ForProvider.RetiringPrincipal = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RetiringPrincipalRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // KeyParameters defines the desired state of Key
@@ -257,6 +259,8 @@ type KeyObservation struct {
 	// Specifies whether the KMS key's key material expires. This value is present
 	// only when Origin is EXTERNAL, otherwise this value is omitted.
 	ExpirationModel *string `json:"expirationModel,omitempty"`
+	// The IDs of the grants that were created for the grants in the spec.
+	GrantIDs []*string `json:"grantIDs,omitempty"`
 	// The globally unique identifier for the KMS key.
 	KeyID *string `json:"keyID,omitempty"`
 	// The manager of the KMS key. KMS keys in your Amazon Web Services account
@@ -312,8 +316,9 @@ type KeyObservation struct {
 
 // KeyStatus defines the observed state of Key.
 type KeyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          KeyObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  KeyObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
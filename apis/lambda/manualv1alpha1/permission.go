@@ -24,6 +24,8 @@ import (
 	"sigs.k8s.io/yaml"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // PermissionParameters define the desired state of a Lambda Permission
@@ -132,8 +134,9 @@ type PermissionObservation struct {
 
 // A PermissionStatus represents the observed state of a ElasticIP.
 type PermissionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          PermissionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  PermissionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -176,6 +176,7 @@ func (in *PermissionSpec) DeepCopy() *PermissionSpec {
 func (in *PermissionStatus) DeepCopyInto(out *PermissionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
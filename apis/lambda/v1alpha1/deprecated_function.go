@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // FunctionParameters defines the desired state of Function
@@ -136,8 +138,9 @@ type FunctionObservation struct {
 
 // FunctionStatus defines the observed state of Function.
 type FunctionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          FunctionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  FunctionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
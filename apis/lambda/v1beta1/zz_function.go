@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // FunctionParameters defines the desired state of Function
@@ -30,66 +32,66 @@ type FunctionParameters struct {
 	// +kubebuilder:validation:Required
 	Region string `json:"region"`
 	// The instruction set architecture that the function supports. Enter a string
-// array with one of the valid values. The default value is x86_64.
-	 Architectures []*string `json:"architectures,omitempty"` 
+	// array with one of the valid values. The default value is x86_64.
+	Architectures []*string `json:"architectures,omitempty"`
 	// To enable code signing for this function, specify the ARN of a code-signing
-// configuration. A code-signing configuration includes a set of signing profiles,
-// which define the trusted publishers for this function.
-	 CodeSigningConfigARN *string `json:"codeSigningConfigARN,omitempty"` 
+	// configuration. A code-signing configuration includes a set of signing profiles,
+	// which define the trusted publishers for this function.
+	CodeSigningConfigARN *string `json:"codeSigningConfigARN,omitempty"`
 	// A dead letter queue configuration that specifies the queue or topic where
-// Lambda sends asynchronous events when they fail processing. For more information,
-// see Dead Letter Queues (https://docs.aws.amazon.com/lambda/latest/dg/invocation-async.html#dlq).
-	 DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"` 
+	// Lambda sends asynchronous events when they fail processing. For more information,
+	// see Dead Letter Queues (https://docs.aws.amazon.com/lambda/latest/dg/invocation-async.html#dlq).
+	DeadLetterConfig *DeadLetterConfig `json:"deadLetterConfig,omitempty"`
 	// A description of the function.
-	 Description *string `json:"description,omitempty"` 
+	Description *string `json:"description,omitempty"`
 	// Environment variables that are accessible from function code during execution.
-	 Environment *Environment `json:"environment,omitempty"` 
+	Environment *Environment `json:"environment,omitempty"`
 	// Connection settings for an Amazon EFS file system.
-	 FileSystemConfigs []*FileSystemConfig `json:"fileSystemConfigs,omitempty"` 
+	FileSystemConfigs []*FileSystemConfig `json:"fileSystemConfigs,omitempty"`
 	// The name of the method within your code that Lambda calls to execute your
-// function. The format includes the file name. It can also include namespaces
-// and other qualifiers, depending on the runtime. For more information, see
-// Programming Model (https://docs.aws.amazon.com/lambda/latest/dg/programming-model-v2.html).
-	 Handler *string `json:"handler,omitempty"` 
+	// function. The format includes the file name. It can also include namespaces
+	// and other qualifiers, depending on the runtime. For more information, see
+	// Programming Model (https://docs.aws.amazon.com/lambda/latest/dg/programming-model-v2.html).
+	Handler *string `json:"handler,omitempty"`
 	// Container image configuration values (https://docs.aws.amazon.com/lambda/latest/dg/configuration-images.html#configuration-images-settings)
-// that override the values in the container image Dockerfile.
-	 ImageConfig *ImageConfig `json:"imageConfig,omitempty"` 
+	// that override the values in the container image Dockerfile.
+	ImageConfig *ImageConfig `json:"imageConfig,omitempty"`
 	// The ARN of the Amazon Web Services Key Management Service (KMS) key that's
-// used to encrypt your function's environment variables. If it's not provided,
-// Lambda uses a default service key.
-	 KMSKeyARN *string `json:"kmsKeyARN,omitempty"` 
+	// used to encrypt your function's environment variables. If it's not provided,
+	// Lambda uses a default service key.
+	KMSKeyARN *string `json:"kmsKeyARN,omitempty"`
 	// A list of function layers (https://docs.aws.amazon.com/lambda/latest/dg/configuration-layers.html)
-// to add to the function's execution environment. Specify each layer by its
-// ARN, including the version.
-	 Layers []*string `json:"layers,omitempty"` 
+	// to add to the function's execution environment. Specify each layer by its
+	// ARN, including the version.
+	Layers []*string `json:"layers,omitempty"`
 	// The amount of memory available to the function (https://docs.aws.amazon.com/lambda/latest/dg/configuration-memory.html)
-// at runtime. Increasing the function memory also increases its CPU allocation.
-// The default value is 128 MB. The value can be any multiple of 1 MB.
-	 MemorySize *int64 `json:"memorySize,omitempty"` 
+	// at runtime. Increasing the function memory also increases its CPU allocation.
+	// The default value is 128 MB. The value can be any multiple of 1 MB.
+	MemorySize *int64 `json:"memorySize,omitempty"`
 	// The type of deployment package. Set to Image for container image and set
-// Zip for ZIP archive.
-	 PackageType *string `json:"packageType,omitempty"` 
+	// Zip for ZIP archive.
+	PackageType *string `json:"packageType,omitempty"`
 	// Set to true to publish the first version of the function during creation.
-	 Publish *bool `json:"publish,omitempty"` 
+	Publish *bool `json:"publish,omitempty"`
 	// The identifier of the function's runtime (https://docs.aws.amazon.com/lambda/latest/dg/lambda-runtimes.html).
-	 Runtime *string `json:"runtime,omitempty"` 
+	Runtime *string `json:"runtime,omitempty"`
 	// A list of tags (https://docs.aws.amazon.com/lambda/latest/dg/tagging.html)
-// to apply to the function.
-	 Tags map[string]*string `json:"tags,omitempty"` 
+	// to apply to the function.
+	Tags map[string]*string `json:"tags,omitempty"`
 	// The amount of time that Lambda allows a function to run before stopping it.
-// The default is 3 seconds. The maximum allowed value is 900 seconds. For additional
-// information, see Lambda execution environment (https://docs.aws.amazon.com/lambda/latest/dg/runtimes-context.html).
-	 Timeout *int64 `json:"timeout,omitempty"` 
+	// The default is 3 seconds. The maximum allowed value is 900 seconds. For additional
+	// information, see Lambda execution environment (https://docs.aws.amazon.com/lambda/latest/dg/runtimes-context.html).
+	Timeout *int64 `json:"timeout,omitempty"`
 	// Set Mode to Active to sample and trace a subset of incoming requests with
-// X-Ray (https://docs.aws.amazon.com/lambda/latest/dg/services-xray.html).
-	 TracingConfig *TracingConfig `json:"tracingConfig,omitempty"` 
+	// X-Ray (https://docs.aws.amazon.com/lambda/latest/dg/services-xray.html).
+	TracingConfig            *TracingConfig `json:"tracingConfig,omitempty"`
 	CustomFunctionParameters `json:",inline"`
 }
 
 // FunctionSpec defines the desired state of Function
 type FunctionSpec struct {
 	xpv1.ResourceSpec `json:",inline"`
-	ForProvider FunctionParameters `json:"forProvider"`
+	ForProvider       FunctionParameters `json:"forProvider"`
 }
 
 // FunctionObservation defines the observed state of Function
@@ -105,10 +107,10 @@ type FunctionObservation struct {
 	// The function's image configuration values.
 	ImageConfigResponse *ImageConfigResponse `json:"imageConfigResponse,omitempty"`
 	// The date and time that the function was last updated, in ISO-8601 format
-// (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).
+	// (https://www.w3.org/TR/NOTE-datetime) (YYYY-MM-DDThh:mm:ss.sTZD).
 	LastModified *string `json:"lastModified,omitempty"`
 	// The status of the last update that was performed on the function. This is
-// first set to Successful after function creation completes.
+	// first set to Successful after function creation completes.
 	LastUpdateStatus *string `json:"lastUpdateStatus,omitempty"`
 	// The reason for the last update that was performed on the function.
 	LastUpdateStatusReason *string `json:"lastUpdateStatusReason,omitempty"`
@@ -125,12 +127,12 @@ type FunctionObservation struct {
 	// The ARN of the signing profile version.
 	SigningProfileVersionARN *string `json:"signingProfileVersionARN,omitempty"`
 	// The current state of the function. When the state is Inactive, you can reactivate
-// the function by invoking it.
+	// the function by invoking it.
 	State *string `json:"state,omitempty"`
 	// The reason for the function's current state.
 	StateReason *string `json:"stateReason,omitempty"`
 	// The reason code for the function's current state. When the code is Creating,
-// you can't invoke or modify the function.
+	// you can't invoke or modify the function.
 	StateReasonCode *string `json:"stateReasonCode,omitempty"`
 	// The version of the Lambda function.
 	Version *string `json:"version,omitempty"`
@@ -140,11 +142,11 @@ type FunctionObservation struct {
 
 // FunctionStatus defines the observed state of Function.
 type FunctionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider FunctionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  FunctionObservation `json:"atProvider,omitempty"`
 }
 
-
 // +kubebuilder:object:root=true
 
 // Function is the Schema for the Functions API
@@ -157,8 +159,8 @@ type FunctionStatus struct {
 type Function struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
-	Spec   FunctionSpec   `json:"spec"`
-	Status FunctionStatus `json:"status,omitempty"`
+	Spec              FunctionSpec   `json:"spec"`
+	Status            FunctionStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -167,7 +169,7 @@ type Function struct {
 type FunctionList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
-	Items []Function `json:"items"`
+	Items           []Function `json:"items"`
 }
 
 // Repository type metadata.
@@ -181,4 +183,3 @@ var (
 func init() {
 	SchemeBuilder.Register(&Function{}, &FunctionList{})
 }
-
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // BrokerParameters defines the desired state of Broker
@@ -105,8 +107,9 @@ type BrokerObservation struct {
 
 // BrokerStatus defines the observed state of Broker.
 type BrokerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BrokerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  BrokerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
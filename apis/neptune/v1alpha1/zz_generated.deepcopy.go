@@ -878,6 +878,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -20,6 +20,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SNSSubscriptionParameters define the desired state of a AWS SNS Topic
@@ -111,8 +113,9 @@ type SNSSubscriptionObservation struct {
 
 // SNSSubscriptionStatus is the status of AWS SNS Topic
 type SNSSubscriptionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SNSSubscriptionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SNSSubscriptionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -181,6 +181,7 @@ func (in *SNSSubscriptionSpec) DeepCopy() *SNSSubscriptionSpec {
 func (in *SNSSubscriptionStatus) DeepCopyInto(out *SNSSubscriptionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -351,6 +352,7 @@ func (in *SNSTopicSpec) DeepCopy() *SNSTopicSpec {
 func (in *SNSTopicStatus) DeepCopyInto(out *SNSTopicStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
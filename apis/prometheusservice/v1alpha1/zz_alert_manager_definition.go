@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // AlertManagerDefinitionParameters defines the desired state of AlertManagerDefinition
@@ -51,8 +53,9 @@ type AlertManagerDefinitionObservation struct {
 
 // AlertManagerDefinitionStatus defines the observed state of AlertManagerDefinition.
 type AlertManagerDefinitionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          AlertManagerDefinitionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  AlertManagerDefinitionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -185,6 +185,7 @@ func (in *AlertManagerDefinitionSpec) DeepCopy() *AlertManagerDefinitionSpec {
 func (in *AlertManagerDefinitionStatus) DeepCopyInto(out *AlertManagerDefinitionStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -502,6 +503,7 @@ func (in *RuleGroupsNamespaceSpec) DeepCopy() *RuleGroupsNamespaceSpec {
 func (in *RuleGroupsNamespaceStatus) DeepCopyInto(out *RuleGroupsNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -828,6 +830,7 @@ func (in *WorkspaceSpec) DeepCopy() *WorkspaceSpec {
 func (in *WorkspaceStatus) DeepCopyInto(out *WorkspaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
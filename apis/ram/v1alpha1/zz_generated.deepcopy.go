@@ -514,6 +514,7 @@ func (in *ResourceShareSpec) DeepCopy() *ResourceShareSpec {
 func (in *ResourceShareStatus) DeepCopyInto(out *ResourceShareStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResourceShareParameters defines the desired state of ResourceShare
@@ -81,8 +83,9 @@ type ResourceShareObservation struct {
 
 // ResourceShareStatus defines the observed state of ResourceShare.
 type ResourceShareStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResourceShareObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResourceShareObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -16,7 +16,28 @@ limitations under the License.
 
 package v1alpha1
 
-import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// PasswordRotation configures the rotation of the master user password.
+type PasswordRotation struct {
+	// Interval after which the master user password is replaced with a newly
+	// generated one, e.g. 720h. The new password is written to the secret
+	// referenced by MasterUserPasswordSecretRef and to the connection secret.
+	Interval metav1.Duration `json:"interval"`
+}
+
+// GetInterval returns the rotation interval, or nil if the password is not
+// rotated.
+func (r *PasswordRotation) GetInterval() *metav1.Duration {
+	if r == nil {
+		return nil
+	}
+	return &r.Interval
+}
 
 // CustomDBParameterGroupParameters are custom parameters for DBParameterGroup
 type CustomDBParameterGroupParameters struct {
@@ -193,6 +214,28 @@ type CustomDBClusterParameters struct {
 	// Constraints: Must contain from 8 to 41 characters. Required.
 	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef"`
 
+	// PasswordRotation configures the periodic rotation of the master user
+	// password. It requires a MasterUserPasswordSecretRef unless the password
+	// is managed in Secrets Manager. The time of the last rotation is
+	// recorded in the passwordRotatedAt field of the status.
+	// +optional
+	PasswordRotation *PasswordRotation `json:"passwordRotation,omitempty"`
+
+	// ManageMasterUserPassword indicates whether to manage the master user
+	// password with Amazon Web Services Secrets Manager. If enabled, the
+	// password is neither read from MasterUserPasswordSecretRef nor generated
+	// by the controller; the username and password in the connection secret
+	// are read from the secret that RDS creates in Secrets Manager.
+	// +optional
+	ManageMasterUserPassword *bool `json:"manageMasterUserPassword,omitempty"`
+
+	// MasterUserSecretKMSKeyID is the Amazon Web Services KMS key identifier
+	// used to encrypt the secret that is managed by RDS in Secrets Manager if
+	// ManageMasterUserPassword is enabled. If not set, the aws/secretsmanager
+	// KMS key is used.
+	// +optional
+	MasterUserSecretKMSKeyID *string `json:"masterUserSecretKMSKeyID,omitempty"`
+
 	// A list of EC2 VPC security groups to associate with this DB cluster.
 	VPCSecurityGroupIDs []string `json:"vpcSecurityGroupIDs,omitempty"`
 
@@ -398,6 +441,28 @@ type CustomDBInstanceParameters struct {
 	// +optional
 	MasterUserPasswordSecretRef *xpv1.SecretKeySelector `json:"masterUserPasswordSecretRef,omitempty"`
 
+	// PasswordRotation configures the periodic rotation of the master user
+	// password. It requires a MasterUserPasswordSecretRef unless the password
+	// is managed in Secrets Manager. The time of the last rotation is
+	// recorded in the passwordRotatedAt field of the status.
+	// +optional
+	PasswordRotation *PasswordRotation `json:"passwordRotation,omitempty"`
+
+	// ManageMasterUserPassword indicates whether to manage the master user
+	// password with Amazon Web Services Secrets Manager. If enabled, the
+	// password is neither read from MasterUserPasswordSecretRef nor generated
+	// by the controller; the username and password in the connection secret
+	// are read from the secret that RDS creates in Secrets Manager.
+	// +optional
+	ManageMasterUserPassword *bool `json:"manageMasterUserPassword,omitempty"`
+
+	// MasterUserSecretKMSKeyID is the Amazon Web Services KMS key identifier
+	// used to encrypt the secret that is managed by RDS in Secrets Manager if
+	// ManageMasterUserPassword is enabled. If not set, the aws/secretsmanager
+	// KMS key is used.
+	// +optional
+	MasterUserSecretKMSKeyID *string `json:"masterUserSecretKMSKeyID,omitempty"`
+
 	// MonitoringRoleARNRef is a reference to an IAMRole used to set
 	// MonitoringRoleARN.
 	// +optional
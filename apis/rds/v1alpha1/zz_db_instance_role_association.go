@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // DBInstanceRoleAssociationParameters defines the desired state of DBInstanceRoleAssociation
@@ -48,8 +50,9 @@ type DBInstanceRoleAssociationObservation struct {
 
 // DBInstanceRoleAssociationStatus defines the observed state of DBInstanceRoleAssociation.
 type DBInstanceRoleAssociationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          DBInstanceRoleAssociationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  DBInstanceRoleAssociationObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -432,6 +432,21 @@ func (in *CustomDBClusterParameters) DeepCopyInto(out *CustomDBClusterParameters
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotation)
+		**out = **in
+	}
+	if in.ManageMasterUserPassword != nil {
+		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserSecretKMSKeyID != nil {
+		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
 	if in.VPCSecurityGroupIDs != nil {
 		in, out := &in.VPCSecurityGroupIDs, &out.VPCSecurityGroupIDs
 		*out = make([]string, len(*in))
@@ -534,6 +549,21 @@ func (in *CustomDBInstanceParameters) DeepCopyInto(out *CustomDBInstanceParamete
 		*out = new(v1.SecretKeySelector)
 		**out = **in
 	}
+	if in.PasswordRotation != nil {
+		in, out := &in.PasswordRotation, &out.PasswordRotation
+		*out = new(PasswordRotation)
+		**out = **in
+	}
+	if in.ManageMasterUserPassword != nil {
+		in, out := &in.ManageMasterUserPassword, &out.ManageMasterUserPassword
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MasterUserSecretKMSKeyID != nil {
+		in, out := &in.MasterUserSecretKMSKeyID, &out.MasterUserSecretKMSKeyID
+		*out = new(string)
+		**out = **in
+	}
 	if in.MonitoringRoleARNRef != nil {
 		in, out := &in.MonitoringRoleARNRef, &out.MonitoringRoleARNRef
 		*out = new(v1.Reference)
@@ -1060,11 +1090,20 @@ func (in *DBClusterObservation) DeepCopyInto(out *DBClusterObservation) {
 		in, out := &in.LatestRestorableTime, &out.LatestRestorableTime
 		*out = (*in).DeepCopy()
 	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MultiAZ != nil {
 		in, out := &in.MultiAZ, &out.MultiAZ
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PasswordRotatedAt != nil {
+		in, out := &in.PasswordRotatedAt, &out.PasswordRotatedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.PercentProgress != nil {
 		in, out := &in.PercentProgress, &out.PercentProgress
 		*out = new(string)
@@ -1297,6 +1336,7 @@ func (in *DBClusterParameterGroupSpec) DeepCopy() *DBClusterParameterGroupSpec {
 func (in *DBClusterParameterGroupStatus) DeepCopyInto(out *DBClusterParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1765,6 +1805,7 @@ func (in *DBClusterSpec) DeepCopy() *DBClusterSpec {
 func (in *DBClusterStatus) DeepCopyInto(out *DBClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -2655,6 +2696,11 @@ func (in *DBInstanceObservation) DeepCopyInto(out *DBInstanceObservation) {
 		*out = new(Endpoint)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MasterUserSecret != nil {
+		in, out := &in.MasterUserSecret, &out.MasterUserSecret
+		*out = new(MasterUserSecret)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.OptionGroupMemberships != nil {
 		in, out := &in.OptionGroupMemberships, &out.OptionGroupMemberships
 		*out = make([]*OptionGroupMembership, len(*in))
@@ -2666,6 +2712,10 @@ func (in *DBInstanceObservation) DeepCopyInto(out *DBInstanceObservation) {
 			}
 		}
 	}
+	if in.PasswordRotatedAt != nil {
+		in, out := &in.PasswordRotatedAt, &out.PasswordRotatedAt
+		*out = (*in).DeepCopy()
+	}
 	if in.PendingModifiedValues != nil {
 		in, out := &in.PendingModifiedValues, &out.PendingModifiedValues
 		*out = new(PendingModifiedValues)
@@ -3167,6 +3217,7 @@ func (in *DBInstanceRoleAssociationSpec) DeepCopy() *DBInstanceRoleAssociationSp
 func (in *DBInstanceRoleAssociationStatus) DeepCopyInto(out *DBInstanceRoleAssociationStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	out.AtProvider = in.AtProvider
 }
 
@@ -3201,6 +3252,7 @@ func (in *DBInstanceSpec) DeepCopy() *DBInstanceSpec {
 func (in *DBInstanceStatus) DeepCopyInto(out *DBInstanceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -3865,6 +3917,7 @@ func (in *DBParameterGroupSpec) DeepCopy() *DBParameterGroupSpec {
 func (in *DBParameterGroupStatus) DeepCopyInto(out *DBParameterGroupStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -5154,6 +5207,7 @@ func (in *GlobalClusterSpec) DeepCopy() *GlobalClusterSpec {
 func (in *GlobalClusterStatus) DeepCopyInto(out *GlobalClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -5338,6 +5392,36 @@ func (in *InstallationMediaFailureCause) DeepCopy() *InstallationMediaFailureCau
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MasterUserSecret) DeepCopyInto(out *MasterUserSecret) {
+	*out = *in
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretARN != nil {
+		in, out := &in.SecretARN, &out.SecretARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.SecretStatus != nil {
+		in, out := &in.SecretStatus, &out.SecretStatus
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MasterUserSecret.
+func (in *MasterUserSecret) DeepCopy() *MasterUserSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(MasterUserSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MinimumEngineVersionPerAllowedValue) DeepCopyInto(out *MinimumEngineVersionPerAllowedValue) {
 	*out = *in
@@ -5977,6 +6061,22 @@ func (in *Parameter) DeepCopy() *Parameter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordRotation) DeepCopyInto(out *PasswordRotation) {
+	*out = *in
+	out.Interval = in.Interval
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordRotation.
+func (in *PasswordRotation) DeepCopy() *PasswordRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PendingCloudwatchLogsExports) DeepCopyInto(out *PendingCloudwatchLogsExports) {
 	*out = *in
@@ -1068,6 +1068,15 @@ type InstallationMediaFailureCause struct {
 	Message *string `json:"message,omitempty"`
 }
 
+// +kubebuilder:skipversion
+type MasterUserSecret struct {
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
+
+	SecretARN *string `json:"secretARN,omitempty"`
+
+	SecretStatus *string `json:"secretStatus,omitempty"`
+}
+
 // +kubebuilder:skipversion
 type MinimumEngineVersionPerAllowedValue struct {
 	AllowedValue *string `json:"allowedValue,omitempty"`
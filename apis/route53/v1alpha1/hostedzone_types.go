@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // +kubebuilder:object:root=true
@@ -48,8 +50,9 @@ type HostedZoneSpec struct {
 
 // HostedZoneStatus represents the observed state of a HostedZone.
 type HostedZoneStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          HostedZoneObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  HostedZoneObservation `json:"atProvider,omitempty"`
 }
 
 // HostedZoneParameters define the desired state of an AWS Route53 Hosted HostedZone.
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResourceRecordSetParameters define the desired state of an AWS Route53 Resource Record.
@@ -803,7 +805,8 @@ type ResourceRecordSetSpec struct {
 
 // ResourceRecordSetStatus represents the observed state of a ResourceRecordSet.
 type ResourceRecordSetStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
@@ -264,6 +264,7 @@ func (in *HostedZoneSpec) DeepCopy() *HostedZoneSpec {
 func (in *HostedZoneStatus) DeepCopyInto(out *HostedZoneStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -462,6 +463,7 @@ func (in *ResourceRecordSetSpec) DeepCopy() *ResourceRecordSetSpec {
 func (in *ResourceRecordSetStatus) DeepCopyInto(out *ResourceRecordSetStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceRecordSetStatus.
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Types of ResolverRuleAssociation status.
@@ -55,8 +57,9 @@ type ResolverRuleAssociationSpec struct {
 
 // ResolverRuleAssociationStatus represents the observed state of a ResolverRuleAssociation.
 type ResolverRuleAssociationStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResolverRuleAssociationObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResolverRuleAssociationObservation `json:"atProvider,omitempty"`
 }
 
 // ResolverRuleAssociationParameters define the desired state of an AWS Route53 Hosted ResolverRuleAssociation.
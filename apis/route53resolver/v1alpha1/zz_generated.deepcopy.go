@@ -813,6 +813,7 @@ func (in *ResolverEndpointSpec) DeepCopy() *ResolverEndpointSpec {
 func (in *ResolverEndpointStatus) DeepCopyInto(out *ResolverEndpointStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -1221,6 +1222,7 @@ func (in *ResolverRuleSpec) DeepCopy() *ResolverRuleSpec {
 func (in *ResolverRuleStatus) DeepCopyInto(out *ResolverRuleStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
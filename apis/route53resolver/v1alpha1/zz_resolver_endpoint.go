@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResolverEndpointParameters defines the desired state of ResolverEndpoint
@@ -117,8 +119,9 @@ type ResolverEndpointObservation struct {
 
 // ResolverEndpointStatus defines the observed state of ResolverEndpoint.
 type ResolverEndpointStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResolverEndpointObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResolverEndpointObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
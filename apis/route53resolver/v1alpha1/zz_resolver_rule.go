@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ResolverRuleParameters defines the desired state of ResolverRule
@@ -104,8 +106,9 @@ type ResolverRuleObservation struct {
 
 // ResolverRuleStatus defines the observed state of ResolverRule.
 type ResolverRuleStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ResolverRuleObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ResolverRuleObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // BucketPolicyParameters define the desired state of an AWS BucketPolicy.
@@ -225,7 +227,8 @@ type BucketPolicySpec struct {
 // An BucketPolicyStatus represents the observed state of an
 // BucketPolicy.
 type BucketPolicyStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
 }
 
 // +kubebuilder:object:root=true
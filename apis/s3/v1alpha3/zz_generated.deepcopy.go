@@ -275,6 +275,7 @@ func (in *BucketPolicyStatement) DeepCopy() *BucketPolicyStatement {
 func (in *BucketPolicyStatus) DeepCopyInto(out *BucketPolicyStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BucketPolicyStatus.
@@ -19,6 +19,8 @@ package v1beta1
 import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 const (
@@ -173,8 +175,9 @@ type BucketExternalStatus struct {
 
 // BucketStatus represents the observed state of the Bucket.
 type BucketStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          BucketExternalStatus `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  BucketExternalStatus `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SecretParameters defines the desired state of Secret
@@ -118,8 +120,9 @@ type SecretObservation struct {
 
 // SecretStatus defines the observed state of Secret.
 type SecretStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SecretObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SecretObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -391,6 +391,7 @@ func (in *SecretSpec) DeepCopy() *SecretSpec {
 func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -18,6 +18,19 @@ package v1beta1
 
 import xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
+const (
+	// SyncDirectionToAWS sends the data of the referenced Kubernetes secret
+	// to AWS.
+	SyncDirectionToAWS = "ToAWS"
+	// SyncDirectionFromAWS writes the current value of the secret in AWS
+	// into the referenced Kubernetes secret.
+	SyncDirectionFromAWS = "FromAWS"
+
+	// AnnotationKeyVersionID is the annotation on the Kubernetes secret that
+	// holds the ID of the secret version in AWS its data was read from.
+	AnnotationKeyVersionID = "secretsmanager.aws.crossplane.io/version-id"
+)
+
 // CustomSecretParameters contains the additional fields for SecretParameters.
 type CustomSecretParameters struct {
 	// KMSKeyIDRef is a reference to an kms/v1alpha1.Key used
@@ -42,6 +55,19 @@ type CustomSecretParameters struct {
 	// into JSON and sent to AWS.
 	BinarySecretRef *SecretReference `json:"binarySecretRef,omitempty"`
 
+	// SyncDirection specifies in which direction the value of the secret is
+	// synchronized. ToAWS sends the data of stringSecretRef or
+	// binarySecretRef to AWS. FromAWS reads the current version of the
+	// secret in AWS on every poll and writes it into the Kubernetes secret
+	// referenced by stringSecretRef or binarySecretRef, e.g. for secrets that
+	// are rotated in AWS. The ID of the version is stored in the
+	// secretsmanager.aws.crossplane.io/version-id annotation of that secret.
+	// Whether that succeeded is reported in the SyncedFromAWS condition.
+	// Defaults to ToAWS.
+	// +kubebuilder:validation:Enum=ToAWS;FromAWS
+	// +optional
+	SyncDirection *string `json:"syncDirection,omitempty"`
+
 	// (Optional) Specifies that the secret is to be deleted without any recovery
 	// window. You can't use both this parameter and the RecoveryWindowInDays parameter
 	// in the same API call.
@@ -75,6 +101,61 @@ type CustomSecretParameters struct {
 	// ResourcePolicy is a required field
 	// +optional
 	ResourcePolicy *string `json:"resourcePolicy,omitempty"`
+
+	// RotationRules configures automatic rotation of the secret. If set,
+	// rotation is turned on using the function given in RotationLambdaARN.
+	// Removing it turns rotation off.
+	//
+	// Once rotation is turned on, the value of the secret in AWS is owned by
+	// the rotation function and changes in stringSecretRef or binarySecretRef
+	// are no longer sent to AWS.
+	// +optional
+	RotationRules *RotationRules `json:"rotationRules,omitempty"`
+
+	// RotationLambdaARN is the ARN of the Lambda function that rotates the
+	// secret. It is required to turn on rotation unless the secret is
+	// managed by another service.
+	// +optional
+	RotationLambdaARN *string `json:"rotationLambdaARN,omitempty"`
+
+	// RotationLambdaARNRef is a reference to a lambda/v1beta1.Function used
+	// to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNRef *xpv1.Reference `json:"rotationLambdaARNRef,omitempty"`
+
+	// RotationLambdaARNSelector selects references to lambda/v1beta1.Function
+	// used to set the RotationLambdaARN field.
+	// +optional
+	RotationLambdaARNSelector *xpv1.Selector `json:"rotationLambdaARNSelector,omitempty"`
+
+	// RotateImmediately specifies whether to rotate the secret immediately
+	// when rotation is turned on or its configuration changes. If false, the
+	// secret is rotated in the next window of the schedule. Defaults to true.
+	// +optional
+	RotateImmediately *bool `json:"rotateImmediately,omitempty"`
+}
+
+// RotationRules defines the rotation schedule of a secret.
+type RotationRules struct {
+	// AutomaticallyAfterDays is the number of days between automatic
+	// rotations of the secret. Only one of AutomaticallyAfterDays and
+	// ScheduleExpression can be set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +optional
+	AutomaticallyAfterDays *int64 `json:"automaticallyAfterDays,omitempty"`
+
+	// ScheduleExpression is a cron() or rate() expression that defines the
+	// schedule for rotating the secret, e.g. "rate(10 days)" or
+	// "cron(0 8 1 * ? *)".
+	// +optional
+	ScheduleExpression *string `json:"scheduleExpression,omitempty"`
+
+	// Duration is the length of the rotation window in hours, for example
+	// "3h". Secrets Manager rotates the secret at any time during this
+	// window. Defaults to the end of the day when not set.
+	// +optional
+	Duration *string `json:"duration,omitempty"`
 }
 
 // A SecretReference is a reference to a secret in an arbitrary namespace.
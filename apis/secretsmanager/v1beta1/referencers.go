@@ -26,6 +26,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	kms "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	lambda "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
 
@@ -47,6 +48,21 @@ func (mg *Secret) ResolveReferences(ctx context.Context, c client.Reader) error
 
 	mg.Spec.ForProvider.KMSKeyID = reference.ToPtrValue(rsp.ResolvedValue)
 	mg.Spec.ForProvider.KMSKeyIDRef = rsp.ResolvedReference
+
+	// Resolve spec.forProvider.rotationLambdaARN
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: reference.FromPtrValue(mg.Spec.ForProvider.RotationLambdaARN),
+		Reference:    mg.Spec.ForProvider.RotationLambdaARNRef,
+		Selector:     mg.Spec.ForProvider.RotationLambdaARNSelector,
+		To:           reference.To{Managed: &lambda.Function{}, List: &lambda.FunctionList{}},
+		Extract:      lambda.FunctionARN(),
+	})
+	if err != nil {
+		return errors.Wrap(err, "spec.forProvider.rotationLambdaARN")
+	}
+
+	mg.Spec.ForProvider.RotationLambdaARN = reference.ToPtrValue(rsp.ResolvedValue)
+	mg.Spec.ForProvider.RotationLambdaARNRef = rsp.ResolvedReference
 	return nil
 }
 
@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	lambda "github.com/crossplane-contrib/provider-aws/apis/lambda/v1beta1"
+)
+
+func TestResolveReferences(t *testing.T) {
+	rotationLambdaARN := "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	ref := &xpv1.Reference{Name: "rotate"}
+	errBoom := errors.New("boom")
+
+	type want struct {
+		arn *string
+		err error
+	}
+
+	cases := map[string]struct {
+		params SecretParameters
+		kube   client.Reader
+		want   want
+	}{
+		"ResolvesRotationLambda": {
+			params: SecretParameters{
+				CustomSecretParameters: CustomSecretParameters{RotationLambdaARNRef: ref},
+			},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+					fn, ok := obj.(*lambda.Function)
+					if !ok {
+						return errBoom
+					}
+					fn.Status.AtProvider.FunctionARN = &rotationLambdaARN
+					return nil
+				}),
+			},
+			want: want{
+				arn: &rotationLambdaARN,
+			},
+		},
+		"NoReference": {
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+		},
+		"GetFailed": {
+			params: SecretParameters{
+				CustomSecretParameters: CustomSecretParameters{RotationLambdaARNRef: ref},
+			},
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot get referenced resource"), "spec.forProvider.rotationLambdaARN"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &Secret{Spec: SecretSpec{ForProvider: tc.params}}
+			err := cr.ResolveReferences(context.Background(), tc.kube)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.arn, cr.Spec.ForProvider.RotationLambdaARN); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -49,6 +49,11 @@ func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 		*out = new(SecretReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SyncDirection != nil {
+		in, out := &in.SyncDirection, &out.SyncDirection
+		*out = new(string)
+		**out = **in
+	}
 	if in.ForceDeleteWithoutRecovery != nil {
 		in, out := &in.ForceDeleteWithoutRecovery, &out.ForceDeleteWithoutRecovery
 		*out = new(bool)
@@ -64,6 +69,31 @@ func (in *CustomSecretParameters) DeepCopyInto(out *CustomSecretParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RotationRules != nil {
+		in, out := &in.RotationRules, &out.RotationRules
+		*out = new(RotationRules)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationLambdaARN != nil {
+		in, out := &in.RotationLambdaARN, &out.RotationLambdaARN
+		*out = new(string)
+		**out = **in
+	}
+	if in.RotationLambdaARNRef != nil {
+		in, out := &in.RotationLambdaARNRef, &out.RotationLambdaARNRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotationLambdaARNSelector != nil {
+		in, out := &in.RotationLambdaARNSelector, &out.RotationLambdaARNSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RotateImmediately != nil {
+		in, out := &in.RotateImmediately, &out.RotateImmediately
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomSecretParameters.
@@ -171,6 +201,36 @@ func (in *ReplicationStatusType) DeepCopy() *ReplicationStatusType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationRules) DeepCopyInto(out *RotationRules) {
+	*out = *in
+	if in.AutomaticallyAfterDays != nil {
+		in, out := &in.AutomaticallyAfterDays, &out.AutomaticallyAfterDays
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ScheduleExpression != nil {
+		in, out := &in.ScheduleExpression, &out.ScheduleExpression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationRules.
+func (in *RotationRules) DeepCopy() *RotationRules {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationRules)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RotationRulesType) DeepCopyInto(out *RotationRulesType) {
 	*out = *in
@@ -370,6 +430,14 @@ func (in *SecretObservation) DeepCopyInto(out *SecretObservation) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastRotatedDate != nil {
+		in, out := &in.LastRotatedDate, &out.LastRotatedDate
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRotationDate != nil {
+		in, out := &in.NextRotationDate, &out.NextRotationDate
+		*out = (*in).DeepCopy()
+	}
 	if in.ReplicationStatus != nil {
 		in, out := &in.ReplicationStatus, &out.ReplicationStatus
 		*out = make([]*ReplicationStatusType, len(*in))
@@ -487,6 +555,7 @@ func (in *SecretSpec) DeepCopy() *SecretSpec {
 func (in *SecretStatus) DeepCopyInto(out *SecretStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -348,6 +348,7 @@ func (in *HTTPNamespaceSpec) DeepCopy() *HTTPNamespaceSpec {
 func (in *HTTPNamespaceStatus) DeepCopyInto(out *HTTPNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -708,6 +709,7 @@ func (in *PrivateDNSNamespaceSpec) DeepCopy() *PrivateDNSNamespaceSpec {
 func (in *PrivateDNSNamespaceStatus) DeepCopyInto(out *PrivateDNSNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
@@ -968,6 +970,7 @@ func (in *PublicDNSNamespaceSpec) DeepCopy() *PublicDNSNamespaceSpec {
 func (in *PublicDNSNamespaceStatus) DeepCopyInto(out *PublicDNSNamespaceStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
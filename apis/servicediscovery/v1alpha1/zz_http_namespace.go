@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // HTTPNamespaceParameters defines the desired state of HTTPNamespace
@@ -56,8 +58,9 @@ type HTTPNamespaceObservation struct {
 
 // HTTPNamespaceStatus defines the observed state of HTTPNamespace.
 type HTTPNamespaceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          HTTPNamespaceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  HTTPNamespaceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
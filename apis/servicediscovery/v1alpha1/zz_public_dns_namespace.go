@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // PublicDNSNamespaceParameters defines the desired state of PublicDNSNamespace
@@ -58,8 +60,9 @@ type PublicDNSNamespaceObservation struct {
 
 // PublicDNSNamespaceStatus defines the observed state of PublicDNSNamespace.
 type PublicDNSNamespaceStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          PublicDNSNamespaceObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  PublicDNSNamespaceObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ActivityParameters defines the desired state of Activity
@@ -79,8 +81,9 @@ type ActivityObservation struct {
 
 // ActivityStatus defines the observed state of Activity.
 type ActivityStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ActivityObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ActivityObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
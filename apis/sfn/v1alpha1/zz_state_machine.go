@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // StateMachineParameters defines the desired state of StateMachine
@@ -88,8 +90,9 @@ type StateMachineObservation struct {
 
 // StateMachineStatus defines the observed state of StateMachine.
 type StateMachineStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          StateMachineObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  StateMachineObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // SubscriptionParameters define the desired state of a AWS SNS Topic
@@ -111,8 +113,9 @@ type SubscriptionObservation struct {
 
 // SubscriptionStatus is the status of AWS SNS Topic
 type SubscriptionStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          SubscriptionObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  SubscriptionObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Tag represent a user-provided metadata that can be associated with a
@@ -120,8 +122,9 @@ type TopicObservation struct {
 
 // TopicStatus is the status of AWS SNS Topic
 type TopicStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          TopicObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  TopicObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -20,6 +20,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // Enum values for Queue attribute names
@@ -219,8 +221,9 @@ type QueueObservation struct {
 
 // QueueStatus represents the observed state of a Queue.
 type QueueStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          QueueObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  QueueObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
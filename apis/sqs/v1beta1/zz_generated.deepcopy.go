@@ -216,6 +216,7 @@ func (in *QueueSpec) DeepCopy() *QueueSpec {
 func (in *QueueStatus) DeepCopyInto(out *QueueStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ReconcileStatus.DeepCopyInto(&out.ReconcileStatus)
 	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
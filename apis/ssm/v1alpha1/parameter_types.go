@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+const (
+	// ResourceCredentialsSecretValueKey is the key of the value of the
+	// parameter in the connection secret.
+	ResourceCredentialsSecretValueKey = "value"
+)
+
+// Types of a Parameter.
+const (
+	ParameterTypeString       = "String"
+	ParameterTypeStringList   = "StringList"
+	ParameterTypeSecureString = "SecureString"
+)
+
+// Tiers of a Parameter.
+const (
+	ParameterTierStandard           = "Standard"
+	ParameterTierAdvanced           = "Advanced"
+	ParameterTierIntelligentTiering = "Intelligent-Tiering"
+)
+
+// Tag is a key-value pair attached to a Parameter.
+type Tag struct {
+	// Key is the name of the tag.
+	Key string `json:"key"`
+
+	// Value is the value of the tag.
+	Value string `json:"value"`
+}
+
+// A SecretReference is a reference to a secret in an arbitrary namespace.
+type SecretReference struct {
+	// Name of the secret.
+	Name string `json:"name"`
+
+	// Namespace of the secret.
+	Namespace string `json:"namespace"`
+
+	// Key whose value will be used. If not given, the whole map in the Secret
+	// data will be marshalled into JSON and used.
+	// +optional
+	Key *string `json:"key,omitempty"`
+}
+
+// ParameterParameters define the desired state of an AWS Systems Manager
+// Parameter Store parameter. The name of the parameter is taken from the
+// crossplane.io/external-name annotation, which defaults to the name of the
+// resource. Set the annotation to use a hierarchical name such as
+// /my-app/database/url.
+type ParameterParameters struct {
+	// Region is the region you'd like your Parameter to be created in.
+	Region string `json:"region"`
+
+	// Type of the parameter. A StringList is a comma-separated list of
+	// values. A SecureString is encrypted with the KMS key given in KeyID.
+	// +kubebuilder:validation:Enum=String;StringList;SecureString
+	Type string `json:"type"`
+
+	// ValueSecretRef points to the Kubernetes Secret whose data will be used
+	// as the value of the parameter. If key is given, only the value of that
+	// key will be used. Otherwise, all data in the Secret will be marshalled
+	// into JSON.
+	ValueSecretRef SecretReference `json:"valueSecretRef"`
+
+	// Description of the parameter.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// KeyID is the ID, ARN or alias of the KMS key used to encrypt a
+	// SecureString parameter. Defaults to the AWS managed key of the account
+	// (alias/aws/ssm).
+	// +optional
+	KeyID *string `json:"keyId,omitempty"`
+
+	// KeyIDRef is a reference to a kms/v1alpha1.Key used to set the KeyID
+	// field.
+	// +optional
+	KeyIDRef *xpv1.Reference `json:"keyIdRef,omitempty"`
+
+	// KeyIDSelector selects references to kms/v1alpha1.Key used to set the
+	// KeyID field.
+	// +optional
+	KeyIDSelector *xpv1.Selector `json:"keyIdSelector,omitempty"`
+
+	// Tier of the parameter. Advanced parameters allow bigger values and
+	// policies. Intelligent-Tiering picks Standard or Advanced depending on
+	// the value and policies. An Advanced parameter cannot be reverted to
+	// Standard.
+	// +kubebuilder:validation:Enum=Standard;Advanced;Intelligent-Tiering
+	// +optional
+	Tier *string `json:"tier,omitempty"`
+
+	// Policies is a JSON array of policies of an Advanced parameter, e.g.
+	// Expiration, ExpirationNotification or NoChangeNotification policies.
+	// See https://docs.aws.amazon.com/systems-manager/latest/userguide/parameter-store-policies.html
+	// +optional
+	Policies *string `json:"policies,omitempty"`
+
+	// AllowedPattern is a regular expression used to validate the value of
+	// the parameter, e.g. ^\d+$.
+	// +optional
+	AllowedPattern *string `json:"allowedPattern,omitempty"`
+
+	// DataType of a String parameter. Use aws:ec2:image to have AWS validate
+	// that the value is the ID of an Amazon Machine Image.
+	// +kubebuilder:validation:Enum=text;"aws:ec2:image";"aws:ssm:integration"
+	// +optional
+	DataType *string `json:"dataType,omitempty"`
+
+	// Tags to add to the parameter.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// ParameterSpec defines the desired state of a Parameter.
+type ParameterSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ParameterParameters `json:"forProvider"`
+}
+
+// ParameterObservation is the observed state of a Parameter.
+type ParameterObservation struct {
+	// ARN of the parameter.
+	ARN string `json:"arn,omitempty"`
+
+	// Version of the parameter. It is incremented every time the value or
+	// the settings of the parameter change.
+	Version int64 `json:"version,omitempty"`
+
+	// Tier of the parameter as chosen by AWS.
+	Tier string `json:"tier,omitempty"`
+
+	// LastModifiedDate is the time the parameter was last changed.
+	LastModifiedDate *metav1.Time `json:"lastModifiedDate,omitempty"`
+}
+
+// ParameterStatus is the status of a Parameter.
+type ParameterStatus struct {
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ParameterObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Parameter is a managed resource that represents an AWS Systems Manager
+// Parameter Store parameter.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="TYPE",type="string",JSONPath=".spec.forProvider.type"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,aws}
+type Parameter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ParameterSpec   `json:"spec"`
+	Status ParameterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ParameterList contains a list of Parameter
+type ParameterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Parameter `json:"items"`
+}
@@ -22,6 +22,8 @@ import (
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 )
 
 // ServerParameters defines the desired state of Server
@@ -134,8 +136,9 @@ type ServerObservation struct {
 
 // ServerStatus defines the observed state of Server.
 type ServerStatus struct {
-	xpv1.ResourceStatus `json:",inline"`
-	AtProvider          ServerObservation `json:"atProvider,omitempty"`
+	xpv1.ResourceStatus         `json:",inline"`
+	awsv1alpha1.ReconcileStatus `json:",inline"`
+	AtProvider                  ServerObservation `json:"atProvider,omitempty"`
 }
 
 // +kubebuilder:object:root=true
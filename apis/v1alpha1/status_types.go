@@ -0,0 +1,71 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// A ReconcileStatus is the part of the status of a managed resource that is
+// common to all AWS managed resources, in addition to their conditions.
+type ReconcileStatus struct {
+	// LastHandledReconcileAt is the value of the
+	// aws.crossplane.io/reconcile-requested-at annotation of the last
+	// reconcile request that was handled.
+	// +optional
+	LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+
+	// LastHandledReconcileTime is the time the managed resource was first
+	// observed after the last reconcile request.
+	// +optional
+	LastHandledReconcileTime *metav1.Time `json:"lastHandledReconcileTime,omitempty"`
+
+	// SyncedGeneration is the generation of the managed resource the
+	// external resource was last observed to be up to date with.
+	// +optional
+	SyncedGeneration int64 `json:"syncedGeneration,omitempty"`
+
+	// Drift is the drift of the external resource that was last detected,
+	// i.e. the fields in which it differs from the desired state of the
+	// managed resource although the desired state did not change since the
+	// external resource was up to date. It is only recorded by controllers
+	// that know which fields drifted.
+	// +optional
+	Drift []FieldDrift `json:"drift,omitempty"`
+}
+
+// A FieldDrift is a field of an external resource that differs from the
+// desired state of its managed resource.
+type FieldDrift struct {
+	// Path of the field, e.g. Tags[0].Value.
+	Path string `json:"path"`
+
+	// Desired value of the field in JSON. Empty if the field is not desired.
+	// +optional
+	Desired string `json:"desired,omitempty"`
+
+	// Observed value of the field in JSON. Empty if the field was not
+	// observed.
+	// +optional
+	Observed string `json:"observed,omitempty"`
+}
+
+// GetReconcileStatus returns the ReconcileStatus. It is promoted to the
+// status of managed resources that embed the ReconcileStatus.
+func (s *ReconcileStatus) GetReconcileStatus() *ReconcileStatus {
+	return s
+}
@@ -33,6 +33,14 @@ type ProviderConfigSpec struct {
 	// AssumeRoleWithWebIdentity defines the options for assuming an IAM role with a Web Identity
 	AssumeRoleWithWebIdentity *AssumeRoleWithWebIdentityOptions `json:"assumeRoleWithWebIdentity,omitempty"`
 
+	// AssumeRoleChain defines the options for assuming a chain of IAM roles.
+	// The roles are assumed in order, each one with the credentials of the
+	// previous one. The first role is assumed with the credentials that
+	// result from the credentials source and, if set, assumeRole or
+	// assumeRoleWithWebIdentity.
+	// +optional
+	AssumeRoleChain []AssumeRoleOptions `json:"assumeRoleChain,omitempty"`
+
 	// AssumeRoleARN to assume with provider credentials
 	// This setting will be deprecated. Use the roleARN field under assumeRole instead.
 	// +optional
@@ -47,15 +55,153 @@ type ProviderConfigSpec struct {
 	// of AWS calls made by the provider.
 	// +optional
 	Endpoint *EndpointConfig `json:"endpoint,omitempty"`
+
+	// RateLimit limits the rate at which requests are sent to the AWS API
+	// with this ProviderConfig, across all managed resources that use it.
+	// +optional
+	RateLimit *RateLimit `json:"rateLimit,omitempty"`
+
+	// Retry configures how requests to the AWS API that fail with a
+	// retryable error, e.g. because they were throttled, are retried.
+	// +optional
+	Retry *RetryPolicy `json:"retry,omitempty"`
+
+	// ReadOnly prevents managed resources that use this ProviderConfig from
+	// making any changes to AWS. Their external resources are only observed
+	// and the ReadOnly condition of the managed resources is true. External
+	// resources that do not exist are not created, and drift is reported in
+	// the Drifted condition but never corrected. Deleting a managed resource
+	// orphans its external resource, regardless of its deletion policy.
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
 }
 
+// RateLimit configures a token bucket that limits the rate of requests to
+// the AWS API.
+type RateLimit struct {
+	// RequestsPerSecond is the rate at which the bucket is refilled.
+	// +kubebuilder:validation:Minimum=1
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+
+	// Burst is the size of the bucket, i.e. the number of requests that can
+	// be sent at once. It defaults to requestsPerSecond.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
+// A RetryMode determines how requests are retried.
+type RetryMode string
+
+// Retry modes.
+const (
+	// RetryModeStandard retries requests with an exponential backoff and a
+	// retry quota that is shared by all requests of a client.
+	RetryModeStandard RetryMode = "Standard"
+
+	// RetryModeAdaptive retries requests like RetryModeStandard and
+	// additionally slows down all requests of a client when requests are
+	// throttled. It is only effective for resources that use AWS SDK v2,
+	// others fall back to RetryModeStandard.
+	RetryModeAdaptive RetryMode = "Adaptive"
+)
+
+// A RetryPolicy configures how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of times a failed request is retried.
+	// It defaults to the default of the AWS SDK.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+
+	// Mode is the retry mode. It defaults to Standard. Adaptive is only
+	// supported by the controllers that use AWS SDK v2; the controllers that
+	// are generated with the AWS Go code generator use AWS SDK v1 and retry
+	// requests in Standard mode.
+	// +kubebuilder:validation:Enum=Standard;Adaptive
+	// +optional
+	Mode *RetryMode `json:"mode,omitempty"`
+}
+
+// Credentials sources that are specific to this provider.
+const (
+	// CredentialsSourceProcess indicates that the provider should get its
+	// credentials from an external process that implements the
+	// credential_process interface of the AWS CLI.
+	CredentialsSourceProcess xpv1.CredentialsSource = "Process"
+
+	// CredentialsSourceRolesAnywhere indicates that the provider should get
+	// its credentials from IAM Roles Anywhere, using an X.509 certificate and
+	// private key.
+	CredentialsSourceRolesAnywhere xpv1.CredentialsSource = "RolesAnywhere"
+)
+
 // ProviderCredentials required to authenticate.
 type ProviderCredentials struct {
 	// Source of the provider credentials.
-	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem
+	// +kubebuilder:validation:Enum=None;Secret;InjectedIdentity;Environment;Filesystem;Process;RolesAnywhere
 	Source xpv1.CredentialsSource `json:"source"`
 
 	xpv1.CommonCredentialSelectors `json:",inline"`
+
+	// Process configures the external process credentials are read from
+	// when the source is Process.
+	// +optional
+	Process *ProcessCredentials `json:"process,omitempty"`
+
+	// RolesAnywhere configures how credentials are obtained from IAM Roles
+	// Anywhere when the source is RolesAnywhere.
+	// +optional
+	RolesAnywhere *RolesAnywhereCredentials `json:"rolesAnywhere,omitempty"`
+}
+
+// ProcessCredentials configure an external process that prints credentials
+// in the format of the credential_process setting of the AWS CLI. See
+// https://docs.aws.amazon.com/cli/latest/userguide/cli-configure-sourcing-external.html
+type ProcessCredentials struct {
+	// Command is the path of the executable to run. It must be available
+	// in the provider container. The command is not run in a shell.
+	// The Process credentials source is disabled unless the provider is
+	// started with --enable-credential-process, and only the commands
+	// allowed with --credential-process-command can be run.
+	Command string `json:"command"`
+
+	// Args are the arguments the command is run with.
+	// +optional
+	Args []string `json:"args,omitempty"`
+
+	// Timeout limits the time the command can run. It defaults to one
+	// minute.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// RolesAnywhereCredentials configure how temporary credentials are obtained
+// from IAM Roles Anywhere. See
+// https://docs.aws.amazon.com/rolesanywhere/latest/userguide/introduction.html
+type RolesAnywhereCredentials struct {
+	// TrustAnchorARN is the ARN of the trust anchor that validates the
+	// certificate. Credentials are obtained in the region of the trust
+	// anchor.
+	TrustAnchorARN string `json:"trustAnchorARN"`
+
+	// ProfileARN is the ARN of the profile that specifies the roles that can
+	// be assumed.
+	ProfileARN string `json:"profileARN"`
+
+	// RoleARN is the ARN of the role to assume.
+	RoleARN string `json:"roleARN"`
+
+	// SessionDuration is the duration of the role session. It defaults to
+	// one hour.
+	// +optional
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty"`
+
+	// CertificateSecretRef references a Secret of type kubernetes.io/tls
+	// that contains the PEM encoded certificate, followed by any
+	// intermediate certificates, in tls.crt and the private key in tls.key.
+	// RSA and ECDSA keys are supported.
+	CertificateSecretRef xpv1.SecretReference `json:"certificateSecretRef"`
 }
 
 // Tag is session tag that can be used to assume an IAM Role
@@ -92,6 +238,49 @@ type AssumeRoleOptions struct {
 	// (https://docs.aws.amazon.com/IAM/latest/UserGuide/id_session-tags.html#id_session-tags_role-chaining).
 	// +optional
 	TransitiveTagKeys []string `json:"transitiveTagKeys,omitempty"`
+
+	// SessionDuration is the duration of the role session. It defaults to
+	// 15 minutes and can not exceed the maximum session duration of the role.
+	// Sessions of roles assumed in a chain are limited to one hour.
+	// +optional
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty"`
+
+	// RoleSessionName is the name of the role session, which is visible in
+	// CloudTrail. It is a Go template that can refer to the ProviderConfig,
+	// Group, Kind and Name of the managed resource the session is used for,
+	// e.g. "{{.ProviderConfig}}-{{.Kind}}-{{.Name}}". Characters that are not
+	// allowed in a session name are replaced with a dash and the name is
+	// truncated to 64 characters. The rendered name must be at least 2
+	// characters long. Note that a session name that refers to the Name of
+	// the managed resource requires a role session per managed resource. At
+	// most 1000 role sessions are cached, so if more managed resources use
+	// the ProviderConfig, the role is assumed again on almost every reconcile.
+	// +optional
+	RoleSessionName *string `json:"roleSessionName,omitempty"`
+
+	// SourceIdentity is the source identity of the role session, which is
+	// visible in CloudTrail and persists across roles assumed in a chain. It
+	// is a Go template that accepts the same parameters as RoleSessionName.
+	// +optional
+	SourceIdentity *string `json:"sourceIdentity,omitempty"`
+
+	// SessionPolicies downscope the permissions of the role session.
+	// +optional
+	SessionPolicies *SessionPolicies `json:"sessionPolicies,omitempty"`
+}
+
+// SessionPolicies limit the permissions of a role session to the
+// intersection of the role's identity-based policies and the session
+// policies. See
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/access_policies.html#policies_session
+type SessionPolicies struct {
+	// Policy is an inline IAM policy document in JSON format.
+	// +optional
+	Policy *string `json:"policy,omitempty"`
+
+	// PolicyARNs are the ARNs of managed IAM policies.
+	// +optional
+	PolicyARNs []string `json:"policyARNs,omitempty"`
 }
 
 // AssumeRoleWithWebIdentityOptions define the options for assuming an IAM Role
@@ -101,8 +290,19 @@ type AssumeRoleWithWebIdentityOptions struct {
 	RoleARN *string `json:"roleARN,omitempty"`
 
 	// RoleSessionName is the session name, if you wish to uniquely identify this session.
+	// It is a Go template that accepts the same parameters as the
+	// RoleSessionName of assumeRole.
 	// +optional
 	RoleSessionName string `json:"roleSessionName,omitempty"`
+
+	// SessionDuration is the duration of the role session. It defaults to
+	// one hour and can not exceed the maximum session duration of the role.
+	// +optional
+	SessionDuration *metav1.Duration `json:"sessionDuration,omitempty"`
+
+	// SessionPolicies downscope the permissions of the role session.
+	// +optional
+	SessionPolicies *SessionPolicies `json:"sessionPolicies,omitempty"`
 }
 
 // EndpointConfig is used to configure the AWS client for a custom endpoint.
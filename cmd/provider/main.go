@@ -41,8 +41,10 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	utilscontroller "github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 func main() {
@@ -51,15 +53,29 @@ func main() {
 		debug            = app.Flag("debug", "Run with debug logging.").Short('d').Bool()
 		syncInterval     = app.Flag("sync", "Sync interval controls how often all resources will be double checked for drift.").Short('s').Default("1h").Duration()
 		pollInterval     = app.Flag("poll", "Poll interval controls how often an individual resource should be checked for drift.").Default("1m").Duration()
+		pollIntervals    = app.Flag("poll-interval-override", "Poll interval of the resources of a kind, identified by its lower case kind and group, e.g. bucket.s3.aws.crossplane.io=1h. Overrides --poll for all resources of the kind, including ready and synced ones. May be repeated.").StringMap()
 		leaderElection   = app.Flag("leader-election", "Use leader election for the conroller manager.").Short('l').Default("false").OverrideDefaultFromEnvar("LEADER_ELECTION").Bool()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
 
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableEnhancedMetrics      = app.Flag("enable-enhanced-metrics", "Enable enhanced prometheus metrics.").Default("true").Envar("ENABLE_ENHANCED_METRICS").Bool()
+		enableCredentialProcess    = app.Flag("enable-credential-process", "Enable the Process credentials source of ProviderConfigs for the commands allowed with --credential-process-command.").Default("false").Envar("ENABLE_CREDENTIAL_PROCESS").Bool()
+		credentialProcessCommands  = app.Flag("credential-process-command", "Command that the Process credentials source of ProviderConfigs is allowed to run, e.g. /usr/local/bin/aws_signing_helper. Anyone who can create a ProviderConfig can run an allowed command with any arguments. May be repeated.").Strings()
+
+		filterUpdateEvents       = app.Flag("filter-update-events", "Controllers that ignore update events of managed resources that are ready and synced and whose spec did not change, identified by the lower case kind and group of the resources, e.g. securitygroup.ec2.aws.crossplane.io. Use * for all controllers. May be repeated.").Default(utilscontroller.DefaultUpdateFilterControllers...).Strings()
+		filterAllowedAnnotations = app.Flag("filter-update-events-allowed-annotation", "Annotation whose changes are never ignored by controllers that filter update events. May be repeated.").Strings()
+		driftAction              = app.Flag("drift-action", "What to do if an external resource differs from the desired state of its managed resource: Correct it, or only Report it. Can be overridden per managed resource with the aws.crossplane.io/drift-action annotation.").Default(string(utilscontroller.DriftActionCorrect)).Enum(string(utilscontroller.DriftActionCorrect), string(utilscontroller.DriftActionReport))
 	)
 	kingpin.MustParse(app.Parse(os.Args[1:]))
 
+	polls := make(map[string]time.Duration, len(*pollIntervals))
+	for kind, v := range *pollIntervals {
+		d, err := time.ParseDuration(v)
+		kingpin.FatalIfError(err, "Cannot parse poll interval of %s", kind)
+		polls[kind] = d
+	}
+
 	zl := zap.New(zap.UseDevMode(*debug), func(o *zap.Options) {
 		o.TimeEncoder = zapcore.RFC3339NanoTimeEncoder
 	})
@@ -95,12 +111,20 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add AWS APIs to scheme")
 
-	o := xpcontroller.Options{
-		Logger:                  log,
-		MaxConcurrentReconciles: *maxReconcileRate,
-		PollInterval:            *pollInterval,
-		GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
-		Features:                &feature.Flags{},
+	o := utilscontroller.Options{
+		Options: xpcontroller.Options{
+			Logger:                  log,
+			MaxConcurrentReconciles: *maxReconcileRate,
+			PollInterval:            *pollInterval,
+			GlobalRateLimiter:       ratelimiter.NewGlobal(*maxReconcileRate),
+			Features:                &feature.Flags{},
+		},
+		UpdateFilter: utilscontroller.UpdateFilterOptions{
+			Controllers: *filterUpdateEvents,
+			Annotations: *filterAllowedAnnotations,
+		},
+		PollIntervals: polls,
+		DriftAction:   utilscontroller.DriftAction(*driftAction),
 	}
 
 	if *enableExternalSecretStores {
@@ -122,6 +146,13 @@ func main() {
 		})), "cannot create default store config")
 	}
 
+	if *enableCredentialProcess {
+		o.Features.Enable(features.EnableAlphaCredentialProcess)
+		log.Info("Alpha feature enabled", "flag", features.EnableAlphaCredentialProcess, "commands", *credentialProcessCommands)
+		awsclient.AllowCredentialProcesses(*credentialProcessCommands...)
+	}
+
+	awsclient.RegisterMetrics(metrics.Registry)
 	if *enableEnhancedMetrics {
 		o.MetricsReconciler = managed.NewPrometheusMetricsReconciler(metrics.Registry)
 	} else {
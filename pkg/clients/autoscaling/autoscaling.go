@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+)
+
+const (
+	// errCodeValidationError is returned by Amazon EC2 Auto Scaling when a
+	// request is invalid, including when the group does not exist.
+	errCodeValidationError = "ValidationError"
+)
+
+// Client is the external client used for the Amazon EC2 Auto Scaling custom
+// resources.
+type Client interface {
+	DescribeAutoScalingGroupsWithContext(ctx aws.Context, input *autoscaling.DescribeAutoScalingGroupsInput, opts ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	CreateAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.CreateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error)
+	UpdateAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.UpdateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+	DeleteAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.DeleteAutoScalingGroupInput, opts ...request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error)
+	AttachLoadBalancerTargetGroupsWithContext(ctx aws.Context, input *autoscaling.AttachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error)
+	DetachLoadBalancerTargetGroupsWithContext(ctx aws.Context, input *autoscaling.DetachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error)
+	CreateOrUpdateTagsWithContext(ctx aws.Context, input *autoscaling.CreateOrUpdateTagsInput, opts ...request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error)
+	DeleteTagsWithContext(ctx aws.Context, input *autoscaling.DeleteTagsInput, opts ...request.Option) (*autoscaling.DeleteTagsOutput, error)
+	DescribeInstanceRefreshesWithContext(ctx aws.Context, input *autoscaling.DescribeInstanceRefreshesInput, opts ...request.Option) (*autoscaling.DescribeInstanceRefreshesOutput, error)
+	StartInstanceRefreshWithContext(ctx aws.Context, input *autoscaling.StartInstanceRefreshInput, opts ...request.Option) (*autoscaling.StartInstanceRefreshOutput, error)
+	DescribeLifecycleHooksWithContext(ctx aws.Context, input *autoscaling.DescribeLifecycleHooksInput, opts ...request.Option) (*autoscaling.DescribeLifecycleHooksOutput, error)
+	PutLifecycleHookWithContext(ctx aws.Context, input *autoscaling.PutLifecycleHookInput, opts ...request.Option) (*autoscaling.PutLifecycleHookOutput, error)
+	DeleteLifecycleHookWithContext(ctx aws.Context, input *autoscaling.DeleteLifecycleHookInput, opts ...request.Option) (*autoscaling.DeleteLifecycleHookOutput, error)
+	DescribePoliciesWithContext(ctx aws.Context, input *autoscaling.DescribePoliciesInput, opts ...request.Option) (*autoscaling.DescribePoliciesOutput, error)
+	PutScalingPolicyWithContext(ctx aws.Context, input *autoscaling.PutScalingPolicyInput, opts ...request.Option) (*autoscaling.PutScalingPolicyOutput, error)
+	DeletePolicyWithContext(ctx aws.Context, input *autoscaling.DeletePolicyInput, opts ...request.Option) (*autoscaling.DeletePolicyOutput, error)
+	DescribeWarmPoolWithContext(ctx aws.Context, input *autoscaling.DescribeWarmPoolInput, opts ...request.Option) (*autoscaling.DescribeWarmPoolOutput, error)
+	PutWarmPoolWithContext(ctx aws.Context, input *autoscaling.PutWarmPoolInput, opts ...request.Option) (*autoscaling.PutWarmPoolOutput, error)
+	DeleteWarmPoolWithContext(ctx aws.Context, input *autoscaling.DeleteWarmPoolInput, opts ...request.Option) (*autoscaling.DeleteWarmPoolOutput, error)
+}
+
+// NewClient returns a new Amazon EC2 Auto Scaling client.
+func NewClient(sess *session.Session) Client {
+	return autoscaling.New(sess)
+}
+
+// IsNotFound returns whether the given error indicates that the requested
+// resource or its Auto Scaling group does not exist.
+func IsNotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	if awsErr.Code() != errCodeValidationError {
+		return false
+	}
+	// NOTE: The message is the only way to tell missing resources apart from
+	// other invalid requests, e.g. "AutoScalingGroup name not found" or
+	// "No warm pool found".
+	msg := strings.ToLower(awsErr.Message())
+	return strings.Contains(msg, "not found") || (strings.HasPrefix(msg, "no ") && strings.Contains(msg, " found"))
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// TagResourceTypeAutoScalingGroup is the resource type of the tags of an
+	// Auto Scaling group.
+	TagResourceTypeAutoScalingGroup = "auto-scaling-group"
+
+	// awsTagPrefix is the prefix of the tags reserved by AWS, they cannot be
+	// removed.
+	awsTagPrefix = "aws:"
+)
+
+// GenerateLaunchTemplateSpecification returns the launch template the
+// instances of the group are launched with.
+func GenerateLaunchTemplateSpecification(lt v1alpha1.LaunchTemplateSpecification) *autoscaling.LaunchTemplateSpecification {
+	return &autoscaling.LaunchTemplateSpecification{
+		LaunchTemplateId:   lt.LaunchTemplateID,
+		LaunchTemplateName: lt.LaunchTemplateName,
+		Version:            lt.Version,
+	}
+}
+
+// GenerateCreateAutoScalingGroupInput returns the input to create the group
+// with the given name.
+func GenerateCreateAutoScalingGroupInput(name string, p *v1alpha1.AutoScalingGroupParameters) *autoscaling.CreateAutoScalingGroupInput {
+	in := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:             aws.String(name),
+		LaunchTemplate:                   GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MinSize:                          aws.Int64(p.MinSize),
+		MaxSize:                          aws.Int64(p.MaxSize),
+		DesiredCapacity:                  p.DesiredCapacity,
+		AvailabilityZones:                aws.StringSlice(p.AvailabilityZones),
+		VPCZoneIdentifier:                generateVPCZoneIdentifier(p.SubnetIDs),
+		TargetGroupARNs:                  aws.StringSlice(p.TargetGroupARNs),
+		HealthCheckType:                  p.HealthCheckType,
+		HealthCheckGracePeriod:           p.HealthCheckGracePeriod,
+		DefaultCooldown:                  p.DefaultCooldown,
+		DefaultInstanceWarmup:            p.DefaultInstanceWarmup,
+		TerminationPolicies:              aws.StringSlice(p.TerminationPolicies),
+		NewInstancesProtectedFromScaleIn: p.NewInstancesProtectedFromScaleIn,
+		CapacityRebalance:                p.CapacityRebalance,
+		MaxInstanceLifetime:              p.MaxInstanceLifetime,
+		PlacementGroup:                   p.PlacementGroup,
+		ServiceLinkedRoleARN:             p.ServiceLinkedRoleARN,
+	}
+	for _, t := range p.Tags {
+		in.Tags = append(in.Tags, &autoscaling.Tag{
+			Key:               aws.String(t.Key),
+			Value:             aws.String(t.Value),
+			PropagateAtLaunch: t.PropagateAtLaunch,
+		})
+	}
+	return in
+}
+
+// GenerateUpdateAutoScalingGroupInput returns the input to update the group
+// with the given name. Target groups and tags are updated separately.
+func GenerateUpdateAutoScalingGroupInput(name string, p *v1alpha1.AutoScalingGroupParameters) *autoscaling.UpdateAutoScalingGroupInput {
+	return &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:             aws.String(name),
+		LaunchTemplate:                   GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		MinSize:                          aws.Int64(p.MinSize),
+		MaxSize:                          aws.Int64(p.MaxSize),
+		DesiredCapacity:                  p.DesiredCapacity,
+		AvailabilityZones:                aws.StringSlice(p.AvailabilityZones),
+		VPCZoneIdentifier:                generateVPCZoneIdentifier(p.SubnetIDs),
+		HealthCheckType:                  p.HealthCheckType,
+		HealthCheckGracePeriod:           p.HealthCheckGracePeriod,
+		DefaultCooldown:                  p.DefaultCooldown,
+		DefaultInstanceWarmup:            p.DefaultInstanceWarmup,
+		TerminationPolicies:              aws.StringSlice(p.TerminationPolicies),
+		NewInstancesProtectedFromScaleIn: p.NewInstancesProtectedFromScaleIn,
+		CapacityRebalance:                p.CapacityRebalance,
+		MaxInstanceLifetime:              p.MaxInstanceLifetime,
+		PlacementGroup:                   p.PlacementGroup,
+		ServiceLinkedRoleARN:             p.ServiceLinkedRoleARN,
+	}
+}
+
+// GenerateStartInstanceRefreshInput returns the input to start an instance
+// refresh that moves the group with the given name to the launch template in
+// the spec.
+func GenerateStartInstanceRefreshInput(name string, p *v1alpha1.AutoScalingGroupParameters) *autoscaling.StartInstanceRefreshInput {
+	in := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		DesiredConfiguration: &autoscaling.DesiredConfiguration{
+			LaunchTemplate: GenerateLaunchTemplateSpecification(p.LaunchTemplate),
+		},
+	}
+	if r := p.InstanceRefresh; r != nil {
+		in.Preferences = &autoscaling.RefreshPreferences{
+			MinHealthyPercentage: r.MinHealthyPercentage,
+			InstanceWarmup:       r.InstanceWarmup,
+			CheckpointDelay:      r.CheckpointDelay,
+			SkipMatching:         r.SkipMatching,
+		}
+		if len(r.CheckpointPercentages) > 0 {
+			in.Preferences.CheckpointPercentages = aws.Int64Slice(r.CheckpointPercentages)
+		}
+	}
+	return in
+}
+
+// LateInitializeAutoScalingGroup fills the empty fields in
+// *v1alpha1.AutoScalingGroupParameters with the values seen in
+// autoscaling.Group. DesiredCapacity is left empty so that it can be managed
+// by scaling policies.
+func LateInitializeAutoScalingGroup(in *v1alpha1.AutoScalingGroupParameters, g *autoscaling.Group) {
+	if g.LaunchTemplate != nil {
+		in.LaunchTemplate.Version = awsclients.LateInitializeStringPtr(in.LaunchTemplate.Version, g.LaunchTemplate.Version)
+	}
+	in.HealthCheckType = awsclients.LateInitializeStringPtr(in.HealthCheckType, g.HealthCheckType)
+	in.HealthCheckGracePeriod = awsclients.LateInitializeInt64Ptr(in.HealthCheckGracePeriod, g.HealthCheckGracePeriod)
+	in.DefaultCooldown = awsclients.LateInitializeInt64Ptr(in.DefaultCooldown, g.DefaultCooldown)
+	in.NewInstancesProtectedFromScaleIn = awsclients.LateInitializeBoolPtr(in.NewInstancesProtectedFromScaleIn, g.NewInstancesProtectedFromScaleIn)
+	in.CapacityRebalance = awsclients.LateInitializeBoolPtr(in.CapacityRebalance, g.CapacityRebalance)
+	in.ServiceLinkedRoleARN = awsclients.LateInitializeStringPtr(in.ServiceLinkedRoleARN, g.ServiceLinkedRoleARN)
+	if len(in.TerminationPolicies) == 0 {
+		in.TerminationPolicies = aws.StringValueSlice(g.TerminationPolicies)
+	}
+}
+
+// GenerateAutoScalingGroupObservation is used to produce
+// AutoScalingGroupObservation from autoscaling.Group.
+func GenerateAutoScalingGroupObservation(g *autoscaling.Group) v1alpha1.AutoScalingGroupObservation {
+	o := v1alpha1.AutoScalingGroupObservation{
+		ARN:             aws.StringValue(g.AutoScalingGroupARN),
+		Status:          aws.StringValue(g.Status),
+		DesiredCapacity: aws.Int64Value(g.DesiredCapacity),
+		WarmPoolSize:    aws.Int64Value(g.WarmPoolSize),
+	}
+	if g.LaunchTemplate != nil {
+		o.LaunchTemplate = &v1alpha1.LaunchTemplateObservation{
+			LaunchTemplateID:   aws.StringValue(g.LaunchTemplate.LaunchTemplateId),
+			LaunchTemplateName: aws.StringValue(g.LaunchTemplate.LaunchTemplateName),
+			Version:            aws.StringValue(g.LaunchTemplate.Version),
+		}
+	}
+	for _, i := range g.Instances {
+		io := v1alpha1.InstanceObservation{
+			InstanceID:           aws.StringValue(i.InstanceId),
+			InstanceType:         aws.StringValue(i.InstanceType),
+			AvailabilityZone:     aws.StringValue(i.AvailabilityZone),
+			LifecycleState:       aws.StringValue(i.LifecycleState),
+			HealthStatus:         aws.StringValue(i.HealthStatus),
+			ProtectedFromScaleIn: aws.BoolValue(i.ProtectedFromScaleIn),
+		}
+		if i.LaunchTemplate != nil {
+			io.LaunchTemplateVersion = aws.StringValue(i.LaunchTemplate.Version)
+		}
+		o.Instances = append(o.Instances, io)
+	}
+	if g.CreatedTime != nil {
+		o.CreatedTime = &metav1.Time{Time: *g.CreatedTime}
+	}
+	return o
+}
+
+// GenerateInstanceRefreshObservation is used to produce
+// InstanceRefreshObservation from autoscaling.InstanceRefresh.
+func GenerateInstanceRefreshObservation(r *autoscaling.InstanceRefresh) *v1alpha1.InstanceRefreshObservation {
+	if r == nil {
+		return nil
+	}
+	o := &v1alpha1.InstanceRefreshObservation{
+		InstanceRefreshID:  aws.StringValue(r.InstanceRefreshId),
+		Status:             aws.StringValue(r.Status),
+		StatusReason:       aws.StringValue(r.StatusReason),
+		PercentageComplete: aws.Int64Value(r.PercentageComplete),
+	}
+	if r.StartTime != nil {
+		o.StartTime = &metav1.Time{Time: *r.StartTime}
+	}
+	if r.EndTime != nil {
+		o.EndTime = &metav1.Time{Time: *r.EndTime}
+	}
+	return o
+}
+
+// IsAutoScalingGroupUpToDate checks whether the group in AWS matches the
+// desired state. Target groups and tags are compared separately with
+// DiffTargetGroups and DiffTags.
+func IsAutoScalingGroupUpToDate(p v1alpha1.AutoScalingGroupParameters, g *autoscaling.Group) bool { // nolint:gocyclo
+	switch {
+	case p.MinSize != aws.Int64Value(g.MinSize),
+		p.MaxSize != aws.Int64Value(g.MaxSize),
+		p.DesiredCapacity != nil && aws.Int64Value(p.DesiredCapacity) != aws.Int64Value(g.DesiredCapacity),
+		!IsLaunchTemplateUpToDate(p.LaunchTemplate, g.LaunchTemplate),
+		len(p.SubnetIDs) != 0 && !equalStringSets(p.SubnetIDs, splitVPCZoneIdentifier(g.VPCZoneIdentifier)),
+		len(p.AvailabilityZones) != 0 && !equalStringSets(p.AvailabilityZones, aws.StringValueSlice(g.AvailabilityZones)),
+		p.HealthCheckType != nil && aws.StringValue(p.HealthCheckType) != aws.StringValue(g.HealthCheckType),
+		p.HealthCheckGracePeriod != nil && aws.Int64Value(p.HealthCheckGracePeriod) != aws.Int64Value(g.HealthCheckGracePeriod),
+		p.DefaultCooldown != nil && aws.Int64Value(p.DefaultCooldown) != aws.Int64Value(g.DefaultCooldown),
+		p.DefaultInstanceWarmup != nil && aws.Int64Value(p.DefaultInstanceWarmup) != aws.Int64Value(g.DefaultInstanceWarmup),
+		p.MaxInstanceLifetime != nil && aws.Int64Value(p.MaxInstanceLifetime) != aws.Int64Value(g.MaxInstanceLifetime),
+		p.NewInstancesProtectedFromScaleIn != nil && aws.BoolValue(p.NewInstancesProtectedFromScaleIn) != aws.BoolValue(g.NewInstancesProtectedFromScaleIn),
+		p.CapacityRebalance != nil && aws.BoolValue(p.CapacityRebalance) != aws.BoolValue(g.CapacityRebalance),
+		p.PlacementGroup != nil && aws.StringValue(p.PlacementGroup) != aws.StringValue(g.PlacementGroup),
+		p.ServiceLinkedRoleARN != nil && aws.StringValue(p.ServiceLinkedRoleARN) != aws.StringValue(g.ServiceLinkedRoleARN),
+		len(p.TerminationPolicies) != 0 && !cmp.Equal(p.TerminationPolicies, aws.StringValueSlice(g.TerminationPolicies)):
+		return false
+	}
+	return true
+}
+
+// IsLaunchTemplateUpToDate checks whether the given launch template
+// specification of a group or an instance refresh matches the desired one.
+func IsLaunchTemplateUpToDate(p v1alpha1.LaunchTemplateSpecification, lt *autoscaling.LaunchTemplateSpecification) bool {
+	switch {
+	case lt == nil:
+		return false
+	case p.LaunchTemplateID != nil && aws.StringValue(p.LaunchTemplateID) != aws.StringValue(lt.LaunchTemplateId),
+		p.LaunchTemplateName != nil && aws.StringValue(p.LaunchTemplateName) != aws.StringValue(lt.LaunchTemplateName),
+		p.Version != nil && aws.StringValue(p.Version) != aws.StringValue(lt.Version):
+		return false
+	}
+	return true
+}
+
+// DiffTargetGroups returns the target group ARNs that should be attached to
+// or detached from the group.
+func DiffTargetGroups(spec []string, current []*string) (attach, detach []*string) {
+	want := make(map[string]bool, len(spec))
+	for _, arn := range spec {
+		want[arn] = true
+	}
+	have := make(map[string]bool, len(current))
+	for _, arn := range current {
+		have[aws.StringValue(arn)] = true
+		if !want[aws.StringValue(arn)] {
+			detach = append(detach, arn)
+		}
+	}
+	for _, arn := range spec {
+		if !have[arn] {
+			attach = append(attach, aws.String(arn))
+		}
+	}
+	return attach, detach
+}
+
+// DiffTags returns the tags of the group with the given name that should be
+// created or updated and the ones that should be deleted. Tags reserved by
+// AWS are ignored.
+func DiffTags(name string, spec []v1alpha1.Tag, current []*autoscaling.TagDescription) (add, remove []*autoscaling.Tag) {
+	have := make(map[string]*autoscaling.TagDescription, len(current))
+	for _, t := range current {
+		have[aws.StringValue(t.Key)] = t
+	}
+	want := make(map[string]bool, len(spec))
+	for _, t := range spec {
+		want[t.Key] = true
+		c, ok := have[t.Key]
+		if ok && aws.StringValue(c.Value) == t.Value && aws.BoolValue(c.PropagateAtLaunch) == aws.BoolValue(t.PropagateAtLaunch) {
+			continue
+		}
+		add = append(add, &autoscaling.Tag{
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String(TagResourceTypeAutoScalingGroup),
+			Key:               aws.String(t.Key),
+			Value:             aws.String(t.Value),
+			PropagateAtLaunch: t.PropagateAtLaunch,
+		})
+	}
+	for _, t := range current {
+		k := aws.StringValue(t.Key)
+		if want[k] || strings.HasPrefix(k, awsTagPrefix) {
+			continue
+		}
+		remove = append(remove, &autoscaling.Tag{
+			ResourceId:   aws.String(name),
+			ResourceType: aws.String(TagResourceTypeAutoScalingGroup),
+			Key:          t.Key,
+		})
+	}
+	return add, remove
+}
+
+// IsInstanceRefreshActive returns whether the given instance refresh is still
+// running. Only one instance refresh can be active at a time.
+func IsInstanceRefreshActive(r *autoscaling.InstanceRefresh) bool {
+	if r == nil {
+		return false
+	}
+	switch aws.StringValue(r.Status) {
+	case autoscaling.InstanceRefreshStatusPending,
+		autoscaling.InstanceRefreshStatusInProgress,
+		autoscaling.InstanceRefreshStatusCancelling:
+		return true
+	}
+	return false
+}
+
+// IsInstanceRefreshEnded returns whether the given instance refresh failed or
+// was cancelled.
+func IsInstanceRefreshEnded(r *autoscaling.InstanceRefresh) bool {
+	if r == nil {
+		return false
+	}
+	s := aws.StringValue(r.Status)
+	return s == autoscaling.InstanceRefreshStatusFailed || s == autoscaling.InstanceRefreshStatusCancelled
+}
+
+func generateVPCZoneIdentifier(subnets []string) *string {
+	if len(subnets) == 0 {
+		return nil
+	}
+	return aws.String(strings.Join(subnets, ","))
+}
+
+func splitVPCZoneIdentifier(id *string) []string {
+	if aws.StringValue(id) == "" {
+		return nil
+	}
+	return strings.Split(aws.StringValue(id), ",")
+}
+
+func equalStringSets(a, b []string) bool {
+	return cmp.Equal(a, b, cmpopts.SortSlices(func(x, y string) bool { return x < y }))
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+var (
+	groupName = "web"
+	tgARN1    = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/1"
+	tgARN2    = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/2"
+)
+
+func groupParams(m ...func(*v1alpha1.AutoScalingGroupParameters)) v1alpha1.AutoScalingGroupParameters {
+	p := v1alpha1.AutoScalingGroupParameters{
+		LaunchTemplate: v1alpha1.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String("web"),
+			Version:            aws.String("2"),
+		},
+		MinSize:   1,
+		MaxSize:   3,
+		SubnetIDs: []string{"subnet-a", "subnet-b"},
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func group(m ...func(*autoscaling.Group)) *autoscaling.Group {
+	g := &autoscaling.Group{
+		AutoScalingGroupName: aws.String(groupName),
+		LaunchTemplate: &autoscaling.LaunchTemplateSpecification{
+			LaunchTemplateId:   aws.String("lt-0123456789"),
+			LaunchTemplateName: aws.String("web"),
+			Version:            aws.String("2"),
+		},
+		MinSize:           aws.Int64(1),
+		MaxSize:           aws.Int64(3),
+		DesiredCapacity:   aws.Int64(2),
+		VPCZoneIdentifier: aws.String("subnet-b,subnet-a"),
+		HealthCheckType:   aws.String("EC2"),
+		DefaultCooldown:   aws.Int64(300),
+		TerminationPolicies: []*string{
+			aws.String("Default"),
+		},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func TestIsAutoScalingGroupUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.AutoScalingGroupParameters
+		g    *autoscaling.Group
+		want bool
+	}{
+		"UpToDate": {
+			spec: groupParams(),
+			g:    group(),
+			want: true,
+		},
+		"DesiredCapacityManagedByAWS": {
+			spec: groupParams(),
+			g:    group(func(g *autoscaling.Group) { g.DesiredCapacity = aws.Int64(3) }),
+			want: true,
+		},
+		"DifferentDesiredCapacity": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.DesiredCapacity = aws.Int64(1) }),
+			g:    group(),
+			want: false,
+		},
+		"DifferentMaxSize": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.MaxSize = 5 }),
+			g:    group(),
+			want: false,
+		},
+		"DifferentLaunchTemplateVersion": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.LaunchTemplate.Version = aws.String("3") }),
+			g:    group(),
+			want: false,
+		},
+		"DifferentSubnets": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.SubnetIDs = []string{"subnet-a"} }),
+			g:    group(),
+			want: false,
+		},
+		"DifferentTerminationPolicies": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.TerminationPolicies = []string{"OldestLaunchTemplate", "Default"}
+			}),
+			g:    group(),
+			want: false,
+		},
+		"DifferentHealthCheckType": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.HealthCheckType = aws.String("ELB") }),
+			g:    group(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsAutoScalingGroupUpToDate(tc.spec, tc.g)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeAutoScalingGroup(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.AutoScalingGroupParameters
+		g    *autoscaling.Group
+		want v1alpha1.AutoScalingGroupParameters
+	}{
+		"FillsEmptyFields": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) { p.LaunchTemplate.Version = nil }),
+			g:    group(),
+			want: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.HealthCheckType = aws.String("EC2")
+				p.DefaultCooldown = aws.Int64(300)
+				p.TerminationPolicies = []string{"Default"}
+			}),
+		},
+		"KeepsSetFields": {
+			spec: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.HealthCheckType = aws.String("ELB")
+				p.DefaultCooldown = aws.Int64(60)
+				p.TerminationPolicies = []string{"OldestInstance"}
+			}),
+			g: group(),
+			want: groupParams(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.HealthCheckType = aws.String("ELB")
+				p.DefaultCooldown = aws.Int64(60)
+				p.TerminationPolicies = []string{"OldestInstance"}
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeAutoScalingGroup(&tc.spec, tc.g)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTargetGroups(t *testing.T) {
+	type want struct {
+		attach []*string
+		detach []*string
+	}
+
+	cases := map[string]struct {
+		spec    []string
+		current []*string
+		want    want
+	}{
+		"NoChange": {
+			spec:    []string{tgARN1},
+			current: []*string{aws.String(tgARN1)},
+			want:    want{},
+		},
+		"Replace": {
+			spec:    []string{tgARN2},
+			current: []*string{aws.String(tgARN1)},
+			want: want{
+				attach: []*string{aws.String(tgARN2)},
+				detach: []*string{aws.String(tgARN1)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attach, detach := DiffTargetGroups(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.attach, attach); diff != "" {
+				t.Errorf("attach: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.detach, detach); diff != "" {
+				t.Errorf("detach: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []*autoscaling.Tag
+		remove []*autoscaling.Tag
+	}
+
+	cases := map[string]struct {
+		spec    []v1alpha1.Tag
+		current []*autoscaling.TagDescription
+		want    want
+	}{
+		"NoChange": {
+			spec: []v1alpha1.Tag{{Key: "team", Value: "web", PropagateAtLaunch: aws.Bool(true)}},
+			current: []*autoscaling.TagDescription{
+				{Key: aws.String("team"), Value: aws.String("web"), PropagateAtLaunch: aws.Bool(true)},
+			},
+			want: want{},
+		},
+		"AddUpdateAndRemove": {
+			spec: []v1alpha1.Tag{
+				{Key: "team", Value: "web", PropagateAtLaunch: aws.Bool(true)},
+				{Key: "env", Value: "prod"},
+			},
+			current: []*autoscaling.TagDescription{
+				{Key: aws.String("team"), Value: aws.String("web"), PropagateAtLaunch: aws.Bool(false)},
+				{Key: aws.String("old"), Value: aws.String("value")},
+				{Key: aws.String("aws:cloudformation:stack-name"), Value: aws.String("stack")},
+			},
+			want: want{
+				add: []*autoscaling.Tag{
+					{
+						ResourceId:        aws.String(groupName),
+						ResourceType:      aws.String(TagResourceTypeAutoScalingGroup),
+						Key:               aws.String("team"),
+						Value:             aws.String("web"),
+						PropagateAtLaunch: aws.Bool(true),
+					},
+					{
+						ResourceId:   aws.String(groupName),
+						ResourceType: aws.String(TagResourceTypeAutoScalingGroup),
+						Key:          aws.String("env"),
+						Value:        aws.String("prod"),
+					},
+				},
+				remove: []*autoscaling.Tag{
+					{
+						ResourceId:   aws.String(groupName),
+						ResourceType: aws.String(TagResourceTypeAutoScalingGroup),
+						Key:          aws.String("old"),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(groupName, tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(autoscaling.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(autoscaling.Tag{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	cases := map[string]struct {
+		err  error
+		want bool
+	}{
+		"GroupNotFound": {
+			err:  awserr.New(errCodeValidationError, "AutoScalingGroup name not found - AutoScalingGroup web not found", nil),
+			want: true,
+		},
+		"WarmPoolNotFound": {
+			err:  awserr.New(errCodeValidationError, "No warm pool found.", nil),
+			want: true,
+		},
+		"OtherValidationError": {
+			err:  awserr.New(errCodeValidationError, "Max bound, 1, must be greater than or equal to min bound, 2", nil),
+			want: false,
+		},
+		"OtherError": {
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsNotFound(tc.err)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+)
+
+// MockClient is a type that implements all the methods for the Amazon EC2
+// Auto Scaling Client interface
+type MockClient struct {
+	MockDescribeAutoScalingGroups      func(ctx aws.Context, input *autoscaling.DescribeAutoScalingGroupsInput, opts []request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error)
+	MockCreateAutoScalingGroup         func(ctx aws.Context, input *autoscaling.CreateAutoScalingGroupInput, opts []request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error)
+	MockUpdateAutoScalingGroup         func(ctx aws.Context, input *autoscaling.UpdateAutoScalingGroupInput, opts []request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error)
+	MockDeleteAutoScalingGroup         func(ctx aws.Context, input *autoscaling.DeleteAutoScalingGroupInput, opts []request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error)
+	MockAttachLoadBalancerTargetGroups func(ctx aws.Context, input *autoscaling.AttachLoadBalancerTargetGroupsInput, opts []request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error)
+	MockDetachLoadBalancerTargetGroups func(ctx aws.Context, input *autoscaling.DetachLoadBalancerTargetGroupsInput, opts []request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error)
+	MockCreateOrUpdateTags             func(ctx aws.Context, input *autoscaling.CreateOrUpdateTagsInput, opts []request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error)
+	MockDeleteTags                     func(ctx aws.Context, input *autoscaling.DeleteTagsInput, opts []request.Option) (*autoscaling.DeleteTagsOutput, error)
+	MockDescribeInstanceRefreshes      func(ctx aws.Context, input *autoscaling.DescribeInstanceRefreshesInput, opts []request.Option) (*autoscaling.DescribeInstanceRefreshesOutput, error)
+	MockStartInstanceRefresh           func(ctx aws.Context, input *autoscaling.StartInstanceRefreshInput, opts []request.Option) (*autoscaling.StartInstanceRefreshOutput, error)
+	MockDescribeLifecycleHooks         func(ctx aws.Context, input *autoscaling.DescribeLifecycleHooksInput, opts []request.Option) (*autoscaling.DescribeLifecycleHooksOutput, error)
+	MockPutLifecycleHook               func(ctx aws.Context, input *autoscaling.PutLifecycleHookInput, opts []request.Option) (*autoscaling.PutLifecycleHookOutput, error)
+	MockDeleteLifecycleHook            func(ctx aws.Context, input *autoscaling.DeleteLifecycleHookInput, opts []request.Option) (*autoscaling.DeleteLifecycleHookOutput, error)
+	MockDescribePolicies               func(ctx aws.Context, input *autoscaling.DescribePoliciesInput, opts []request.Option) (*autoscaling.DescribePoliciesOutput, error)
+	MockPutScalingPolicy               func(ctx aws.Context, input *autoscaling.PutScalingPolicyInput, opts []request.Option) (*autoscaling.PutScalingPolicyOutput, error)
+	MockDeletePolicy                   func(ctx aws.Context, input *autoscaling.DeletePolicyInput, opts []request.Option) (*autoscaling.DeletePolicyOutput, error)
+	MockDescribeWarmPool               func(ctx aws.Context, input *autoscaling.DescribeWarmPoolInput, opts []request.Option) (*autoscaling.DescribeWarmPoolOutput, error)
+	MockPutWarmPool                    func(ctx aws.Context, input *autoscaling.PutWarmPoolInput, opts []request.Option) (*autoscaling.PutWarmPoolOutput, error)
+	MockDeleteWarmPool                 func(ctx aws.Context, input *autoscaling.DeleteWarmPoolInput, opts []request.Option) (*autoscaling.DeleteWarmPoolOutput, error)
+}
+
+// DescribeAutoScalingGroupsWithContext mocks DescribeAutoScalingGroupsWithContext method
+func (m *MockClient) DescribeAutoScalingGroupsWithContext(ctx aws.Context, input *autoscaling.DescribeAutoScalingGroupsInput, opts ...request.Option) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	return m.MockDescribeAutoScalingGroups(ctx, input, opts)
+}
+
+// CreateAutoScalingGroupWithContext mocks CreateAutoScalingGroupWithContext method
+func (m *MockClient) CreateAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.CreateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.CreateAutoScalingGroupOutput, error) {
+	return m.MockCreateAutoScalingGroup(ctx, input, opts)
+}
+
+// UpdateAutoScalingGroupWithContext mocks UpdateAutoScalingGroupWithContext method
+func (m *MockClient) UpdateAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.UpdateAutoScalingGroupInput, opts ...request.Option) (*autoscaling.UpdateAutoScalingGroupOutput, error) {
+	return m.MockUpdateAutoScalingGroup(ctx, input, opts)
+}
+
+// DeleteAutoScalingGroupWithContext mocks DeleteAutoScalingGroupWithContext method
+func (m *MockClient) DeleteAutoScalingGroupWithContext(ctx aws.Context, input *autoscaling.DeleteAutoScalingGroupInput, opts ...request.Option) (*autoscaling.DeleteAutoScalingGroupOutput, error) {
+	return m.MockDeleteAutoScalingGroup(ctx, input, opts)
+}
+
+// AttachLoadBalancerTargetGroupsWithContext mocks AttachLoadBalancerTargetGroupsWithContext method
+func (m *MockClient) AttachLoadBalancerTargetGroupsWithContext(ctx aws.Context, input *autoscaling.AttachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.AttachLoadBalancerTargetGroupsOutput, error) {
+	return m.MockAttachLoadBalancerTargetGroups(ctx, input, opts)
+}
+
+// DetachLoadBalancerTargetGroupsWithContext mocks DetachLoadBalancerTargetGroupsWithContext method
+func (m *MockClient) DetachLoadBalancerTargetGroupsWithContext(ctx aws.Context, input *autoscaling.DetachLoadBalancerTargetGroupsInput, opts ...request.Option) (*autoscaling.DetachLoadBalancerTargetGroupsOutput, error) {
+	return m.MockDetachLoadBalancerTargetGroups(ctx, input, opts)
+}
+
+// CreateOrUpdateTagsWithContext mocks CreateOrUpdateTagsWithContext method
+func (m *MockClient) CreateOrUpdateTagsWithContext(ctx aws.Context, input *autoscaling.CreateOrUpdateTagsInput, opts ...request.Option) (*autoscaling.CreateOrUpdateTagsOutput, error) {
+	return m.MockCreateOrUpdateTags(ctx, input, opts)
+}
+
+// DeleteTagsWithContext mocks DeleteTagsWithContext method
+func (m *MockClient) DeleteTagsWithContext(ctx aws.Context, input *autoscaling.DeleteTagsInput, opts ...request.Option) (*autoscaling.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
+
+// DescribeInstanceRefreshesWithContext mocks DescribeInstanceRefreshesWithContext method
+func (m *MockClient) DescribeInstanceRefreshesWithContext(ctx aws.Context, input *autoscaling.DescribeInstanceRefreshesInput, opts ...request.Option) (*autoscaling.DescribeInstanceRefreshesOutput, error) {
+	return m.MockDescribeInstanceRefreshes(ctx, input, opts)
+}
+
+// StartInstanceRefreshWithContext mocks StartInstanceRefreshWithContext method
+func (m *MockClient) StartInstanceRefreshWithContext(ctx aws.Context, input *autoscaling.StartInstanceRefreshInput, opts ...request.Option) (*autoscaling.StartInstanceRefreshOutput, error) {
+	return m.MockStartInstanceRefresh(ctx, input, opts)
+}
+
+// DescribeLifecycleHooksWithContext mocks DescribeLifecycleHooksWithContext method
+func (m *MockClient) DescribeLifecycleHooksWithContext(ctx aws.Context, input *autoscaling.DescribeLifecycleHooksInput, opts ...request.Option) (*autoscaling.DescribeLifecycleHooksOutput, error) {
+	return m.MockDescribeLifecycleHooks(ctx, input, opts)
+}
+
+// PutLifecycleHookWithContext mocks PutLifecycleHookWithContext method
+func (m *MockClient) PutLifecycleHookWithContext(ctx aws.Context, input *autoscaling.PutLifecycleHookInput, opts ...request.Option) (*autoscaling.PutLifecycleHookOutput, error) {
+	return m.MockPutLifecycleHook(ctx, input, opts)
+}
+
+// DeleteLifecycleHookWithContext mocks DeleteLifecycleHookWithContext method
+func (m *MockClient) DeleteLifecycleHookWithContext(ctx aws.Context, input *autoscaling.DeleteLifecycleHookInput, opts ...request.Option) (*autoscaling.DeleteLifecycleHookOutput, error) {
+	return m.MockDeleteLifecycleHook(ctx, input, opts)
+}
+
+// DescribePoliciesWithContext mocks DescribePoliciesWithContext method
+func (m *MockClient) DescribePoliciesWithContext(ctx aws.Context, input *autoscaling.DescribePoliciesInput, opts ...request.Option) (*autoscaling.DescribePoliciesOutput, error) {
+	return m.MockDescribePolicies(ctx, input, opts)
+}
+
+// PutScalingPolicyWithContext mocks PutScalingPolicyWithContext method
+func (m *MockClient) PutScalingPolicyWithContext(ctx aws.Context, input *autoscaling.PutScalingPolicyInput, opts ...request.Option) (*autoscaling.PutScalingPolicyOutput, error) {
+	return m.MockPutScalingPolicy(ctx, input, opts)
+}
+
+// DeletePolicyWithContext mocks DeletePolicyWithContext method
+func (m *MockClient) DeletePolicyWithContext(ctx aws.Context, input *autoscaling.DeletePolicyInput, opts ...request.Option) (*autoscaling.DeletePolicyOutput, error) {
+	return m.MockDeletePolicy(ctx, input, opts)
+}
+
+// DescribeWarmPoolWithContext mocks DescribeWarmPoolWithContext method
+func (m *MockClient) DescribeWarmPoolWithContext(ctx aws.Context, input *autoscaling.DescribeWarmPoolInput, opts ...request.Option) (*autoscaling.DescribeWarmPoolOutput, error) {
+	return m.MockDescribeWarmPool(ctx, input, opts)
+}
+
+// PutWarmPoolWithContext mocks PutWarmPoolWithContext method
+func (m *MockClient) PutWarmPoolWithContext(ctx aws.Context, input *autoscaling.PutWarmPoolInput, opts ...request.Option) (*autoscaling.PutWarmPoolOutput, error) {
+	return m.MockPutWarmPool(ctx, input, opts)
+}
+
+// DeleteWarmPoolWithContext mocks DeleteWarmPoolWithContext method
+func (m *MockClient) DeleteWarmPoolWithContext(ctx aws.Context, input *autoscaling.DeleteWarmPoolInput, opts ...request.Option) (*autoscaling.DeleteWarmPoolOutput, error) {
+	return m.MockDeleteWarmPool(ctx, input, opts)
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// GeneratePutLifecycleHookInput returns the input to create or update the
+// lifecycle hook with the given name.
+func GeneratePutLifecycleHookInput(name string, p *v1alpha1.LifecycleHookParameters) *autoscaling.PutLifecycleHookInput {
+	return &autoscaling.PutLifecycleHookInput{
+		LifecycleHookName:     aws.String(name),
+		AutoScalingGroupName:  p.AutoScalingGroupName,
+		LifecycleTransition:   aws.String(p.LifecycleTransition),
+		DefaultResult:         p.DefaultResult,
+		HeartbeatTimeout:      p.HeartbeatTimeout,
+		NotificationMetadata:  p.NotificationMetadata,
+		NotificationTargetARN: p.NotificationTargetARN,
+		RoleARN:               p.RoleARN,
+	}
+}
+
+// LateInitializeLifecycleHook fills the empty fields in
+// *v1alpha1.LifecycleHookParameters with the values seen in
+// autoscaling.LifecycleHook.
+func LateInitializeLifecycleHook(in *v1alpha1.LifecycleHookParameters, h *autoscaling.LifecycleHook) {
+	in.DefaultResult = awsclients.LateInitializeStringPtr(in.DefaultResult, h.DefaultResult)
+	in.HeartbeatTimeout = awsclients.LateInitializeInt64Ptr(in.HeartbeatTimeout, h.HeartbeatTimeout)
+}
+
+// IsLifecycleHookUpToDate checks whether the lifecycle hook in AWS matches the
+// desired state.
+func IsLifecycleHookUpToDate(p v1alpha1.LifecycleHookParameters, h *autoscaling.LifecycleHook) bool {
+	switch {
+	case p.LifecycleTransition != aws.StringValue(h.LifecycleTransition),
+		p.DefaultResult != nil && aws.StringValue(p.DefaultResult) != aws.StringValue(h.DefaultResult),
+		p.HeartbeatTimeout != nil && aws.Int64Value(p.HeartbeatTimeout) != aws.Int64Value(h.HeartbeatTimeout),
+		aws.StringValue(p.NotificationMetadata) != aws.StringValue(h.NotificationMetadata),
+		aws.StringValue(p.NotificationTargetARN) != aws.StringValue(h.NotificationTargetARN),
+		aws.StringValue(p.RoleARN) != aws.StringValue(h.RoleARN):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+var (
+	hookName  = "drain"
+	topicARN  = "arn:aws:sns:us-east-1:123456789012:drain"
+	hookRole  = "arn:aws:iam::123456789012:role/drain"
+	launching = "autoscaling:EC2_INSTANCE_LAUNCHING"
+)
+
+func hookParams(m ...func(*v1alpha1.LifecycleHookParameters)) v1alpha1.LifecycleHookParameters {
+	p := v1alpha1.LifecycleHookParameters{
+		AutoScalingGroupName:  aws.String(groupName),
+		LifecycleTransition:   launching,
+		NotificationTargetARN: aws.String(topicARN),
+		RoleARN:               aws.String(hookRole),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func hook(m ...func(*autoscaling.LifecycleHook)) *autoscaling.LifecycleHook {
+	h := &autoscaling.LifecycleHook{
+		AutoScalingGroupName:  aws.String(groupName),
+		LifecycleHookName:     aws.String(hookName),
+		LifecycleTransition:   aws.String(launching),
+		DefaultResult:         aws.String("ABANDON"),
+		HeartbeatTimeout:      aws.Int64(3600),
+		GlobalTimeout:         aws.Int64(172800),
+		NotificationTargetARN: aws.String(topicARN),
+		RoleARN:               aws.String(hookRole),
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+func TestGeneratePutLifecycleHookInput(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.LifecycleHookParameters
+		want *autoscaling.PutLifecycleHookInput
+	}{
+		"AllFields": {
+			spec: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.DefaultResult = aws.String("CONTINUE")
+				p.HeartbeatTimeout = aws.Int64(300)
+				p.NotificationMetadata = aws.String("metadata")
+			}),
+			want: &autoscaling.PutLifecycleHookInput{
+				LifecycleHookName:     aws.String(hookName),
+				AutoScalingGroupName:  aws.String(groupName),
+				LifecycleTransition:   aws.String(launching),
+				DefaultResult:         aws.String("CONTINUE"),
+				HeartbeatTimeout:      aws.Int64(300),
+				NotificationMetadata:  aws.String("metadata"),
+				NotificationTargetARN: aws.String(topicARN),
+				RoleARN:               aws.String(hookRole),
+			},
+		},
+		"RequiredFields": {
+			spec: v1alpha1.LifecycleHookParameters{
+				AutoScalingGroupName: aws.String(groupName),
+				LifecycleTransition:  launching,
+			},
+			want: &autoscaling.PutLifecycleHookInput{
+				LifecycleHookName:    aws.String(hookName),
+				AutoScalingGroupName: aws.String(groupName),
+				LifecycleTransition:  aws.String(launching),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutLifecycleHookInput(hookName, &tc.spec)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(autoscaling.PutLifecycleHookInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeLifecycleHook(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.LifecycleHookParameters
+		h    *autoscaling.LifecycleHook
+		want v1alpha1.LifecycleHookParameters
+	}{
+		"FillsEmptyFields": {
+			spec: hookParams(),
+			h:    hook(),
+			want: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.DefaultResult = aws.String("ABANDON")
+				p.HeartbeatTimeout = aws.Int64(3600)
+			}),
+		},
+		"KeepsSetFields": {
+			spec: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.DefaultResult = aws.String("CONTINUE")
+				p.HeartbeatTimeout = aws.Int64(300)
+			}),
+			h: hook(),
+			want: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.DefaultResult = aws.String("CONTINUE")
+				p.HeartbeatTimeout = aws.Int64(300)
+			}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeLifecycleHook(&tc.spec, tc.h)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsLifecycleHookUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.LifecycleHookParameters
+		h    *autoscaling.LifecycleHook
+		want bool
+	}{
+		"UpToDate": {
+			spec: hookParams(),
+			h:    hook(),
+			want: true,
+		},
+		"DifferentTransition": {
+			spec: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.LifecycleTransition = "autoscaling:EC2_INSTANCE_TERMINATING"
+			}),
+			h:    hook(),
+			want: false,
+		},
+		"DifferentHeartbeatTimeout": {
+			spec: hookParams(func(p *v1alpha1.LifecycleHookParameters) { p.HeartbeatTimeout = aws.Int64(300) }),
+			h:    hook(),
+			want: false,
+		},
+		"NotificationTargetRemoved": {
+			spec: hookParams(func(p *v1alpha1.LifecycleHookParameters) {
+				p.NotificationTargetARN = nil
+				p.RoleARN = nil
+			}),
+			h:    hook(),
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsLifecycleHookUpToDate(tc.spec, tc.h)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// GeneratePutScalingPolicyInput returns the input to create or update the
+// scaling policy with the given name.
+func GeneratePutScalingPolicyInput(name string, p *v1alpha1.ScalingPolicyParameters) *autoscaling.PutScalingPolicyInput {
+	return &autoscaling.PutScalingPolicyInput{
+		PolicyName:                  aws.String(name),
+		AutoScalingGroupName:        p.AutoScalingGroupName,
+		PolicyType:                  p.PolicyType,
+		AdjustmentType:              p.AdjustmentType,
+		ScalingAdjustment:           p.ScalingAdjustment,
+		Cooldown:                    p.Cooldown,
+		MinAdjustmentMagnitude:      p.MinAdjustmentMagnitude,
+		MetricAggregationType:       p.MetricAggregationType,
+		StepAdjustments:             generateStepAdjustments(p.StepAdjustments),
+		EstimatedInstanceWarmup:     p.EstimatedInstanceWarmup,
+		TargetTrackingConfiguration: generateTargetTrackingConfiguration(p.TargetTrackingConfiguration),
+		Enabled:                     p.Enabled,
+	}
+}
+
+// LateInitializeScalingPolicy fills the empty fields in
+// *v1alpha1.ScalingPolicyParameters with the values seen in
+// autoscaling.ScalingPolicy.
+func LateInitializeScalingPolicy(in *v1alpha1.ScalingPolicyParameters, s *autoscaling.ScalingPolicy) {
+	in.PolicyType = awsclients.LateInitializeStringPtr(in.PolicyType, s.PolicyType)
+	in.Enabled = awsclients.LateInitializeBoolPtr(in.Enabled, s.Enabled)
+	if aws.StringValue(in.PolicyType) == v1alpha1.PolicyTypeStepScaling {
+		in.MetricAggregationType = awsclients.LateInitializeStringPtr(in.MetricAggregationType, s.MetricAggregationType)
+	}
+}
+
+// GenerateScalingPolicyObservation is used to produce
+// ScalingPolicyObservation from autoscaling.ScalingPolicy.
+func GenerateScalingPolicyObservation(s *autoscaling.ScalingPolicy) v1alpha1.ScalingPolicyObservation {
+	o := v1alpha1.ScalingPolicyObservation{
+		PolicyARN: aws.StringValue(s.PolicyARN),
+	}
+	for _, a := range s.Alarms {
+		o.Alarms = append(o.Alarms, v1alpha1.Alarm{
+			AlarmName: aws.StringValue(a.AlarmName),
+			AlarmARN:  aws.StringValue(a.AlarmARN),
+		})
+	}
+	return o
+}
+
+// IsScalingPolicyUpToDate checks whether the scaling policy in AWS matches the
+// desired state.
+func IsScalingPolicyUpToDate(p v1alpha1.ScalingPolicyParameters, s *autoscaling.ScalingPolicy) bool {
+	switch {
+	case p.PolicyType != nil && aws.StringValue(p.PolicyType) != aws.StringValue(s.PolicyType),
+		p.AdjustmentType != nil && aws.StringValue(p.AdjustmentType) != aws.StringValue(s.AdjustmentType),
+		p.ScalingAdjustment != nil && aws.Int64Value(p.ScalingAdjustment) != aws.Int64Value(s.ScalingAdjustment),
+		p.Cooldown != nil && aws.Int64Value(p.Cooldown) != aws.Int64Value(s.Cooldown),
+		p.MinAdjustmentMagnitude != nil && aws.Int64Value(p.MinAdjustmentMagnitude) != aws.Int64Value(s.MinAdjustmentMagnitude),
+		p.MetricAggregationType != nil && aws.StringValue(p.MetricAggregationType) != aws.StringValue(s.MetricAggregationType),
+		p.EstimatedInstanceWarmup != nil && aws.Int64Value(p.EstimatedInstanceWarmup) != aws.Int64Value(s.EstimatedInstanceWarmup),
+		p.Enabled != nil && aws.BoolValue(p.Enabled) != aws.BoolValue(s.Enabled):
+		return false
+	}
+	return cmp.Equal(generateStepAdjustments(p.StepAdjustments), s.StepAdjustments, cmpopts.EquateEmpty(), ignoreUnexported) &&
+		isTargetTrackingConfigurationUpToDate(p.TargetTrackingConfiguration, s.TargetTrackingConfiguration)
+}
+
+// ignoreUnexported ignores the unexported fields of the SDK types.
+var ignoreUnexported = cmpopts.IgnoreUnexported(
+	autoscaling.StepAdjustment{},
+	autoscaling.TargetTrackingConfiguration{},
+	autoscaling.PredefinedMetricSpecification{},
+	autoscaling.CustomizedMetricSpecification{},
+	autoscaling.MetricDimension{},
+)
+
+func isTargetTrackingConfigurationUpToDate(p *v1alpha1.TargetTrackingConfiguration, c *autoscaling.TargetTrackingConfiguration) bool {
+	if p == nil || c == nil {
+		return p == nil && c == nil
+	}
+	got := *c
+	// DisableScaleIn is returned as false if it was not set.
+	if p.DisableScaleIn == nil {
+		got.DisableScaleIn = nil
+	}
+	return cmp.Equal(generateTargetTrackingConfiguration(p), &got, cmpopts.EquateEmpty(), ignoreUnexported)
+}
+
+func generateStepAdjustments(in []v1alpha1.StepAdjustment) []*autoscaling.StepAdjustment {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]*autoscaling.StepAdjustment, len(in))
+	for i, s := range in {
+		out[i] = &autoscaling.StepAdjustment{
+			MetricIntervalLowerBound: s.MetricIntervalLowerBound,
+			MetricIntervalUpperBound: s.MetricIntervalUpperBound,
+			ScalingAdjustment:        aws.Int64(s.ScalingAdjustment),
+		}
+	}
+	return out
+}
+
+func generateTargetTrackingConfiguration(in *v1alpha1.TargetTrackingConfiguration) *autoscaling.TargetTrackingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := &autoscaling.TargetTrackingConfiguration{
+		TargetValue:    aws.Float64(in.TargetValue),
+		DisableScaleIn: in.DisableScaleIn,
+	}
+	if m := in.PredefinedMetricSpecification; m != nil {
+		out.PredefinedMetricSpecification = &autoscaling.PredefinedMetricSpecification{
+			PredefinedMetricType: aws.String(m.PredefinedMetricType),
+			ResourceLabel:        m.ResourceLabel,
+		}
+	}
+	if m := in.CustomizedMetricSpecification; m != nil {
+		out.CustomizedMetricSpecification = &autoscaling.CustomizedMetricSpecification{
+			MetricName: aws.String(m.MetricName),
+			Namespace:  aws.String(m.Namespace),
+			Statistic:  aws.String(m.Statistic),
+			Unit:       m.Unit,
+		}
+		for _, d := range m.Dimensions {
+			out.CustomizedMetricSpecification.Dimensions = append(out.CustomizedMetricSpecification.Dimensions, &autoscaling.MetricDimension{
+				Name:  aws.String(d.Name),
+				Value: aws.String(d.Value),
+			})
+		}
+	}
+	return out
+}
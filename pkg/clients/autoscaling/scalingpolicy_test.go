@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+func TestIsScalingPolicyUpToDate(t *testing.T) {
+	targetTracking := func(v float64) *v1alpha1.TargetTrackingConfiguration {
+		return &v1alpha1.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &v1alpha1.PredefinedMetricSpecification{
+				PredefinedMetricType: "ASGAverageCPUUtilization",
+			},
+			TargetValue: v,
+		}
+	}
+	observed := &autoscaling.ScalingPolicy{
+		PolicyType: aws.String(v1alpha1.PolicyTypeTargetTrackingScaling),
+		Enabled:    aws.Bool(true),
+		TargetTrackingConfiguration: &autoscaling.TargetTrackingConfiguration{
+			PredefinedMetricSpecification: &autoscaling.PredefinedMetricSpecification{
+				PredefinedMetricType: aws.String("ASGAverageCPUUtilization"),
+			},
+			TargetValue:    aws.Float64(50),
+			DisableScaleIn: aws.Bool(false),
+		},
+	}
+
+	cases := map[string]struct {
+		spec v1alpha1.ScalingPolicyParameters
+		s    *autoscaling.ScalingPolicy
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1alpha1.ScalingPolicyParameters{
+				PolicyType:                  aws.String(v1alpha1.PolicyTypeTargetTrackingScaling),
+				TargetTrackingConfiguration: targetTracking(50),
+			},
+			s:    observed,
+			want: true,
+		},
+		"DifferentTargetValue": {
+			spec: v1alpha1.ScalingPolicyParameters{
+				PolicyType:                  aws.String(v1alpha1.PolicyTypeTargetTrackingScaling),
+				TargetTrackingConfiguration: targetTracking(70),
+			},
+			s:    observed,
+			want: false,
+		},
+		"Disabled": {
+			spec: v1alpha1.ScalingPolicyParameters{
+				PolicyType:                  aws.String(v1alpha1.PolicyTypeTargetTrackingScaling),
+				TargetTrackingConfiguration: targetTracking(50),
+				Enabled:                     aws.Bool(false),
+			},
+			s:    observed,
+			want: false,
+		},
+		"DifferentStepAdjustments": {
+			spec: v1alpha1.ScalingPolicyParameters{
+				PolicyType:     aws.String(v1alpha1.PolicyTypeStepScaling),
+				AdjustmentType: aws.String("ChangeInCapacity"),
+				StepAdjustments: []v1alpha1.StepAdjustment{
+					{MetricIntervalLowerBound: aws.Float64(0), ScalingAdjustment: 2},
+				},
+			},
+			s: &autoscaling.ScalingPolicy{
+				PolicyType:     aws.String(v1alpha1.PolicyTypeStepScaling),
+				AdjustmentType: aws.String("ChangeInCapacity"),
+				StepAdjustments: []*autoscaling.StepAdjustment{
+					{MetricIntervalLowerBound: aws.Float64(0), ScalingAdjustment: aws.Int64(1)},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsScalingPolicyUpToDate(tc.spec, tc.s)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
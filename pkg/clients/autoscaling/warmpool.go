@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// GeneratePutWarmPoolInput returns the input to create or update the warm
+// pool of the group with the given name.
+func GeneratePutWarmPoolInput(name string, p *v1alpha1.WarmPoolParameters) *autoscaling.PutWarmPoolInput {
+	in := &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName:     aws.String(name),
+		MaxGroupPreparedCapacity: p.MaxGroupPreparedCapacity,
+		MinSize:                  p.MinSize,
+		PoolState:                p.PoolState,
+	}
+	if p.InstanceReusePolicy != nil {
+		in.InstanceReusePolicy = &autoscaling.InstanceReusePolicy{
+			ReuseOnScaleIn: p.InstanceReusePolicy.ReuseOnScaleIn,
+		}
+	}
+	return in
+}
+
+// LateInitializeWarmPool fills the empty fields in
+// *v1alpha1.WarmPoolParameters with the values seen in
+// autoscaling.WarmPoolConfiguration.
+func LateInitializeWarmPool(in *v1alpha1.WarmPoolParameters, c *autoscaling.WarmPoolConfiguration) {
+	in.MinSize = awsclients.LateInitializeInt64Ptr(in.MinSize, c.MinSize)
+	in.PoolState = awsclients.LateInitializeStringPtr(in.PoolState, c.PoolState)
+}
+
+// IsWarmPoolUpToDate checks whether the warm pool in AWS matches the desired
+// state.
+func IsWarmPoolUpToDate(p v1alpha1.WarmPoolParameters, c *autoscaling.WarmPoolConfiguration) bool {
+	switch {
+	case p.MaxGroupPreparedCapacity != nil && aws.Int64Value(p.MaxGroupPreparedCapacity) != aws.Int64Value(c.MaxGroupPreparedCapacity),
+		p.MinSize != nil && aws.Int64Value(p.MinSize) != aws.Int64Value(c.MinSize),
+		p.PoolState != nil && aws.StringValue(p.PoolState) != aws.StringValue(c.PoolState):
+		return false
+	}
+	if p.InstanceReusePolicy == nil {
+		return true
+	}
+	var reuse *bool
+	if c.InstanceReusePolicy != nil {
+		reuse = c.InstanceReusePolicy.ReuseOnScaleIn
+	}
+	return aws.BoolValue(p.InstanceReusePolicy.ReuseOnScaleIn) == aws.BoolValue(reuse)
+}
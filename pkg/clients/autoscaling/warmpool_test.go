@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscaling
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+)
+
+func warmPool(m ...func(*autoscaling.WarmPoolConfiguration)) *autoscaling.WarmPoolConfiguration {
+	c := &autoscaling.WarmPoolConfiguration{
+		MaxGroupPreparedCapacity: aws.Int64(4),
+		MinSize:                  aws.Int64(0),
+		PoolState:                aws.String("Stopped"),
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func TestGeneratePutWarmPoolInput(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.WarmPoolParameters
+		want *autoscaling.PutWarmPoolInput
+	}{
+		"AllFields": {
+			spec: v1alpha1.WarmPoolParameters{
+				MaxGroupPreparedCapacity: aws.Int64(4),
+				MinSize:                  aws.Int64(1),
+				PoolState:                aws.String("Hibernated"),
+				InstanceReusePolicy:      &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+			},
+			want: &autoscaling.PutWarmPoolInput{
+				AutoScalingGroupName:     aws.String(groupName),
+				MaxGroupPreparedCapacity: aws.Int64(4),
+				MinSize:                  aws.Int64(1),
+				PoolState:                aws.String("Hibernated"),
+				InstanceReusePolicy:      &autoscaling.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+			},
+		},
+		"NoFields": {
+			spec: v1alpha1.WarmPoolParameters{},
+			want: &autoscaling.PutWarmPoolInput{
+				AutoScalingGroupName: aws.String(groupName),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GeneratePutWarmPoolInput(groupName, &tc.spec)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(autoscaling.PutWarmPoolInput{}, autoscaling.InstanceReusePolicy{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitializeWarmPool(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.WarmPoolParameters
+		c    *autoscaling.WarmPoolConfiguration
+		want v1alpha1.WarmPoolParameters
+	}{
+		"FillsEmptyFields": {
+			spec: v1alpha1.WarmPoolParameters{},
+			c:    warmPool(),
+			want: v1alpha1.WarmPoolParameters{
+				MinSize:   aws.Int64(0),
+				PoolState: aws.String("Stopped"),
+			},
+		},
+		"KeepsSetFields": {
+			spec: v1alpha1.WarmPoolParameters{
+				MinSize:   aws.Int64(2),
+				PoolState: aws.String("Running"),
+			},
+			c: warmPool(),
+			want: v1alpha1.WarmPoolParameters{
+				MinSize:   aws.Int64(2),
+				PoolState: aws.String("Running"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitializeWarmPool(&tc.spec, tc.c)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsWarmPoolUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.WarmPoolParameters
+		c    *autoscaling.WarmPoolConfiguration
+		want bool
+	}{
+		"UpToDate": {
+			spec: v1alpha1.WarmPoolParameters{
+				MaxGroupPreparedCapacity: aws.Int64(4),
+				PoolState:                aws.String("Stopped"),
+			},
+			c:    warmPool(),
+			want: true,
+		},
+		"DifferentPoolState": {
+			spec: v1alpha1.WarmPoolParameters{PoolState: aws.String("Running")},
+			c:    warmPool(),
+			want: false,
+		},
+		"DifferentMaxGroupPreparedCapacity": {
+			spec: v1alpha1.WarmPoolParameters{MaxGroupPreparedCapacity: aws.Int64(2)},
+			c:    warmPool(),
+			want: false,
+		},
+		"ReuseOnScaleInNotObserved": {
+			spec: v1alpha1.WarmPoolParameters{
+				InstanceReusePolicy: &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+			},
+			c:    warmPool(),
+			want: false,
+		},
+		"ReuseOnScaleInDisabled": {
+			spec: v1alpha1.WarmPoolParameters{
+				InstanceReusePolicy: &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(false)},
+			},
+			c:    warmPool(),
+			want: true,
+		},
+		"SameReuseOnScaleIn": {
+			spec: v1alpha1.WarmPoolParameters{
+				InstanceReusePolicy: &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+			},
+			c: warmPool(func(c *autoscaling.WarmPoolConfiguration) {
+				c.InstanceReusePolicy = &autoscaling.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)}
+			}),
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsWarmPoolUpToDate(tc.spec, tc.c)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
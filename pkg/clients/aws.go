@@ -24,6 +24,8 @@ import (
 	"log"
 	"net"
 	"net/url"
+	"os/exec"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -32,6 +34,7 @@ import (
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	stscredstypesv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
 
@@ -123,55 +126,155 @@ func GetConfig(ctx context.Context, c client.Client, mg resource.Managed, region
 }
 
 // UseProviderConfig to produce a config that can be used to authenticate to AWS.
-func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) { // nolint:gocyclo
+func UseProviderConfig(ctx context.Context, c client.Client, mg resource.Managed, region string) (*aws.Config, error) {
+	pc, data, err := getProviderConfig(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+	key, cacheable := newConfigCacheKey(pc, data, region)
+	return cachedConfig(key, cacheable, func() (*aws.Config, error) {
+		return newProviderConfigConfig(ctx, data, region, pc)
+	})
+}
+
+// GetAssumeRoleConfig constructs an *aws.Config like GetConfig that assumes
+// the supplied IAM role with the credentials of the config returned by
+// GetConfig. The role session options of the ProviderConfig, e.g. its role
+// session name and duration, apply to the session of the supplied role.
+// Configs built from a ProviderConfig are cached per role.
+func GetAssumeRoleConfig(ctx context.Context, c client.Client, mg resource.Managed, region, roleARN string) (*aws.Config, error) {
+	if mg.GetProviderConfigReference() == nil {
+		cfg, err := GetConfig(ctx, c, mg, region)
+		if err != nil {
+			return nil, err
+		}
+		return assumeRole(cfg, roleARN, func(*stscreds.AssumeRoleOptions) {}), nil
+	}
+	pc, data, err := getProviderConfig(ctx, c, mg)
+	if err != nil {
+		return nil, err
+	}
+	key, cacheable := newConfigCacheKey(pc, data, region)
+	roleKey := key
+	roleKey.role = roleARN
+	return cachedConfig(roleKey, cacheable, func() (*aws.Config, error) {
+		cfg, err := cachedConfig(key, cacheable, func() (*aws.Config, error) {
+			return newProviderConfigConfig(ctx, data, region, pc)
+		})
+		if err != nil {
+			return nil, err
+		}
+		return assumeRole(cfg, roleARN, roleSessionOptions(pc)), nil
+	})
+}
+
+// getProviderConfig returns the ProviderConfig referenced by the supplied
+// managed resource, with its session templates rendered, and the data of
+// its credentials.
+func getProviderConfig(ctx context.Context, c client.Client, mg resource.Managed) (*v1beta1.ProviderConfig, []byte, error) {
 	pc := &v1beta1.ProviderConfig{}
 	if err := c.Get(ctx, types.NamespacedName{Name: mg.GetProviderConfigReference().Name}, pc); err != nil {
-		return nil, errors.Wrap(err, "cannot get referenced Provider")
+		return nil, nil, errors.Wrap(err, "cannot get referenced Provider")
 	}
 
 	t := resource.NewProviderConfigUsageTracker(c, &v1beta1.ProviderConfigUsage{})
 	if err := t.Track(ctx, mg); err != nil {
-		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
+		return nil, nil, errors.Wrap(err, "cannot track ProviderConfig usage")
+	}
+
+	pc, err := renderSessionTemplates(pc, mg)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	data, err := extractCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pc, data, nil
+}
+
+// cachedConfig returns the config cached for the supplied key, or builds and
+// caches it if there is none. Configs that are not cacheable are built every
+// time.
+func cachedConfig(key configCacheKey, cacheable bool, build func() (*aws.Config, error)) (*aws.Config, error) {
+	if cacheable {
+		if cfg, ok := configs.GetV2(key); ok {
+			return cfg, nil
+		}
+	}
+	cfg, err := build()
+	if err != nil {
+		return nil, err
+	}
+	if cacheable {
+		configs.SetV2(key, cfg)
+	}
+	return cfg, nil
+}
+
+// extractCredentials extracts the credentials data of the supplied
+// ProviderConfig from its source. Sources that do not read any credentials
+// from the API server, the environment or the filesystem have no data.
+func extractCredentials(ctx context.Context, c client.Client, pc *v1beta1.ProviderConfig) ([]byte, error) {
+	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
+	case xpv1.CredentialsSourceInjectedIdentity, v1beta1.CredentialsSourceProcess:
+		return nil, nil
+	case v1beta1.CredentialsSourceRolesAnywhere:
+		return extractRolesAnywhereCertificate(ctx, c, pc.Spec.Credentials.RolesAnywhere)
+	default:
+		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		return data, errors.Wrap(err, "cannot get credentials")
+	}
+}
+
+// newProviderConfigConfig builds a config that can be used to authenticate to
+// AWS from the supplied ProviderConfig and the credentials data extracted from
+// its source.
+func newProviderConfigConfig(ctx context.Context, data []byte, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	cfg, err := useCredentialsSource(ctx, data, region, pc)
+	if err != nil {
+		return nil, err
+	}
+	if len(pc.Spec.AssumeRoleChain) > 0 {
+		cfg, err = UseAssumeRoleChain(cfg, pc.Spec.AssumeRoleChain)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot assume IAM Role chain")
+		}
+	}
+	return SetRetryPolicy(pc, SetResolver(pc, cfg)), nil
+}
+
+// useCredentialsSource builds a config that authenticates with the
+// credentials source of the supplied ProviderConfig, assuming the role it
+// configures if any.
+func useCredentialsSource(ctx context.Context, data []byte, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
+			return UsePodServiceAccountAssumeRole(ctx, []byte{}, DefaultSection, region, pc)
 		}
 		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
-			cfg, err := UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
+			return UsePodServiceAccountAssumeRoleWithWebIdentity(ctx, []byte{}, DefaultSection, region, pc)
 		}
-		cfg, err := UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+		return UsePodServiceAccount(ctx, []byte{}, DefaultSection, region)
+	case v1beta1.CredentialsSourceProcess:
+		cfg, err := UseProcessCredentials(ctx, region, pc)
 		if err != nil {
 			return nil, err
 		}
-		return SetResolver(pc, cfg), nil
-	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
+		return useAssumeRole(cfg, pc)
+	case v1beta1.CredentialsSourceRolesAnywhere:
+		cfg, err := UseRolesAnywhere(ctx, data, region, pc)
 		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
+			return nil, err
 		}
+		return useAssumeRole(cfg, pc)
+	default:
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
-			cfg, err := UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
-			if err != nil {
-				return nil, err
-			}
-			return SetResolver(pc, cfg), nil
-		}
-		cfg, err := UseProviderSecret(ctx, data, DefaultSection, region)
-		if err != nil {
-			return nil, err
+			return UseProviderSecretAssumeRole(ctx, data, DefaultSection, region, pc)
 		}
-		return SetResolver(pc, cfg), nil
+		return UseProviderSecret(ctx, data, DefaultSection, region)
 	}
 }
 
@@ -358,11 +461,106 @@ func UseProviderSecretAssumeRole(ctx context.Context, data []byte, profile, regi
 		StringValue(roleArn),
 		stsAssumeRoleOptions,
 	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = aws.NewCredentialsCache(stsAssume, withExpiryWindow)
 
 	return &config, err
 }
 
+// credentialProcesses are the commands the Process credentials source is
+// allowed to run. The Process credentials source is disabled if it is empty.
+var credentialProcesses = struct {
+	sync.RWMutex
+	allowed map[string]bool
+}{}
+
+// AllowCredentialProcesses enables the Process credentials source of
+// ProviderConfigs for the supplied commands. Anyone who can create a
+// ProviderConfig can run an allowed command with arbitrary arguments, with
+// the identity of the provider, so only commands that are safe to run this
+// way should be allowed.
+func AllowCredentialProcesses(commands ...string) {
+	credentialProcesses.Lock()
+	defer credentialProcesses.Unlock()
+	credentialProcesses.allowed = make(map[string]bool, len(commands))
+	for _, c := range commands {
+		credentialProcesses.allowed[c] = true
+	}
+}
+
+func isCredentialProcessAllowed(command string) bool {
+	credentialProcesses.RLock()
+	defer credentialProcesses.RUnlock()
+	return credentialProcesses.allowed[command]
+}
+
+// UseProcessCredentials - AWS configuration which can be used to issue
+// requests against AWS API with credentials read from the external process
+// configured by the ProviderConfig. The command must have been allowed with
+// AllowCredentialProcesses.
+func UseProcessCredentials(ctx context.Context, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	p := pc.Spec.Credentials.Process
+	if p == nil || p.Command == "" {
+		return nil, errors.New("a command must be set to use the Process credentials source")
+	}
+	if !isCredentialProcessAllowed(p.Command) {
+		return nil, errors.Errorf("command %q is not allowed to be run by the Process credentials source", p.Command)
+	}
+	builder := processcreds.NewCommandBuilderFunc(func(ctx context.Context) (*exec.Cmd, error) {
+		return exec.CommandContext(ctx, p.Command, p.Args...), nil // nolint:gosec // Only allowed commands are run.
+	})
+	provider := processcreds.NewProviderCommand(builder, func(o *processcreds.Options) {
+		if p.Timeout != nil {
+			o.Timeout = p.Timeout.Duration
+		}
+	})
+
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		userAgentV2,
+		config.WithRegion(region),
+		config.WithCredentialsProvider(aws.NewCredentialsCache(provider, withExpiryWindow)),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	return &cfg, nil
+}
+
+// UseRolesAnywhere - AWS configuration which can be used to issue requests
+// against AWS API with credentials obtained from IAM Roles Anywhere, using
+// the PEM encoded certificate and private key in the supplied data.
+func UseRolesAnywhere(ctx context.Context, data []byte, region string, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	cfg, err := config.LoadDefaultConfig(
+		ctx,
+		userAgentV2,
+		config.WithRegion(region),
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load default AWS config")
+	}
+	// Sessions are created with the HTTP client and at the endpoint that
+	// the ProviderConfig configures for all other services.
+	provider, err := newRolesAnywhereProvider(data, pc.Spec.Credentials.RolesAnywhere, *SetResolver(pc, &cfg))
+	if err != nil {
+		return nil, err
+	}
+	cfg.Credentials = aws.NewCredentialsCache(provider, withExpiryWindow)
+	return &cfg, nil
+}
+
+// useAssumeRole returns a copy of the supplied config that assumes the role
+// configured by the supplied ProviderConfig, if any.
+func useAssumeRole(cfg *aws.Config, pc *v1beta1.ProviderConfig) (*aws.Config, error) {
+	if pc.Spec.AssumeRole == nil && pc.Spec.AssumeRoleARN == nil {
+		return cfg, nil
+	}
+	roleArn, err := GetAssumeRoleARN(pc.Spec.DeepCopy())
+	if err != nil {
+		return nil, err
+	}
+	return assumeRole(cfg, StringValue(roleArn), SetAssumeRoleOptions(pc)), nil
+}
+
 // UsePodServiceAccountAssumeRole assumes an IAM role configured via a ServiceAccount
 // assume Cross account IAM roles
 // https://aws.amazon.com/blogs/containers/cross-account-iam-roles-for-kubernetes-service-accounts/
@@ -386,7 +584,7 @@ func UsePodServiceAccountAssumeRole(ctx context.Context, _ []byte, _, region str
 				stsclient,
 				StringValue(roleArn),
 				stsAssumeRoleOptions,
-			)),
+			), withExpiryWindow),
 		),
 	)
 	if err != nil {
@@ -422,7 +620,7 @@ func UsePodServiceAccountAssumeRoleWithWebIdentity(ctx context.Context, _ []byte
 				StringValue(roleArn),
 				stscreds.IdentityTokenFile("/var/run/secrets/eks.amazonaws.com/serviceaccount/token"),
 				webIdentityRoleOptions,
-			)),
+			), withExpiryWindow),
 		),
 	)
 	if err != nil {
@@ -457,7 +655,7 @@ func UsePodServiceAccount(ctx context.Context, _ []byte, _, region string) (*aws
 
 // GetConfigV1 constructs an *awsv1.Config that can be used to authenticate to AWS
 // API by the AWSv1 clients.
-func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) { // nolint:gocyclo
+func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, region string) (*session.Session, error) {
 	if mg.GetProviderConfigReference() == nil {
 		return nil, errors.New("providerConfigRef cannot be empty")
 	}
@@ -470,45 +668,84 @@ func GetConfigV1(ctx context.Context, c client.Client, mg resource.Managed, regi
 	if err := t.Track(ctx, mg); err != nil {
 		return nil, errors.Wrap(err, "cannot track ProviderConfig usage")
 	}
+
+	pc, err := renderSessionTemplates(pc, mg)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := extractCredentials(ctx, c, pc)
+	if err != nil {
+		return nil, err
+	}
+
+	key, cacheable := newConfigCacheKey(pc, data, region)
+	if cacheable {
+		if sess, ok := configs.GetV1(key); ok {
+			return sess, nil
+		}
+	}
+	cfg, err := newProviderConfigConfigV1(ctx, data, region, pc)
+	if err != nil {
+		return nil, err
+	}
+	sess, err := GetSessionV1(SetRetryPolicyV1(pc, cfg))
+	if err != nil {
+		return nil, err
+	}
+	sess = SetRequestHandlersV1(pc, sess)
+	if cacheable {
+		configs.SetV1(key, sess)
+	}
+	return sess, nil
+}
+
+// newProviderConfigConfigV1 builds an *awsv1.Config that can be used to
+// authenticate to AWS from the supplied ProviderConfig and the credentials
+// data extracted from its source.
+func newProviderConfigConfigV1(ctx context.Context, data []byte, region string, pc *v1beta1.ProviderConfig) (*awsv1.Config, error) { // nolint:gocyclo
+	if len(pc.Spec.AssumeRoleChain) > 0 || usesV2CredentialsSource(pc) {
+		// Roles are assumed, and credentials sources that are only
+		// implemented for AWS SDK v2 are used, with AWS SDK v2, whose
+		// credentials refresh themselves, and handed to AWS SDK v1 clients.
+		cfg, err := newProviderConfigConfig(ctx, data, region, pc)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to retrieve credentials")
+		}
+		return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(newV1Credentials(cfg.Credentials)).WithRegion(region)), nil
+	}
 	switch s := pc.Spec.Credentials.Source; s { //nolint:exhaustive
 	case xpv1.CredentialsSourceInjectedIdentity:
 		if pc.Spec.AssumeRoleARN != nil || pc.Spec.AssumeRole != nil {
 			cfg, err := UsePodServiceAccountV1AssumeRole(ctx, []byte{}, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use pod service account to assume role")
-			}
-			return GetSessionV1(cfg)
+			return cfg, errors.Wrap(err, "cannot use pod service account to assume role")
 		}
 		if pc.Spec.AssumeRoleWithWebIdentity != nil && pc.Spec.AssumeRoleWithWebIdentity.RoleARN != nil {
-			cfg, err := UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx, []byte{}, pc, DefaultSection, region)
-			if err != nil {
-				return nil, err
-			}
-			return GetSessionV1(cfg)
+			return UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx, []byte{}, pc, DefaultSection, region)
 		}
 		cfg, err := UsePodServiceAccountV1(ctx, []byte{}, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use pod service account")
-		}
-		return GetSessionV1(cfg)
+		return cfg, errors.Wrap(err, "cannot use pod service account")
 	default:
-		data, err := resource.CommonCredentialExtractor(ctx, s, c, pc.Spec.Credentials.CommonCredentialSelectors)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot get credentials")
-		}
-
 		if pc.Spec.AssumeRole != nil || pc.Spec.AssumeRoleARN != nil {
 			cfg, err := UseProviderSecretV1AssumeRole(ctx, data, pc, DefaultSection, region)
-			if err != nil {
-				return nil, errors.Wrap(err, "cannot use secret")
-			}
-			return GetSessionV1(cfg)
+			return cfg, errors.Wrap(err, "cannot use secret")
 		}
 		cfg, err := UseProviderSecretV1(ctx, data, pc, DefaultSection, region)
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot use secret")
-		}
-		return GetSessionV1(cfg)
+		return cfg, errors.Wrap(err, "cannot use secret")
+	}
+}
+
+// usesV2CredentialsSource returns true if the credentials source of the
+// supplied ProviderConfig is only implemented for AWS SDK v2.
+func usesV2CredentialsSource(pc *v1beta1.ProviderConfig) bool {
+	switch pc.Spec.Credentials.Source { //nolint:exhaustive
+	case v1beta1.CredentialsSourceProcess, v1beta1.CredentialsSourceRolesAnywhere:
+		return true
+	default:
+		return false
 	}
 }
 
@@ -554,17 +791,12 @@ func UseProviderSecretV1AssumeRole(ctx context.Context, data []byte, pc *v1beta1
 		StringValue(roleArn),
 		stsAssumeRoleOptions,
 	)
-	config.Credentials = aws.NewCredentialsCache(stsAssume)
+	config.Credentials = aws.NewCredentialsCache(stsAssume, withExpiryWindow)
 
-	v2creds, err := config.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := config.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
+	v1creds := newV1Credentials(config.Credentials)
 
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
@@ -628,20 +860,16 @@ func UsePodServiceAccountV1AssumeRole(ctx context.Context, _ []byte, pc *v1beta1
 				stsclient,
 				StringValue(roleArn),
 				stsAssumeRoleOptions,
-			)),
+			), withExpiryWindow),
 		),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := cnf.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
+	v1creds := newV1Credentials(cnf.Credentials)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -672,20 +900,16 @@ func UsePodServiceAccountV1AssumeRoleWithWebIdentity(ctx context.Context, _ []by
 				StringValue(roleArn),
 				stscreds.IdentityTokenFile("/var/run/secrets/eks.amazonaws.com/serviceaccount/token"),
 				webIdentityRoleOptions,
-			)),
+			), withExpiryWindow),
 		),
 	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to load assumed role AWS config")
 	}
-	v2creds, err := cnf.Credentials.Retrieve(ctx)
-	if err != nil {
+	if _, err := cnf.Credentials.Retrieve(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to retrieve credentials")
 	}
-	v1creds := credentialsv1.NewStaticCredentials(
-		v2creds.AccessKeyID,
-		v2creds.SecretAccessKey,
-		v2creds.SessionToken)
+	v1creds := newV1Credentials(cnf.Credentials)
 	return SetResolverV1(pc, awsv1.NewConfig().WithCredentials(v1creds).WithRegion(region)), nil
 }
 
@@ -792,23 +1016,7 @@ func GetAssumeRoleWithWebIdentityARN(pcs *v1beta1.ProviderConfigSpec) (*string,
 // SetAssumeRoleOptions sets options when Assuming an IAM Role
 func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleOptions) {
 	if pc.Spec.AssumeRole != nil {
-		return func(opt *stscreds.AssumeRoleOptions) {
-			if pc.Spec.AssumeRole.ExternalID != nil {
-				opt.ExternalID = pc.Spec.AssumeRole.ExternalID
-			}
-
-			if pc.Spec.AssumeRole.Tags != nil && len(pc.Spec.AssumeRole.Tags) > 0 {
-				for _, t := range pc.Spec.AssumeRole.Tags {
-					opt.Tags = append(
-						opt.Tags,
-						stscredstypesv2.Tag{Key: t.Key, Value: t.Value})
-				}
-			}
-
-			if pc.Spec.AssumeRole.TransitiveTagKeys != nil && len(pc.Spec.AssumeRole.TransitiveTagKeys) > 0 {
-				opt.TransitiveTagKeys = pc.Spec.AssumeRole.TransitiveTagKeys
-			}
-		}
+		return assumeRoleOptions(pc.Spec.AssumeRole)
 	}
 
 	// Deprecated. Use AssumeRole.ExternalID
@@ -819,6 +1027,90 @@ func SetAssumeRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleO
 	return func(opt *stscreds.AssumeRoleOptions) {}
 }
 
+// assumeRoleOptions sets the supplied options when Assuming an IAM Role
+func assumeRoleOptions(o *v1beta1.AssumeRoleOptions) func(*stscreds.AssumeRoleOptions) {
+	return func(opt *stscreds.AssumeRoleOptions) {
+		if o.ExternalID != nil {
+			opt.ExternalID = o.ExternalID
+		}
+
+		if o.Tags != nil && len(o.Tags) > 0 {
+			for _, t := range o.Tags {
+				opt.Tags = append(
+					opt.Tags,
+					stscredstypesv2.Tag{Key: t.Key, Value: t.Value})
+			}
+		}
+
+		if o.TransitiveTagKeys != nil && len(o.TransitiveTagKeys) > 0 {
+			opt.TransitiveTagKeys = o.TransitiveTagKeys
+		}
+
+		if o.SessionDuration != nil {
+			opt.Duration = o.SessionDuration.Duration
+		}
+
+		if o.RoleSessionName != nil {
+			opt.RoleSessionName = StringValue(o.RoleSessionName)
+		}
+
+		if o.SourceIdentity != nil {
+			opt.SourceIdentity = o.SourceIdentity
+		}
+
+		if o.SessionPolicies != nil {
+			opt.Policy = o.SessionPolicies.Policy
+			opt.PolicyARNs = policyDescriptors(o.SessionPolicies.PolicyARNs)
+		}
+	}
+}
+
+// roleSessionOptions returns the options of the role session of the
+// supplied ProviderConfig that do not depend on the role that is assumed.
+func roleSessionOptions(pc *v1beta1.ProviderConfig) func(*stscreds.AssumeRoleOptions) {
+	if pc.Spec.AssumeRole == nil {
+		return func(*stscreds.AssumeRoleOptions) {}
+	}
+	return assumeRoleOptions(&v1beta1.AssumeRoleOptions{
+		Tags:              pc.Spec.AssumeRole.Tags,
+		TransitiveTagKeys: pc.Spec.AssumeRole.TransitiveTagKeys,
+		SessionDuration:   pc.Spec.AssumeRole.SessionDuration,
+		RoleSessionName:   pc.Spec.AssumeRole.RoleSessionName,
+		SourceIdentity:    pc.Spec.AssumeRole.SourceIdentity,
+	})
+}
+
+// assumeRole returns a copy of the supplied config that assumes the supplied
+// IAM role with the credentials of the supplied config.
+func assumeRole(cfg *aws.Config, roleARN string, o func(*stscreds.AssumeRoleOptions)) *aws.Config {
+	cnf := cfg.Copy()
+	cnf.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(*cfg), roleARN, o), withExpiryWindow)
+	return &cnf
+}
+
+// UseAssumeRoleChain assumes the IAM roles of the supplied chain in order,
+// starting with the credentials of the supplied config. The credentials of
+// the returned config are those of the last role in the chain.
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_terms-and-concepts.html#iam-term-role-chaining
+func UseAssumeRoleChain(cfg *aws.Config, chain []v1beta1.AssumeRoleOptions) (*aws.Config, error) {
+	cnf := cfg.Copy()
+	for i := range chain {
+		hop := chain[i]
+		if StringValue(hop.RoleARN) == "" {
+			return nil, errors.Errorf("a RoleARN must be set to assume IAM Role %d of the chain", i)
+		}
+		// The STS client is built from a copy of the config, so it signs its
+		// requests with the credentials of the previous role in the chain.
+		cnf.Credentials = aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(
+				sts.NewFromConfig(cnf),
+				StringValue(hop.RoleARN),
+				assumeRoleOptions(&hop),
+			), withExpiryWindow)
+	}
+	return &cnf, nil
+}
+
 // SetWebIdentityRoleOptions sets options when exchanging a WebIdentity Token for a Role
 func SetWebIdentityRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.WebIdentityRoleOptions) {
 	if pc.Spec.AssumeRoleWithWebIdentity != nil {
@@ -826,6 +1118,15 @@ func SetWebIdentityRoleOptions(pc *v1beta1.ProviderConfig) func(*stscreds.WebIde
 			if pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName != "" {
 				opt.RoleSessionName = pc.Spec.AssumeRoleWithWebIdentity.RoleSessionName
 			}
+
+			if pc.Spec.AssumeRoleWithWebIdentity.SessionDuration != nil {
+				opt.Duration = pc.Spec.AssumeRoleWithWebIdentity.SessionDuration.Duration
+			}
+
+			if p := pc.Spec.AssumeRoleWithWebIdentity.SessionPolicies; p != nil {
+				opt.Policy = p.Policy
+				opt.PolicyARNs = policyDescriptors(p.PolicyARNs)
+			}
 		}
 	}
 
@@ -1242,25 +1543,56 @@ func IsPolicyUpToDate(local, remote *string) bool {
 		return false
 	}
 
-	sortSlicesOpt := cmpopts.SortSlices(func(x, y interface{}) bool {
-		if a, ok := x.(string); ok {
-			if b, ok := y.(string); ok {
-				return a < b
-			}
-		}
-		// Note: Unknown types in slices will not cause a panic, but
-		// may not be sorted correctly. Depending on how AWS handles
-		// these, it may cause constant updates - but better this than
-		// panicing.
-		return false
-	})
-	diff := cmp.Diff(localUnmarshalled, remoteUnmarshalled, cmpopts.EquateEmpty(), sortSlicesOpt)
+	diff := cmp.Diff(normalizePolicy(localUnmarshalled), normalizePolicy(remoteUnmarshalled), cmpopts.EquateEmpty())
 	if diff != "" {
 		log.Println("diff" + diff)
 	}
 	return diff == ""
 }
 
+// normalizePolicy sorts all lists in the given policy document by their JSON
+// representation and unwraps lists containing a single element, since AWS
+// accepts both a single value and a list for most policy elements and does
+// not keep the order of statements, principals, actions and so on.
+func normalizePolicy(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			m[k] = normalizePolicy(e)
+		}
+		return m
+	case []interface{}:
+		if len(t) == 1 {
+			return normalizePolicy(t[0])
+		}
+		s := make([]interface{}, len(t))
+		keys := make([]string, len(t))
+		for i, e := range t {
+			s[i] = normalizePolicy(e)
+			b, _ := json.Marshal(s[i])
+			keys[i] = string(b)
+		}
+		sort.Sort(byJSON{values: s, keys: keys})
+		return s
+	default:
+		return v
+	}
+}
+
+// byJSON sorts values by their JSON representation.
+type byJSON struct {
+	values []interface{}
+	keys   []string
+}
+
+func (b byJSON) Len() int           { return len(b.values) }
+func (b byJSON) Less(i, j int) bool { return b.keys[i] < b.keys[j] }
+func (b byJSON) Swap(i, j int) {
+	b.values[i], b.values[j] = b.values[j], b.values[i]
+	b.keys[i], b.keys[j] = b.keys[j], b.keys[i]
+}
+
 // Wrap will remove the request-specific information from the error and only then
 // wrap it.
 func Wrap(err error, msg string) error {
@@ -19,11 +19,18 @@ package aws
 import (
 	"context"
 	"fmt"
+	nethttp "net/http"
+	"net/http/httptest"
 	"os"
+	"path"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/processcreds"
 	stscreds "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	stscredstypesv2 "github.com/aws/aws-sdk-go-v2/service/sts/types"
@@ -35,6 +42,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -346,6 +354,32 @@ func TestSetAssumeroleOptions(t *testing.T) {
 				aro: stscreds.AssumeRoleOptions{},
 			},
 		},
+		"SetSessionOptions": {
+			args: args{
+				pc: v1beta1.ProviderConfig{
+					Spec: v1beta1.ProviderConfigSpec{
+						AssumeRole: &v1beta1.AssumeRoleOptions{
+							RoleSessionName: aws.String("session"),
+							SourceIdentity:  aws.String("identity"),
+							SessionDuration: &v1.Duration{Duration: time.Hour},
+							SessionPolicies: &v1beta1.SessionPolicies{
+								Policy:     aws.String("{}"),
+								PolicyARNs: []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"},
+							},
+						},
+					},
+				},
+			},
+			want: want{
+				aro: stscreds.AssumeRoleOptions{
+					RoleSessionName: "session",
+					SourceIdentity:  aws.String("identity"),
+					Duration:        time.Hour,
+					Policy:          aws.String("{}"),
+					PolicyARNs:      []stscredstypesv2.PolicyDescriptorType{{Arn: aws.String("arn:aws:iam::aws:policy/ReadOnlyAccess")}},
+				},
+			},
+		},
 	}
 
 	for name, tc := range cases {
@@ -355,7 +389,7 @@ func TestSetAssumeroleOptions(t *testing.T) {
 			f := SetAssumeRoleOptions(&tc.args.pc)
 			f(&aro)
 
-			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{})); diff != "" {
+			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{}, stscredstypesv2.PolicyDescriptorType{})); diff != "" {
 				t.Errorf("Wrap: -want, +got:\n%s", diff)
 			}
 		})
@@ -410,7 +444,7 @@ func TestSetWebIdentityRoleOptions(t *testing.T) {
 			f := SetWebIdentityRoleOptions(&tc.args.pc)
 			f(&aro)
 
-			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{})); diff != "" {
+			if diff := cmp.Diff(tc.want.aro, aro, cmpopts.IgnoreUnexported(stscredstypesv2.Tag{}, stscredstypesv2.PolicyDescriptorType{})); diff != "" {
 				t.Errorf("Wrap: -want, +got:\n%s", diff)
 			}
 		})
@@ -854,6 +888,38 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: true,
 		},
+		"ReorderedStatements": {
+			args: args{
+				local: `{"Version":"2012-10-17","Statement":[
+					{"Sid":"a","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:root","arn:aws:iam::123456789012:role/r"]},"Action":"kms:*","Resource":"*"},
+					{"Sid":"b","Effect":"Allow","Principal":{"Service":"logs.amazonaws.com"},"Action":["kms:Decrypt","kms:Encrypt"],"Resource":"*"}]}`,
+				remote: `{"Version":"2012-10-17","Statement":[
+					{"Sid":"b","Effect":"Allow","Principal":{"Service":"logs.amazonaws.com"},"Action":["kms:Encrypt","kms:Decrypt"],"Resource":"*"},
+					{"Sid":"a","Effect":"Allow","Principal":{"AWS":["arn:aws:iam::123456789012:role/r","arn:aws:iam::123456789012:root"]},"Action":"kms:*","Resource":"*"}]}`,
+			},
+			want: true,
+		},
+		"SingleElementList": {
+			args: args{
+				local:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["kms:*"],"Resource":"*"}]}`,
+				remote: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","Action":"kms:*","Resource":"*"}}`,
+			},
+			want: true,
+		},
+		"DifferentAction": {
+			args: args{
+				local:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:*","Resource":"*"}]}`,
+				remote: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"kms:Decrypt","Resource":"*"}]}`,
+			},
+			want: false,
+		},
+		"InvalidJSON": {
+			args: args{
+				local:  `{`,
+				remote: `{}`,
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
@@ -1270,3 +1336,310 @@ func TestUsePodServiceAccountAssumeRole(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestUseProcessCredentials(t *testing.T) {
+	type want struct {
+		creds aws.Credentials
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		allowed []string
+		process *v1beta1.ProcessCredentials
+		want    want
+	}{
+		"NoCommand": {
+			reason: "A command must be configured to use the Process credentials source.",
+			want: want{
+				err: errors.New("a command must be set to use the Process credentials source"),
+			},
+		},
+		"NotAllowed": {
+			reason: "Commands that are not allowed must not be run.",
+			process: &v1beta1.ProcessCredentials{
+				Command: "sh",
+				Args:    []string{"-c", "exit 1"},
+			},
+			want: want{
+				err: errors.New(`command "sh" is not allowed to be run by the Process credentials source`),
+			},
+		},
+		"Process": {
+			reason:  "Credentials should be read from the output of the process.",
+			allowed: []string{"sh"},
+			process: &v1beta1.ProcessCredentials{
+				Command: "sh",
+				Args:    []string{"-c", `echo '{"Version": 1, "AccessKeyId": "akid", "SecretAccessKey": "secret", "SessionToken": "token"}'`},
+				Timeout: &v1.Duration{Duration: 10 * time.Second},
+			},
+			want: want{
+				creds: aws.Credentials{
+					AccessKeyID:     "akid",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Source:          processcreds.ProviderName,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			AllowCredentialProcesses(tc.allowed...)
+			defer AllowCredentialProcesses()
+
+			pc := &v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Credentials: v1beta1.ProviderCredentials{
+						Source:  v1beta1.CredentialsSourceProcess,
+						Process: tc.process,
+					},
+				},
+			}
+			cfg, err := UseProcessCredentials(context.TODO(), "us-east-1", pc)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nUseProcessCredentials(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			creds, err := cfg.Credentials.Retrieve(context.TODO())
+			if err != nil {
+				t.Fatalf("\n%s\nRetrieve(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.creds, creds); diff != "" {
+				t.Errorf("\n%s\nRetrieve(...): -want credentials, +got credentials:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUseProviderConfigCache(t *testing.T) {
+	providerConfigReferenceName := "ProviderConfigReference"
+
+	type args struct {
+		uid             string
+		generation      int64
+		resourceVersion string
+		static          string
+	}
+
+	cases := map[string]struct {
+		reason string
+		first  args
+		second args
+		want   string
+	}{
+		"SameVersion": {
+			reason: "A ProviderConfig that did not change should be served from the cache.",
+			first:  args{uid: "cool-uid", generation: 1, resourceVersion: "1", static: "http://first:4566"},
+			second: args{uid: "cool-uid", generation: 1, resourceVersion: "1", static: "http://second:4566"},
+			want:   "http://first:4566",
+		},
+		"StatusChanged": {
+			reason: "A ProviderConfig whose status changed should be served from the cache.",
+			first:  args{uid: "status-uid", generation: 1, resourceVersion: "1", static: "http://first:4566"},
+			second: args{uid: "status-uid", generation: 1, resourceVersion: "2", static: "http://second:4566"},
+			want:   "http://first:4566",
+		},
+		"NewGeneration": {
+			reason: "A ProviderConfig whose spec changed should invalidate the cached config.",
+			first:  args{uid: "other-uid", generation: 1, resourceVersion: "1", static: "http://first:4566"},
+			second: args{uid: "other-uid", generation: 2, resourceVersion: "2", static: "http://second:4566"},
+			want:   "http://second:4566",
+		},
+		"NotFromAPIServer": {
+			reason: "A ProviderConfig without a UID and generation should never be cached.",
+			first:  args{static: "http://first:4566"},
+			second: args{static: "http://second:4566"},
+			want:   "http://second:4566",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := fake.Managed{
+				ProviderConfigReferencer: fake.ProviderConfigReferencer{
+					Ref: &xpv1.Reference{Name: providerConfigReferenceName},
+				},
+			}
+			newClient := func(a args) client.Client {
+				return &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						if pc, ok := obj.(*v1beta1.ProviderConfig); ok {
+							*pc = v1beta1.ProviderConfig{
+								ObjectMeta: v1.ObjectMeta{Name: providerConfigReferenceName, UID: types.UID(a.uid), Generation: a.generation, ResourceVersion: a.resourceVersion},
+								Spec: v1beta1.ProviderConfigSpec{
+									Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone},
+									Endpoint:    &v1beta1.EndpointConfig{URL: v1beta1.URLConfig{Type: URLConfigTypeStatic, Static: aws.String(a.static)}},
+								},
+							}
+						}
+						return nil
+					}),
+				}
+			}
+
+			if _, err := UseProviderConfig(context.TODO(), newClient(tc.first), &mg, "us-east-1"); err != nil {
+				t.Fatalf("\n%s\nUseProviderConfig(...): %s", tc.reason, err)
+			}
+			cfg, err := UseProviderConfig(context.TODO(), newClient(tc.second), &mg, "us-east-1")
+			if err != nil {
+				t.Fatalf("\n%s\nUseProviderConfig(...): %s", tc.reason, err)
+			}
+			got, err := cfg.EndpointResolverWithOptions.ResolveEndpoint("ec2", "us-east-1")
+			if err != nil {
+				t.Fatalf("\n%s\nResolveEndpoint(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got.URL); diff != "" {
+				t.Errorf("\n%s\nUseProviderConfig(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetAssumeRoleConfigCache(t *testing.T) {
+	mg := fake.Managed{
+		ProviderConfigReferencer: fake.ProviderConfigReferencer{
+			Ref: &xpv1.Reference{Name: "assume-role-cache"},
+		},
+	}
+	c := &test.MockClient{
+		MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+			if pc, ok := obj.(*v1beta1.ProviderConfig); ok {
+				*pc = v1beta1.ProviderConfig{
+					ObjectMeta: v1.ObjectMeta{Name: "assume-role-cache", UID: types.UID("assume-role-cache-uid"), Generation: 1},
+					Spec:       v1beta1.ProviderConfigSpec{Credentials: v1beta1.ProviderCredentials{Source: xpv1.CredentialsSourceNone}},
+				}
+			}
+			return nil
+		}),
+	}
+	get := func(role string) *aws.Config {
+		cfg, err := GetAssumeRoleConfig(context.TODO(), c, &mg, "us-east-1", role)
+		if err != nil {
+			t.Fatalf("GetAssumeRoleConfig(...): %s", err)
+		}
+		return cfg
+	}
+
+	first := get("arn:aws:iam::123456789012:role/first")
+	if get("arn:aws:iam::123456789012:role/first").Credentials != first.Credentials {
+		t.Errorf("GetAssumeRoleConfig(...): want the credentials of the same role to be cached")
+	}
+	if get("arn:aws:iam::123456789012:role/second").Credentials == first.Credentials {
+		t.Errorf("GetAssumeRoleConfig(...): want credentials per role")
+	}
+	base, err := UseProviderConfig(context.TODO(), c, &mg, "us-east-1")
+	if err != nil {
+		t.Fatalf("UseProviderConfig(...): %s", err)
+	}
+	if base.Credentials == first.Credentials {
+		t.Errorf("UseProviderConfig(...): want the config of the ProviderConfig not to assume the role")
+	}
+}
+
+func TestUseAssumeRoleChain(t *testing.T) {
+	type call struct {
+		RoleARN         string
+		DurationSeconds string
+		ExternalID      string
+		SignedBy        string
+	}
+
+	type want struct {
+		calls       []call
+		accessKeyID string
+		err         error
+	}
+
+	cases := map[string]struct {
+		reason string
+		chain  []v1beta1.AssumeRoleOptions
+		want   want
+	}{
+		"MissingRoleARN": {
+			reason: "Every role in the chain must have a RoleARN.",
+			chain: []v1beta1.AssumeRoleOptions{
+				{RoleARN: aws.String("arn:aws:iam::111111111111:role/broker")},
+				{ExternalID: aws.String("ext")},
+			},
+			want: want{
+				err: errors.New("a RoleARN must be set to assume IAM Role 1 of the chain"),
+			},
+		},
+		"Chain": {
+			reason: "Each role in the chain should be assumed with the credentials of the previous one.",
+			chain: []v1beta1.AssumeRoleOptions{
+				{RoleARN: aws.String("arn:aws:iam::111111111111:role/broker"), SessionDuration: &v1.Duration{Duration: time.Hour}},
+				{RoleARN: aws.String("arn:aws:iam::222222222222:role/workload"), ExternalID: aws.String("ext")},
+			},
+			want: want{
+				calls: []call{
+					{RoleARN: "arn:aws:iam::111111111111:role/broker", DurationSeconds: "3600", SignedBy: "base"},
+					{RoleARN: "arn:aws:iam::222222222222:role/workload", DurationSeconds: "900", ExternalID: "ext", SignedBy: "assumed-broker"},
+				},
+				accessKeyID: "assumed-workload",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []call
+			srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+				if err := r.ParseForm(); err != nil {
+					t.Fatal(err)
+				}
+				auth := r.Header.Get("Authorization")
+				signedBy := strings.SplitN(strings.SplitN(auth, "Credential=", 2)[1], "/", 2)[0]
+				calls = append(calls, call{
+					RoleARN:         r.Form.Get("RoleArn"),
+					DurationSeconds: r.Form.Get("DurationSeconds"),
+					ExternalID:      r.Form.Get("ExternalId"),
+					SignedBy:        signedBy,
+				})
+				fmt.Fprintf(w, assumeRoleResponseFormat, "assumed-"+path.Base(r.Form.Get("RoleArn")))
+			}))
+			defer srv.Close()
+
+			cfg := &aws.Config{
+				Region:      "us-east-1",
+				Credentials: credentials.NewStaticCredentialsProvider("base", "secret", ""),
+				EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(_, _ string, _ ...interface{}) (aws.Endpoint, error) {
+					return aws.Endpoint{URL: srv.URL}, nil
+				}),
+			}
+
+			got, err := UseAssumeRoleChain(cfg, tc.chain)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nUseAssumeRoleChain(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			creds, err := got.Credentials.Retrieve(context.TODO())
+			if err != nil {
+				t.Fatalf("\n%s\nRetrieve(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.accessKeyID, creds.AccessKeyID); diff != "" {
+				t.Errorf("\n%s\nUseAssumeRoleChain(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("\n%s\nUseAssumeRoleChain(...): -want calls, +got calls:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+const assumeRoleResponseFormat = `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <Credentials>
+      <AccessKeyId>%s</AccessKeyId>
+      <SecretAccessKey>secret</SecretAccessKey>
+      <SessionToken>token</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+</AssumeRoleResponse>`
@@ -0,0 +1,278 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	credentialsv1 "github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+// credentialsExpiryWindow is how long before their expiry cached temporary
+// credentials are refreshed, so that a request is never signed with
+// credentials that expire while it is in flight.
+const credentialsExpiryWindow = 1 * time.Minute
+
+// withExpiryWindow configures a credentials cache to refresh credentials
+// credentialsExpiryWindow before they expire.
+func withExpiryWindow(o *aws.CredentialsCacheOptions) {
+	o.ExpiryWindow = credentialsExpiryWindow
+}
+
+// configs is the process-wide cache of AWS configurations built from
+// ProviderConfigs. It is shared by all controllers so that the ProviderConfig
+// credentials, and any role assumed with them, are reused across reconciles
+// instead of being resolved on every Connect.
+var configs = newConfigCache()
+
+// configCacheIdleTimeout is how long a cached configuration is retained after
+// it was last used. It bounds how long the configurations of a deleted
+// ProviderConfig are kept in memory.
+const configCacheIdleTimeout = 1 * time.Hour
+
+// maxConfigCacheEntries is the maximum number of cached configurations. Role
+// session names that refer to the managed resource require a configuration,
+// and thus a role session, per managed resource, because the session name is
+// part of the credentials of the session. The least recently used
+// configuration is evicted when the cache is full, so the cache does not help
+// ProviderConfigs whose session names refer to the managed resource once more
+// than maxConfigCacheEntries managed resources use them.
+const maxConfigCacheEntries = 1000
+
+// A configCacheKey identifies a configuration built from a particular
+// generation of a ProviderConfig and its credentials for a region, and the
+// IAM role it assumes in addition to those of the ProviderConfig, if any.
+type configCacheKey struct {
+	uid         types.UID
+	generation  int64
+	credentials string
+	sessions    string
+	region      string
+	role        string
+}
+
+// newConfigCacheKey returns the key of the configuration built from the
+// supplied ProviderConfig and credentials data for the supplied region. The
+// ProviderConfig is keyed by its generation rather than its resourceVersion,
+// so that status updates, e.g. of the number of its users, do not invalidate
+// the configuration. The credentials are keyed by their hash so that any
+// change to the referenced Secret, environment variable or file invalidates
+// the configuration without the credentials being retained in memory any
+// longer than necessary. Role session names are part of the key, so that
+// roles are only assumed once per distinct session. It returns false if the
+// ProviderConfig can not be cached, i.e. because it was not read from the API
+// server.
+func newConfigCacheKey(pc *v1beta1.ProviderConfig, data []byte, region string) (configCacheKey, bool) {
+	if pc.GetUID() == "" || pc.GetGeneration() == 0 {
+		return configCacheKey{}, false
+	}
+	h := sha256.Sum256(data)
+	return configCacheKey{
+		uid:         pc.GetUID(),
+		generation:  pc.GetGeneration(),
+		credentials: hex.EncodeToString(h[:]),
+		sessions:    strings.Join(sessionNames(pc), "/"),
+		region:      region,
+	}, true
+}
+
+// A configCacheEntry holds the AWS SDK v2 configuration and the AWS SDK v1
+// session built for a configCacheKey. Either of them may be nil.
+type configCacheEntry struct {
+	v2       *aws.Config
+	v1       *session.Session
+	lastUsed time.Time
+}
+
+// A configCache caches AWS SDK v2 configurations and AWS SDK v1 sessions.
+// Cached entries hold credential providers that refresh themselves before
+// they expire, so an entry stays valid until the ProviderConfig or its
+// credentials change. Entries that were not used for configCacheIdleTimeout
+// are evicted, as is the least recently used entry when the cache holds
+// maxConfigCacheEntries.
+type configCache struct {
+	mu        sync.Mutex
+	entries   map[configCacheKey]*configCacheEntry
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+func newConfigCache() *configCache {
+	return &configCache{
+		entries: map[configCacheKey]*configCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// GetV2 returns a copy of the cached AWS SDK v2 configuration for the supplied
+// key, if any.
+func (c *configCache) GetV2(k configCacheKey) (*aws.Config, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.get(k)
+	if e == nil || e.v2 == nil {
+		return nil, false
+	}
+	cp := e.v2.Copy()
+	return &cp, true
+}
+
+// SetV2 caches the supplied AWS SDK v2 configuration, evicting any
+// configuration built from an older version of the same ProviderConfig.
+func (c *configCache) SetV2(k configCacheKey, cfg *aws.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cp := cfg.Copy()
+	c.set(k).v2 = &cp
+}
+
+// GetV1 returns the cached AWS SDK v1 session for the supplied key, if any.
+// Sessions are safe to be shared by concurrent clients.
+func (c *configCache) GetV1(k configCacheKey) (*session.Session, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e := c.get(k)
+	if e == nil || e.v1 == nil {
+		return nil, false
+	}
+	return e.v1, true
+}
+
+// SetV1 caches the supplied AWS SDK v1 session, evicting any session built
+// from an older version of the same ProviderConfig.
+func (c *configCache) SetV1(k configCacheKey, s *session.Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(k).v1 = s
+}
+
+// get returns the entry for the supplied key and marks it as used. The caller
+// must hold the lock.
+func (c *configCache) get(k configCacheKey) *configCacheEntry {
+	now := c.now()
+	c.sweep(now)
+	e, ok := c.entries[k]
+	if !ok {
+		return nil
+	}
+	e.lastUsed = now
+	return e
+}
+
+// set returns the entry for the supplied key, creating it if necessary, and
+// evicts the entries of other versions of the same ProviderConfig. The caller
+// must hold the lock.
+func (c *configCache) set(k configCacheKey) *configCacheEntry {
+	for ek := range c.entries {
+		if ek.uid == k.uid && !ek.sameVersion(k) {
+			delete(c.entries, ek)
+		}
+	}
+	e := c.get(k)
+	if e == nil {
+		if len(c.entries) >= maxConfigCacheEntries {
+			c.evictLeastRecentlyUsed()
+		}
+		e = &configCacheEntry{lastUsed: c.now()}
+		c.entries[k] = e
+	}
+	return e
+}
+
+// evictLeastRecentlyUsed evicts the entry that was used least recently. The
+// caller must hold the lock.
+func (c *configCache) evictLeastRecentlyUsed() {
+	var lru *configCacheKey
+	var lastUsed time.Time
+	for k, e := range c.entries {
+		if lru == nil || e.lastUsed.Before(lastUsed) {
+			k := k
+			lru, lastUsed = &k, e.lastUsed
+		}
+	}
+	if lru != nil {
+		delete(c.entries, *lru)
+	}
+}
+
+// sweep evicts the entries that were not used for configCacheIdleTimeout. It
+// walks the cache at most once per configCacheIdleTimeout. The caller must
+// hold the lock.
+func (c *configCache) sweep(now time.Time) {
+	if now.Sub(c.lastSweep) < configCacheIdleTimeout {
+		return
+	}
+	for k, e := range c.entries {
+		if now.Sub(e.lastUsed) >= configCacheIdleTimeout {
+			delete(c.entries, k)
+		}
+	}
+	c.lastSweep = now
+}
+
+func (k configCacheKey) sameVersion(o configCacheKey) bool {
+	return k.generation == o.generation && k.credentials == o.credentials
+}
+
+// v1CredentialsProvider adapts an AWS SDK v2 credentials provider so that it
+// can be used by AWS SDK v1 clients. Unlike static credentials, credentials
+// retrieved through it are refreshed before they expire, which allows AWS SDK
+// v1 sessions using assumed roles to be cached.
+type v1CredentialsProvider struct {
+	credentialsv1.Expiry
+
+	provider aws.CredentialsProvider
+}
+
+// newV1Credentials returns AWS SDK v1 credentials that are retrieved from the
+// supplied AWS SDK v2 credentials provider.
+func newV1Credentials(p aws.CredentialsProvider) *credentialsv1.Credentials {
+	return credentialsv1.NewCredentials(&v1CredentialsProvider{provider: p})
+}
+
+// Retrieve credentials from the underlying AWS SDK v2 provider.
+func (p *v1CredentialsProvider) Retrieve() (credentialsv1.Value, error) {
+	return p.RetrieveWithContext(context.Background())
+}
+
+// RetrieveWithContext retrieves credentials from the underlying AWS SDK v2
+// provider.
+func (p *v1CredentialsProvider) RetrieveWithContext(ctx credentialsv1.Context) (credentialsv1.Value, error) {
+	c, err := p.provider.Retrieve(ctx)
+	if err != nil {
+		return credentialsv1.Value{}, err
+	}
+	if c.CanExpire {
+		p.SetExpiration(c.Expires, credentialsExpiryWindow)
+	}
+	return credentialsv1.Value{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		ProviderName:    c.Source,
+	}, nil
+}
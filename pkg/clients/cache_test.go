@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigCacheEviction(t *testing.T) {
+	used := configCacheKey{uid: "used", generation: 1, region: "us-east-1"}
+	idle := configCacheKey{uid: "idle", generation: 1, region: "us-east-1"}
+	newer := configCacheKey{uid: "used", generation: 2, region: "us-east-1"}
+
+	type want struct {
+		used  bool
+		idle  bool
+		newer bool
+	}
+
+	cases := map[string]struct {
+		reason  string
+		elapsed time.Duration
+		set     *configCacheKey
+		want    want
+	}{
+		"Recent": {
+			reason:  "Entries that were recently used should be retained.",
+			elapsed: configCacheIdleTimeout / 2,
+			want:    want{used: true, idle: true},
+		},
+		"Idle": {
+			reason:  "Entries that were not used for the idle timeout should be evicted, e.g. because their ProviderConfig was deleted.",
+			elapsed: configCacheIdleTimeout,
+			want:    want{used: true},
+		},
+		"NewerGeneration": {
+			reason: "Entries of an older generation of a ProviderConfig should be evicted when a newer one is cached.",
+			set:    &newer,
+			want:   want{idle: true, newer: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			c := newConfigCache()
+			c.now = func() time.Time { return now }
+			c.SetV2(used, &aws.Config{})
+			c.SetV2(idle, &aws.Config{})
+
+			// Only the used entry is used in the meantime.
+			now = now.Add(tc.elapsed / 2)
+			c.GetV2(used)
+			now = now.Add(tc.elapsed / 2)
+			if tc.set != nil {
+				c.SetV2(*tc.set, &aws.Config{})
+			}
+			_, gotUsed := c.GetV2(used)
+			_, gotNewer := c.GetV2(newer)
+			_, gotIdle := c.GetV2(idle)
+			got := want{used: gotUsed, idle: gotIdle, newer: gotNewer}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGetV2(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestConfigCacheSize(t *testing.T) {
+	now := time.Now()
+	c := newConfigCache()
+	c.now = func() time.Time { return now }
+
+	first := configCacheKey{uid: "pc", generation: 1, sessions: "mr-0"}
+	c.SetV2(first, &aws.Config{})
+	for i := 1; i <= maxConfigCacheEntries; i++ {
+		now = now.Add(time.Millisecond)
+		c.SetV2(configCacheKey{uid: "pc", generation: 1, sessions: fmt.Sprintf("mr-%d", i)}, &aws.Config{})
+	}
+
+	if diff := cmp.Diff(maxConfigCacheEntries, len(c.entries)); diff != "" {
+		t.Errorf("len(entries): -want, +got:\n%s", diff)
+	}
+	if _, ok := c.GetV2(first); ok {
+		t.Errorf("GetV2(...): the least recently used entry should have been evicted")
+	}
+}
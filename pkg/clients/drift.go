@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+// Drift returns the fields in which the supplied observed value differs from
+// the supplied desired value, compared with the supplied options.
+func Drift(desired, observed interface{}, opts ...cmp.Option) []v1alpha1.FieldDrift {
+	r := &driftReporter{}
+	cmp.Equal(desired, observed, append(opts, cmp.Reporter(r))...)
+	return r.drift
+}
+
+// DriftAt returns the fields in which the supplied observed value of the field
+// at the supplied path differs from the supplied desired value, like Drift.
+func DriftAt(path string, desired, observed interface{}, opts ...cmp.Option) []v1alpha1.FieldDrift {
+	drift := Drift(desired, observed, opts...)
+	for i := range drift {
+		switch {
+		case drift[i].Path == "":
+			drift[i].Path = path
+		case strings.HasPrefix(drift[i].Path, "["):
+			drift[i].Path = path + drift[i].Path
+		default:
+			drift[i].Path = path + "." + drift[i].Path
+		}
+	}
+	return drift
+}
+
+// ElementsDrift returns the drift of the list at the supplied path whose order
+// does not matter, i.e. the supplied slices of desired elements that were not
+// observed and of observed elements that are not desired.
+func ElementsDrift(path string, missing, unexpected interface{}) []v1alpha1.FieldDrift {
+	var drift []v1alpha1.FieldDrift
+	if m := reflect.ValueOf(missing); m.Kind() == reflect.Slice {
+		for i := 0; i < m.Len(); i++ {
+			drift = append(drift, v1alpha1.FieldDrift{Path: path, Desired: driftValue(m.Index(i))})
+		}
+	}
+	if u := reflect.ValueOf(unexpected); u.Kind() == reflect.Slice {
+		for i := 0; i < u.Len(); i++ {
+			drift = append(drift, v1alpha1.FieldDrift{Path: path, Observed: driftValue(u.Index(i))})
+		}
+	}
+	return drift
+}
+
+// FormatDrift returns a human readable description of the supplied drift.
+func FormatDrift(drift []v1alpha1.FieldDrift) string {
+	lines := make([]string, len(drift))
+	for i, d := range drift {
+		if d.Desired == "" && d.Observed == "" {
+			lines[i] = d.Path
+			continue
+		}
+		lines[i] = fmt.Sprintf("%s: desired %s, observed %s", d.Path, orNone(d.Desired), orNone(d.Observed))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func orNone(v string) string {
+	if v == "" {
+		return "none"
+	}
+	return v
+}
+
+type driftReporter struct {
+	path  cmp.Path
+	drift []v1alpha1.FieldDrift
+}
+
+func (r *driftReporter) PushStep(s cmp.PathStep) {
+	r.path = append(r.path, s)
+}
+
+func (r *driftReporter) Report(rs cmp.Result) {
+	if rs.Equal() {
+		return
+	}
+	d, o := r.path.Last().Values()
+	r.drift = append(r.drift, v1alpha1.FieldDrift{
+		Path:     driftPath(r.path),
+		Desired:  driftValue(d),
+		Observed: driftValue(o),
+	})
+}
+
+func (r *driftReporter) PopStep() {
+	r.path = r.path[:len(r.path)-1]
+}
+
+// driftPath returns the path of the field in the supplied path, e.g.
+// Tags[0].Value, without indirections and type assertions.
+func driftPath(p cmp.Path) string {
+	var b strings.Builder
+	for _, s := range p {
+		switch s := s.(type) {
+		case cmp.StructField:
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(s.Name())
+		case cmp.SliceIndex:
+			i, j := s.SplitKeys()
+			if i < 0 {
+				i = j
+			}
+			fmt.Fprintf(&b, "[%d]", i)
+		case cmp.MapIndex:
+			fmt.Fprintf(&b, "[%v]", s.Key())
+		}
+	}
+	return b.String()
+}
+
+// driftValue returns the supplied value in JSON, or an empty string if it is
+// invalid or nil.
+func driftValue(v reflect.Value) string {
+	if !v.IsValid() || !v.CanInterface() {
+		return ""
+	}
+	switch v.Kind() { // nolint:exhaustive
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		if v.IsNil() {
+			return ""
+		}
+	}
+	b, err := json.Marshal(v.Interface())
+	if err != nil {
+		return fmt.Sprintf("%v", v.Interface())
+	}
+	return string(b)
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+func TestDrift(t *testing.T) {
+	type tag struct {
+		Key   string
+		Value *string
+	}
+	type params struct {
+		Name   *string
+		Tags   []tag
+		Labels map[string]string
+	}
+
+	cases := map[string]struct {
+		reason   string
+		desired  params
+		observed params
+		want     []v1alpha1.FieldDrift
+	}{
+		"UpToDate": {
+			reason:   "No drift should be returned if the values are equal.",
+			desired:  params{Name: String("a"), Tags: []tag{{Key: "k", Value: String("v")}}},
+			observed: params{Name: String("a"), Tags: []tag{{Key: "k", Value: String("v")}}},
+		},
+		"Field": {
+			reason:   "Drifted fields should be returned with their path and values.",
+			desired:  params{Name: String("a")},
+			observed: params{Name: String("b")},
+			want:     []v1alpha1.FieldDrift{{Path: "Name", Desired: `"a"`, Observed: `"b"`}},
+		},
+		"NotObserved": {
+			reason:   "Fields that were not observed should have no observed value.",
+			desired:  params{Name: String("a")},
+			observed: params{},
+			want:     []v1alpha1.FieldDrift{{Path: "Name", Desired: `"a"`}},
+		},
+		"SliceElement": {
+			reason:   "Drifted fields of slice elements should be returned with their index.",
+			desired:  params{Tags: []tag{{Key: "k", Value: String("v")}}},
+			observed: params{Tags: []tag{{Key: "k", Value: String("w")}}},
+			want:     []v1alpha1.FieldDrift{{Path: "Tags[0].Value", Desired: `"v"`, Observed: `"w"`}},
+		},
+		"UnexpectedSliceElement": {
+			reason:   "Unexpected slice elements should have no desired value.",
+			desired:  params{Tags: []tag{{Key: "k"}}},
+			observed: params{Tags: []tag{{Key: "k"}, {Key: "l"}}},
+			want:     []v1alpha1.FieldDrift{{Path: "Tags[1]", Observed: `{"Key":"l","Value":null}`}},
+		},
+		"MapElement": {
+			reason:   "Drifted map elements should be returned with their key.",
+			desired:  params{Labels: map[string]string{"k": "v"}},
+			observed: params{Labels: map[string]string{"k": "w"}},
+			want:     []v1alpha1.FieldDrift{{Path: "Labels[k]", Desired: `"v"`, Observed: `"w"`}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Drift(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nDrift(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDriftAt(t *testing.T) {
+	want := []v1alpha1.FieldDrift{
+		{Path: "Tags[0]", Desired: `"a"`, Observed: `"b"`},
+	}
+	if diff := cmp.Diff(want, DriftAt("Tags", []string{"a"}, []string{"b"})); diff != "" {
+		t.Errorf("DriftAt(...): -want, +got:\n%s", diff)
+	}
+	want = []v1alpha1.FieldDrift{{Path: "Name", Desired: `"a"`, Observed: `"b"`}}
+	if diff := cmp.Diff(want, DriftAt("Name", "a", "b")); diff != "" {
+		t.Errorf("DriftAt(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestElementsDrift(t *testing.T) {
+	want := []v1alpha1.FieldDrift{
+		{Path: "ARNs", Desired: `"a"`},
+		{Path: "ARNs", Observed: `"b"`},
+		{Path: "ARNs", Observed: `"c"`},
+	}
+	if diff := cmp.Diff(want, ElementsDrift("ARNs", []*string{String("a")}, []string{"b", "c"})); diff != "" {
+		t.Errorf("ElementsDrift(...): -want, +got:\n%s", diff)
+	}
+	if got := ElementsDrift("ARNs", nil, nil); got != nil {
+		t.Errorf("ElementsDrift(...): want no drift, got %v", got)
+	}
+}
+
+func TestFormatDrift(t *testing.T) {
+	drift := []v1alpha1.FieldDrift{
+		{Path: "Name", Desired: `"a"`, Observed: `"b"`},
+		{Path: "Tags[0]", Observed: `"c"`},
+		{Path: "Engine"},
+	}
+	want := "Name: desired \"a\", observed \"b\"\nTags[0]: desired none, observed \"c\"\nEngine"
+	if got := FormatDrift(drift); got != want {
+		t.Errorf("FormatDrift(...): want %q, got %q", want, got)
+	}
+}
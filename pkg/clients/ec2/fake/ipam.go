@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.IPAMClient = (*MockIPAMClient)(nil)
+
+// MockIPAMClient is a type that implements all the methods for IPAMClient interface
+type MockIPAMClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateIpamInput, opts []func(*ec2.Options)) (*ec2.CreateIpamOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeIpamsInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyIpamInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteIpamInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateIpam mocks CreateIpam method
+func (m *MockIPAMClient) CreateIpam(ctx context.Context, input *ec2.CreateIpamInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeIpams mocks DescribeIpams method
+func (m *MockIPAMClient) DescribeIpams(ctx context.Context, input *ec2.DescribeIpamsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyIpam mocks ModifyIpam method
+func (m *MockIPAMClient) ModifyIpam(ctx context.Context, input *ec2.ModifyIpamInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteIpam mocks DeleteIpam method
+func (m *MockIPAMClient) DeleteIpam(ctx context.Context, input *ec2.DeleteIpamInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockIPAMClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockIPAMClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
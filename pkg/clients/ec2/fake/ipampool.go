@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.IPAMPoolClient = (*MockIPAMPoolClient)(nil)
+
+// MockIPAMPoolClient is a type that implements all the methods for IPAMPoolClient interface
+type MockIPAMPoolClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateIpamPoolInput, opts []func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateIpamPool mocks CreateIpamPool method
+func (m *MockIPAMPoolClient) CreateIpamPool(ctx context.Context, input *ec2.CreateIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeIpamPools mocks DescribeIpamPools method
+func (m *MockIPAMPoolClient) DescribeIpamPools(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyIpamPool mocks ModifyIpamPool method
+func (m *MockIPAMPoolClient) ModifyIpamPool(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteIpamPool mocks DeleteIpamPool method
+func (m *MockIPAMPoolClient) DeleteIpamPool(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockIPAMPoolClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockIPAMPoolClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.IPAMPoolCIDRClient = (*MockIPAMPoolCIDRClient)(nil)
+
+// MockIPAMPoolCIDRClient is a type that implements all the methods for IPAMPoolCIDRClient interface
+type MockIPAMPoolCIDRClient struct {
+	MockProvision   func(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts []func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error)
+	MockGetCIDRs    func(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts []func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error)
+	MockDeprovision func(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts []func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error)
+}
+
+// ProvisionIpamPoolCidr mocks ProvisionIpamPoolCidr method
+func (m *MockIPAMPoolCIDRClient) ProvisionIpamPoolCidr(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error) {
+	return m.MockProvision(ctx, input, opts)
+}
+
+// GetIpamPoolCidrs mocks GetIpamPoolCidrs method
+func (m *MockIPAMPoolCIDRClient) GetIpamPoolCidrs(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error) {
+	return m.MockGetCIDRs(ctx, input, opts)
+}
+
+// DeprovisionIpamPoolCidr mocks DeprovisionIpamPoolCidr method
+func (m *MockIPAMPoolCIDRClient) DeprovisionIpamPoolCidr(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error) {
+	return m.MockDeprovision(ctx, input, opts)
+}
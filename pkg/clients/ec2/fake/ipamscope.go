@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.IPAMScopeClient = (*MockIPAMScopeClient)(nil)
+
+// MockIPAMScopeClient is a type that implements all the methods for IPAMScopeClient interface
+type MockIPAMScopeClient struct {
+	MockCreate     func(ctx context.Context, input *ec2.CreateIpamScopeInput, opts []func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error)
+	MockDescribe   func(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts []func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error)
+	MockModify     func(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts []func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error)
+	MockDelete     func(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts []func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error)
+	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// CreateIpamScope mocks CreateIpamScope method
+func (m *MockIPAMScopeClient) CreateIpamScope(ctx context.Context, input *ec2.CreateIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error) {
+	return m.MockCreate(ctx, input, opts)
+}
+
+// DescribeIpamScopes mocks DescribeIpamScopes method
+func (m *MockIPAMScopeClient) DescribeIpamScopes(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error) {
+	return m.MockDescribe(ctx, input, opts)
+}
+
+// ModifyIpamScope mocks ModifyIpamScope method
+func (m *MockIPAMScopeClient) ModifyIpamScope(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error) {
+	return m.MockModify(ctx, input, opts)
+}
+
+// DeleteIpamScope mocks DeleteIpamScope method
+func (m *MockIPAMScopeClient) DeleteIpamScope(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error) {
+	return m.MockDelete(ctx, input, opts)
+}
+
+// CreateTags mocks CreateTags method
+func (m *MockIPAMScopeClient) CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error) {
+	return m.MockCreateTags(ctx, input, opts)
+}
+
+// DeleteTags mocks DeleteTags method
+func (m *MockIPAMScopeClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
+	return m.MockDeleteTags(ctx, input, opts)
+}
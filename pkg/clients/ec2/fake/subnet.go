@@ -35,6 +35,10 @@ type MockSubnetClient struct {
 	MockModify     func(ctx context.Context, input *ec2.ModifySubnetAttributeInput, opts []func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
 	MockCreateTags func(ctx context.Context, input *ec2.CreateTagsInput, opts []func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 	MockDeleteTags func(ctx context.Context, input *ec2.DeleteTagsInput, opts []func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+
+	MockAllocateIpamPoolCidr      func(ctx context.Context, input *ec2.AllocateIpamPoolCidrInput, opts []func(*ec2.Options)) (*ec2.AllocateIpamPoolCidrOutput, error)
+	MockGetIpamPoolAllocations    func(ctx context.Context, input *ec2.GetIpamPoolAllocationsInput, opts []func(*ec2.Options)) (*ec2.GetIpamPoolAllocationsOutput, error)
+	MockReleaseIpamPoolAllocation func(ctx context.Context, input *ec2.ReleaseIpamPoolAllocationInput, opts []func(*ec2.Options)) (*ec2.ReleaseIpamPoolAllocationOutput, error)
 }
 
 // CreateSubnet mocks CreateSubnet method
@@ -66,3 +70,18 @@ func (m *MockSubnetClient) CreateTags(ctx context.Context, input *ec2.CreateTags
 func (m *MockSubnetClient) DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error) {
 	return m.MockDeleteTags(ctx, input, opts)
 }
+
+// AllocateIpamPoolCidr mocks AllocateIpamPoolCidr method
+func (m *MockSubnetClient) AllocateIpamPoolCidr(ctx context.Context, input *ec2.AllocateIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.AllocateIpamPoolCidrOutput, error) {
+	return m.MockAllocateIpamPoolCidr(ctx, input, opts)
+}
+
+// GetIpamPoolAllocations mocks GetIpamPoolAllocations method
+func (m *MockSubnetClient) GetIpamPoolAllocations(ctx context.Context, input *ec2.GetIpamPoolAllocationsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolAllocationsOutput, error) {
+	return m.MockGetIpamPoolAllocations(ctx, input, opts)
+}
+
+// ReleaseIpamPoolAllocation mocks ReleaseIpamPoolAllocation method
+func (m *MockSubnetClient) ReleaseIpamPoolAllocation(ctx context.Context, input *ec2.ReleaseIpamPoolAllocationInput, opts ...func(*ec2.Options)) (*ec2.ReleaseIpamPoolAllocationOutput, error) {
+	return m.MockReleaseIpamPoolAllocation(ctx, input, opts)
+}
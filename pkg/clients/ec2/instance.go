@@ -43,6 +43,8 @@ type InstanceClient interface {
 	DescribeInstanceAttribute(context.Context, *ec2.DescribeInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.DescribeInstanceAttributeOutput, error)
 	ModifyInstanceAttribute(context.Context, *ec2.ModifyInstanceAttributeInput, ...func(*ec2.Options)) (*ec2.ModifyInstanceAttributeOutput, error)
 	CreateTags(context.Context, *ec2.CreateTagsInput, ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	StartInstances(context.Context, *ec2.StartInstancesInput, ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error)
+	StopInstances(context.Context, *ec2.StopInstancesInput, ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error)
 }
 
 // NewInstanceClient returns a new client using AWS credentials as JSON encoded data.
@@ -79,9 +81,47 @@ func IsInstanceUpToDate(spec manualv1alpha1.InstanceParameters, instance types.I
 	if awsclients.StringValue(spec.UserData) != attributeValue(attributes.UserData) {
 		return false
 	}
+	// InstanceType
+	if spec.InstanceType != "" && spec.InstanceType != attributeValue(attributes.InstanceType) {
+		return false
+	}
+	// EBSOptimized
+	if spec.EBSOptimized != nil && awsclients.BoolValue(spec.EBSOptimized) != awsclients.BoolValue(instance.EbsOptimized) {
+		return false
+	}
+	// DesiredState
+	if instance.State != nil && !IsInstancePowerStateUpToDate(spec.DesiredState, string(instance.State.Name)) {
+		return false
+	}
 	return manualv1alpha1.CompareGroupIDs(spec.SecurityGroupIDs, instance.SecurityGroups)
 }
 
+// IsInstanceStateTransitional returns true if the instance is in a state
+// from which it moves on without further calls, i.e. it is starting,
+// stopping or shutting down.
+func IsInstanceStateTransitional(state string) bool {
+	switch types.InstanceStateName(state) {
+	case types.InstanceStateNamePending, types.InstanceStateNameStopping, types.InstanceStateNameShuttingDown:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsInstancePowerStateUpToDate returns true if the given instance state
+// matches or is moving towards the desired power state. A hibernated
+// instance is reported as stopped by EC2.
+func IsInstancePowerStateUpToDate(desired *string, state string) bool {
+	switch awsclients.StringValue(desired) {
+	case manualv1alpha1.InstanceDesiredStateRunning:
+		return state == string(types.InstanceStateNameRunning) || state == string(types.InstanceStateNamePending)
+	case manualv1alpha1.InstanceDesiredStateStopped, manualv1alpha1.InstanceDesiredStateHibernated:
+		return state == string(types.InstanceStateNameStopped) || state == string(types.InstanceStateNameStopping)
+	default:
+		return true
+	}
+}
+
 // GenerateInstanceObservation is used to produce manualv1alpha1.InstanceObservation from
 // a []ec2.Instance.
 func GenerateInstanceObservation(i types.Instance) manualv1alpha1.InstanceObservation {
@@ -147,9 +187,9 @@ func GenerateInstanceCondition(o manualv1alpha1.InstanceObservation) Condition {
 	case string(types.InstanceStateNameShuttingDown):
 		return Deleting
 	case string(types.InstanceStateNameStopped):
-		return Deleting
+		return Unavailable
 	case string(types.InstanceStateNameStopping):
-		return Deleting
+		return Unavailable
 	case string(types.InstanceStateNameTerminated):
 		return Deleted
 	default:
@@ -167,6 +207,9 @@ const (
 	// Creating is the condition that represents some instances could be
 	// running, but the rest are pending
 	Creating Condition = "creating"
+	// Unavailable is the condition that represents an instance that is
+	// stopped or stopping
+	Unavailable Condition = "unavailable"
 	// Deleting is the condition that represents some instances have entered
 	// the shutdown/termination state
 	Deleting Condition = "deleting"
@@ -212,6 +255,15 @@ func LateInitializeInstance(in *manualv1alpha1.InstanceParameters, instance *typ
 	if in.SubnetID == nil || *in.SubnetID == "" && instance.SubnetId != nil {
 		in.SubnetID = instance.SubnetId
 	}
+
+	if in.DesiredState == nil && instance.State != nil {
+		switch instance.State.Name { //nolint:exhaustive
+		case types.InstanceStateNameRunning, types.InstanceStateNamePending:
+			in.DesiredState = awsclients.String(manualv1alpha1.InstanceDesiredStateRunning)
+		case types.InstanceStateNameStopped, types.InstanceStateNameStopping:
+			in.DesiredState = awsclients.String(manualv1alpha1.InstanceDesiredStateStopped)
+		}
+	}
 }
 
 // GenerateEC2BlockDeviceMappings coverts an internal slice of BlockDeviceMapping into a slice of ec2.BlockDeviceMapping
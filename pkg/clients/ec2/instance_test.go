@@ -116,7 +116,15 @@ func TestGenerateInstanceConditions(t *testing.T) {
 					State: string(types.InstanceStateNameStopping),
 				},
 			},
-			want: Deleting,
+			want: Unavailable,
+		},
+		"InstanceIsStopped": {
+			args: args{
+				obeserved: manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				},
+			},
+			want: Unavailable,
 		},
 		"InstanceIsShuttingDown": {
 			args: args{
@@ -147,6 +155,75 @@ func TestGenerateInstanceConditions(t *testing.T) {
 	}
 }
 
+func TestIsInstancePowerStateUpToDate(t *testing.T) {
+	type args struct {
+		desired *string
+		state   types.InstanceStateName
+	}
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotManaged": {
+			args: args{
+				state: types.InstanceStateNameStopped,
+			},
+			want: true,
+		},
+		"Running": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				state:   types.InstanceStateNameRunning,
+			},
+			want: true,
+		},
+		"Starting": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				state:   types.InstanceStateNamePending,
+			},
+			want: true,
+		},
+		"StoppedButShouldRun": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				state:   types.InstanceStateNameStopped,
+			},
+			want: false,
+		},
+		"RunningButShouldStop": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				state:   types.InstanceStateNameRunning,
+			},
+			want: false,
+		},
+		"Stopping": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateStopped),
+				state:   types.InstanceStateNameStopping,
+			},
+			want: true,
+		},
+		"Hibernated": {
+			args: args{
+				desired: aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+				state:   types.InstanceStateNameStopped,
+			},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsInstancePowerStateUpToDate(tc.args.desired, string(tc.args.state))
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateDescribeInstancesByExternalTags(t *testing.T) {
 	type args struct {
 		extTags map[string]string
@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// IPAMIDNotFound is the code that is returned by ec2 when the given IPAM ID is not valid
+	IPAMIDNotFound = "InvalidIpamId.NotFound"
+)
+
+// IPAMClient is the external client used for IPAM Custom Resource
+type IPAMClient interface {
+	CreateIpam(ctx context.Context, input *ec2.CreateIpamInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamOutput, error)
+	DescribeIpams(ctx context.Context, input *ec2.DescribeIpamsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamsOutput, error)
+	ModifyIpam(ctx context.Context, input *ec2.ModifyIpamInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamOutput, error)
+	DeleteIpam(ctx context.Context, input *ec2.DeleteIpamInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMClient(cfg aws.Config) IPAMClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMNotFoundErr returns true if the error is because the item doesn't exist
+func IsIPAMNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && strings.EqualFold(awsErr.ErrorCode(), IPAMIDNotFound)
+}
+
+// IsIPAMStateInProgress returns true if an IPAM, IPAM scope or IPAM pool in
+// the given state is being created, modified or deleted, and cannot be
+// modified.
+func IsIPAMStateInProgress(state string) bool {
+	return strings.HasSuffix(state, "-in-progress")
+}
+
+// IPAMOperatingRegions returns the operating regions of an IPAM. The home
+// region of the IPAM is always one of them.
+func IPAMOperatingRegions(p networkv1alpha1.IPAMParameters) []string {
+	regions := map[string]struct{}{aws.ToString(p.Region): {}}
+	for _, r := range p.OperatingRegions {
+		regions[r] = struct{}{}
+	}
+	res := make([]string, 0, len(regions))
+	for r := range regions {
+		res = append(res, r)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// GenerateCreateIPAMInput returns the input to create the given IPAM.
+func GenerateCreateIPAMInput(clientToken string, p networkv1alpha1.IPAMParameters) *ec2.CreateIpamInput {
+	in := &ec2.CreateIpamInput{
+		ClientToken: aws.String(clientToken),
+		Description: p.Description,
+	}
+	for _, r := range IPAMOperatingRegions(p) {
+		in.OperatingRegions = append(in.OperatingRegions, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpam,
+			Tags:         networkv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateIPAMObservation is used to produce networkv1alpha1.IPAMObservation
+// from ec2types.Ipam.
+func GenerateIPAMObservation(ipam ec2types.Ipam) networkv1alpha1.IPAMObservation {
+	return networkv1alpha1.IPAMObservation{
+		IPAMID:                aws.ToString(ipam.IpamId),
+		IPAMARN:               aws.ToString(ipam.IpamArn),
+		OwnerID:               aws.ToString(ipam.OwnerId),
+		PrivateDefaultScopeID: aws.ToString(ipam.PrivateDefaultScopeId),
+		PublicDefaultScopeID:  aws.ToString(ipam.PublicDefaultScopeId),
+		ScopeCount:            aws.ToInt32(ipam.ScopeCount),
+		State:                 string(ipam.State),
+	}
+}
+
+// LateInitializeIPAM fills the empty fields in *networkv1alpha1.IPAMParameters
+// with the values seen in ec2types.Ipam.
+func LateInitializeIPAM(in *networkv1alpha1.IPAMParameters, ipam ec2types.Ipam) {
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, ipam.Description)
+	if len(in.Tags) == 0 && len(ipam.Tags) != 0 {
+		in.Tags = networkv1alpha1.BuildFromEC2Tags(ipam.Tags)
+	}
+}
+
+// DiffIPAMOperatingRegions returns the operating regions to add to and to
+// remove from the observed IPAM.
+func DiffIPAMOperatingRegions(p networkv1alpha1.IPAMParameters, ipam ec2types.Ipam) (add []ec2types.AddIpamOperatingRegion, remove []ec2types.RemoveIpamOperatingRegion) {
+	want := IPAMOperatingRegions(p)
+	have := make(map[string]struct{}, len(ipam.OperatingRegions))
+	for _, r := range ipam.OperatingRegions {
+		have[aws.ToString(r.RegionName)] = struct{}{}
+	}
+	for _, r := range want {
+		if _, ok := have[r]; !ok {
+			add = append(add, ec2types.AddIpamOperatingRegion{RegionName: aws.String(r)})
+		}
+		delete(have, r)
+	}
+	for r := range have {
+		remove = append(remove, ec2types.RemoveIpamOperatingRegion{RegionName: aws.String(r)})
+	}
+	sort.Slice(remove, func(i, j int) bool {
+		return aws.ToString(remove[i].RegionName) < aws.ToString(remove[j].RegionName)
+	})
+	return add, remove
+}
+
+// IsIPAMUpToDate checks whether the observed IPAM matches the desired state.
+func IsIPAMUpToDate(p networkv1alpha1.IPAMParameters, ipam ec2types.Ipam) bool {
+	if aws.ToString(p.Description) != aws.ToString(ipam.Description) {
+		return false
+	}
+	if !networkv1alpha1.CompareTags(p.Tags, ipam.Tags) {
+		return false
+	}
+	add, remove := DiffIPAMOperatingRegions(p, ipam)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+)
+
+func TestDiffIPAMOperatingRegions(t *testing.T) {
+	type want struct {
+		add    []types.AddIpamOperatingRegion
+		remove []types.RemoveIpamOperatingRegion
+	}
+
+	cases := map[string]struct {
+		p    networkv1alpha1.IPAMParameters
+		ipam types.Ipam
+		want want
+	}{
+		"HomeRegionOnly": {
+			p: networkv1alpha1.IPAMParameters{Region: aws.String("us-east-1")},
+			ipam: types.Ipam{OperatingRegions: []types.IpamOperatingRegion{
+				{RegionName: aws.String("us-east-1")},
+			}},
+		},
+		"HomeRegionAdded": {
+			p: networkv1alpha1.IPAMParameters{
+				Region:           aws.String("us-east-1"),
+				OperatingRegions: []string{"eu-west-1"},
+			},
+			want: want{
+				add: []types.AddIpamOperatingRegion{
+					{RegionName: aws.String("eu-west-1")},
+					{RegionName: aws.String("us-east-1")},
+				},
+			},
+		},
+		"AddAndRemove": {
+			p: networkv1alpha1.IPAMParameters{
+				Region:           aws.String("us-east-1"),
+				OperatingRegions: []string{"us-east-1", "eu-west-1"},
+			},
+			ipam: types.Ipam{OperatingRegions: []types.IpamOperatingRegion{
+				{RegionName: aws.String("us-east-1")},
+				{RegionName: aws.String("us-west-2")},
+				{RegionName: aws.String("ap-south-1")},
+			}},
+			want: want{
+				add: []types.AddIpamOperatingRegion{{RegionName: aws.String("eu-west-1")}},
+				remove: []types.RemoveIpamOperatingRegion{
+					{RegionName: aws.String("ap-south-1")},
+					{RegionName: aws.String("us-west-2")},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffIPAMOperatingRegions(tc.p, tc.ipam)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(types.AddIpamOperatingRegion{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove, cmpopts.IgnoreUnexported(types.RemoveIpamOperatingRegion{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerateModifyIPAMPoolInput(t *testing.T) {
+	poolID := "ipam-pool-1"
+
+	cases := map[string]struct {
+		p    networkv1alpha1.IPAMPoolParameters
+		pool types.IpamPool
+		want *ec2.ModifyIpamPoolInput
+	}{
+		"UpToDate": {
+			p: networkv1alpha1.IPAMPoolParameters{
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+			},
+			pool: types.IpamPool{
+				Description:                    aws.String("pool"),
+				AllocationDefaultNetmaskLength: aws.Int32(24),
+				AllocationMaxNetmaskLength:     aws.Int32(28),
+			},
+		},
+		"Changed": {
+			p: networkv1alpha1.IPAMPoolParameters{
+				Description:                aws.String("new"),
+				AutoImport:                 aws.Bool(true),
+				AllocationMinNetmaskLength: aws.Int32(16),
+			},
+			pool: types.IpamPool{
+				Description:                aws.String("old"),
+				AllocationMinNetmaskLength: aws.Int32(20),
+			},
+			want: &ec2.ModifyIpamPoolInput{
+				IpamPoolId:                 aws.String(poolID),
+				Description:                aws.String("new"),
+				AutoImport:                 aws.Bool(true),
+				AllocationMinNetmaskLength: aws.Int32(16),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyIPAMPoolInput(poolID, tc.p, tc.pool)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(ec2.ModifyIpamPoolInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// IPAMPoolIDNotFound is the code that is returned by ec2 when the given IPAM pool ID is not valid
+	IPAMPoolIDNotFound = "InvalidIpamPoolId.NotFound"
+)
+
+// IPAMPoolClient is the external client used for IPAMPool Custom Resource
+type IPAMPoolClient interface {
+	CreateIpamPool(ctx context.Context, input *ec2.CreateIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamPoolOutput, error)
+	DescribeIpamPools(ctx context.Context, input *ec2.DescribeIpamPoolsInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamPoolsOutput, error)
+	ModifyIpamPool(ctx context.Context, input *ec2.ModifyIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamPoolOutput, error)
+	DeleteIpamPool(ctx context.Context, input *ec2.DeleteIpamPoolInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamPoolOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMPoolClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMPoolClient(cfg aws.Config) IPAMPoolClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMPoolNotFoundErr returns true if the error is because the item doesn't exist
+func IsIPAMPoolNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && strings.EqualFold(awsErr.ErrorCode(), IPAMPoolIDNotFound)
+}
+
+// GenerateCreateIPAMPoolInput returns the input to create the given IPAM pool.
+func GenerateCreateIPAMPoolInput(clientToken string, p networkv1alpha1.IPAMPoolParameters) *ec2.CreateIpamPoolInput {
+	in := &ec2.CreateIpamPoolInput{
+		ClientToken:                    aws.String(clientToken),
+		IpamScopeId:                    p.IPAMScopeID,
+		SourceIpamPoolId:               p.SourceIPAMPoolID,
+		AddressFamily:                  ec2types.AddressFamily(p.AddressFamily),
+		Locale:                         p.Locale,
+		Description:                    p.Description,
+		AutoImport:                     p.AutoImport,
+		PubliclyAdvertisable:           p.PubliclyAdvertisable,
+		AllocationDefaultNetmaskLength: p.AllocationDefaultNetmaskLength,
+		AllocationMinNetmaskLength:     p.AllocationMinNetmaskLength,
+		AllocationMaxNetmaskLength:     p.AllocationMaxNetmaskLength,
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpamPool,
+			Tags:         networkv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateIPAMPoolObservation is used to produce
+// networkv1alpha1.IPAMPoolObservation from ec2types.IpamPool.
+func GenerateIPAMPoolObservation(pool ec2types.IpamPool) networkv1alpha1.IPAMPoolObservation {
+	return networkv1alpha1.IPAMPoolObservation{
+		IPAMPoolID:    aws.ToString(pool.IpamPoolId),
+		IPAMPoolARN:   aws.ToString(pool.IpamPoolArn),
+		IPAMARN:       aws.ToString(pool.IpamArn),
+		IPAMScopeARN:  aws.ToString(pool.IpamScopeArn),
+		IPAMScopeType: string(pool.IpamScopeType),
+		PoolDepth:     aws.ToInt32(pool.PoolDepth),
+		OwnerID:       aws.ToString(pool.OwnerId),
+		State:         string(pool.State),
+		StateMessage:  aws.ToString(pool.StateMessage),
+	}
+}
+
+// LateInitializeIPAMPool fills the empty fields in
+// *networkv1alpha1.IPAMPoolParameters with the values seen in
+// ec2types.IpamPool.
+func LateInitializeIPAMPool(in *networkv1alpha1.IPAMPoolParameters, pool ec2types.IpamPool) {
+	in.Locale = awsclients.LateInitializeStringPtr(in.Locale, pool.Locale)
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, pool.Description)
+	in.AutoImport = awsclients.LateInitializeBoolPtr(in.AutoImport, pool.AutoImport)
+	in.PubliclyAdvertisable = awsclients.LateInitializeBoolPtr(in.PubliclyAdvertisable, pool.PubliclyAdvertisable)
+	in.AllocationDefaultNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationDefaultNetmaskLength, pool.AllocationDefaultNetmaskLength)
+	in.AllocationMinNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationMinNetmaskLength, pool.AllocationMinNetmaskLength)
+	in.AllocationMaxNetmaskLength = awsclients.LateInitializeInt32Ptr(in.AllocationMaxNetmaskLength, pool.AllocationMaxNetmaskLength)
+	if len(in.Tags) == 0 && len(pool.Tags) != 0 {
+		in.Tags = networkv1alpha1.BuildFromEC2Tags(pool.Tags)
+	}
+}
+
+// GenerateModifyIPAMPoolInput returns the input to bring the observed IPAM
+// pool to the desired state. It returns nil if no modification is needed.
+func GenerateModifyIPAMPoolInput(id string, p networkv1alpha1.IPAMPoolParameters, pool ec2types.IpamPool) *ec2.ModifyIpamPoolInput {
+	in := &ec2.ModifyIpamPoolInput{IpamPoolId: aws.String(id)}
+	changed := false
+	if aws.ToString(p.Description) != aws.ToString(pool.Description) {
+		in.Description = aws.String(aws.ToString(p.Description))
+		changed = true
+	}
+	if p.AutoImport != nil && aws.ToBool(p.AutoImport) != aws.ToBool(pool.AutoImport) {
+		in.AutoImport = p.AutoImport
+		changed = true
+	}
+	if p.AllocationDefaultNetmaskLength != nil && aws.ToInt32(p.AllocationDefaultNetmaskLength) != aws.ToInt32(pool.AllocationDefaultNetmaskLength) {
+		in.AllocationDefaultNetmaskLength = p.AllocationDefaultNetmaskLength
+		changed = true
+	}
+	if p.AllocationMinNetmaskLength != nil && aws.ToInt32(p.AllocationMinNetmaskLength) != aws.ToInt32(pool.AllocationMinNetmaskLength) {
+		in.AllocationMinNetmaskLength = p.AllocationMinNetmaskLength
+		changed = true
+	}
+	if p.AllocationMaxNetmaskLength != nil && aws.ToInt32(p.AllocationMaxNetmaskLength) != aws.ToInt32(pool.AllocationMaxNetmaskLength) {
+		in.AllocationMaxNetmaskLength = p.AllocationMaxNetmaskLength
+		changed = true
+	}
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// IsIPAMPoolUpToDate checks whether the observed IPAM pool matches the
+// desired state.
+func IsIPAMPoolUpToDate(p networkv1alpha1.IPAMPoolParameters, pool ec2types.IpamPool) bool {
+	return GenerateModifyIPAMPoolInput("", p, pool) == nil &&
+		networkv1alpha1.CompareTags(p.Tags, pool.Tags)
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+)
+
+// IPAMPoolCIDRClient is the external client used for IPAMPoolCIDR Custom Resource
+type IPAMPoolCIDRClient interface {
+	ProvisionIpamPoolCidr(ctx context.Context, input *ec2.ProvisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.ProvisionIpamPoolCidrOutput, error)
+	GetIpamPoolCidrs(ctx context.Context, input *ec2.GetIpamPoolCidrsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolCidrsOutput, error)
+	DeprovisionIpamPoolCidr(ctx context.Context, input *ec2.DeprovisionIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.DeprovisionIpamPoolCidrOutput, error)
+}
+
+// NewIPAMPoolCIDRClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMPoolCIDRClient(cfg aws.Config) IPAMPoolCIDRClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// GenerateIPAMPoolCIDRObservation is used to produce
+// networkv1alpha1.IPAMPoolCIDRObservation from ec2types.IpamPoolCidr.
+func GenerateIPAMPoolCIDRObservation(c ec2types.IpamPoolCidr) networkv1alpha1.IPAMPoolCIDRObservation {
+	o := networkv1alpha1.IPAMPoolCIDRObservation{
+		State: string(c.State),
+	}
+	if c.FailureReason != nil {
+		o.FailureMessage = aws.ToString(c.FailureReason.Message)
+	}
+	return o
+}
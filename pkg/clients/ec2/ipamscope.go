@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+const (
+	// IPAMScopeIDNotFound is the code that is returned by ec2 when the given IPAM scope ID is not valid
+	IPAMScopeIDNotFound = "InvalidIpamScopeId.NotFound"
+)
+
+// IPAMScopeClient is the external client used for IPAMScope Custom Resource
+type IPAMScopeClient interface {
+	CreateIpamScope(ctx context.Context, input *ec2.CreateIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.CreateIpamScopeOutput, error)
+	DescribeIpamScopes(ctx context.Context, input *ec2.DescribeIpamScopesInput, opts ...func(*ec2.Options)) (*ec2.DescribeIpamScopesOutput, error)
+	ModifyIpamScope(ctx context.Context, input *ec2.ModifyIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.ModifyIpamScopeOutput, error)
+	DeleteIpamScope(ctx context.Context, input *ec2.DeleteIpamScopeInput, opts ...func(*ec2.Options)) (*ec2.DeleteIpamScopeOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewIPAMScopeClient returns a new client using AWS credentials as JSON encoded data.
+func NewIPAMScopeClient(cfg aws.Config) IPAMScopeClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsIPAMScopeNotFoundErr returns true if the error is because the item doesn't exist
+func IsIPAMScopeNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && strings.EqualFold(awsErr.ErrorCode(), IPAMScopeIDNotFound)
+}
+
+// GenerateCreateIPAMScopeInput returns the input to create the given IPAM
+// scope.
+func GenerateCreateIPAMScopeInput(clientToken string, p networkv1alpha1.IPAMScopeParameters) *ec2.CreateIpamScopeInput {
+	in := &ec2.CreateIpamScopeInput{
+		ClientToken: aws.String(clientToken),
+		IpamId:      p.IPAMID,
+		Description: p.Description,
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypeIpamScope,
+			Tags:         networkv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateIPAMScopeObservation is used to produce
+// networkv1alpha1.IPAMScopeObservation from ec2types.IpamScope.
+func GenerateIPAMScopeObservation(scope ec2types.IpamScope) networkv1alpha1.IPAMScopeObservation {
+	return networkv1alpha1.IPAMScopeObservation{
+		IPAMScopeID:   aws.ToString(scope.IpamScopeId),
+		IPAMScopeARN:  aws.ToString(scope.IpamScopeArn),
+		IPAMARN:       aws.ToString(scope.IpamArn),
+		IPAMScopeType: string(scope.IpamScopeType),
+		IsDefault:     aws.ToBool(scope.IsDefault),
+		OwnerID:       aws.ToString(scope.OwnerId),
+		PoolCount:     aws.ToInt32(scope.PoolCount),
+		State:         string(scope.State),
+	}
+}
+
+// LateInitializeIPAMScope fills the empty fields in
+// *networkv1alpha1.IPAMScopeParameters with the values seen in
+// ec2types.IpamScope.
+func LateInitializeIPAMScope(in *networkv1alpha1.IPAMScopeParameters, scope ec2types.IpamScope) {
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, scope.Description)
+	if len(in.Tags) == 0 && len(scope.Tags) != 0 {
+		in.Tags = networkv1alpha1.BuildFromEC2Tags(scope.Tags)
+	}
+}
+
+// IsIPAMScopeUpToDate checks whether the observed IPAM scope matches the
+// desired state.
+func IsIPAMScopeUpToDate(p networkv1alpha1.IPAMScopeParameters, scope ec2types.IpamScope) bool {
+	return aws.ToString(p.Description) == aws.ToString(scope.Description) &&
+		networkv1alpha1.CompareTags(p.Tags, scope.Tags)
+}
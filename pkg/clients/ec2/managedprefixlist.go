@@ -0,0 +1,140 @@
+package ec2
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+)
+
+const (
+	// PrefixListIDNotFound is the code that is returned by ec2 when the given PrefixListID is not valid
+	PrefixListIDNotFound = "InvalidPrefixListID.NotFound"
+)
+
+// ManagedPrefixListClient is the external client used for ManagedPrefixList Custom Resource
+type ManagedPrefixListClient interface {
+	CreateManagedPrefixList(ctx context.Context, input *ec2.CreateManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.CreateManagedPrefixListOutput, error)
+	DescribeManagedPrefixLists(ctx context.Context, input *ec2.DescribeManagedPrefixListsInput, opts ...func(*ec2.Options)) (*ec2.DescribeManagedPrefixListsOutput, error)
+	GetManagedPrefixListEntries(ctx context.Context, input *ec2.GetManagedPrefixListEntriesInput, opts ...func(*ec2.Options)) (*ec2.GetManagedPrefixListEntriesOutput, error)
+	ModifyManagedPrefixList(ctx context.Context, input *ec2.ModifyManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.ModifyManagedPrefixListOutput, error)
+	DeleteManagedPrefixList(ctx context.Context, input *ec2.DeleteManagedPrefixListInput, opts ...func(*ec2.Options)) (*ec2.DeleteManagedPrefixListOutput, error)
+	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
+	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+}
+
+// NewManagedPrefixListClient returns a new client using AWS credentials as JSON encoded data.
+func NewManagedPrefixListClient(cfg aws.Config) ManagedPrefixListClient {
+	return ec2.NewFromConfig(cfg)
+}
+
+// IsManagedPrefixListNotFoundErr returns true if the error is because the item doesn't exist
+func IsManagedPrefixListNotFoundErr(err error) bool {
+	var awsErr smithy.APIError
+	return errors.As(err, &awsErr) && strings.EqualFold(awsErr.ErrorCode(), PrefixListIDNotFound)
+}
+
+// IsManagedPrefixListInProgress returns true if the prefix list is being
+// created, modified, restored or deleted, and cannot be modified.
+func IsManagedPrefixListInProgress(state ec2types.PrefixListState) bool {
+	return strings.HasSuffix(string(state), "-in-progress")
+}
+
+// GenerateCreateManagedPrefixListInput returns the input to create the given
+// prefix list.
+func GenerateCreateManagedPrefixListInput(clientToken string, p networkv1alpha1.ManagedPrefixListParameters) *ec2.CreateManagedPrefixListInput {
+	in := &ec2.CreateManagedPrefixListInput{
+		ClientToken:    aws.String(clientToken),
+		PrefixListName: aws.String(p.PrefixListName),
+		AddressFamily:  aws.String(p.AddressFamily),
+		MaxEntries:     aws.Int32(p.MaxEntries),
+	}
+	for _, e := range p.Entries {
+		in.Entries = append(in.Entries, ec2types.AddPrefixListEntry{
+			Cidr:        aws.String(e.CIDR),
+			Description: e.Description,
+		})
+	}
+	if len(p.Tags) > 0 {
+		in.TagSpecifications = []ec2types.TagSpecification{{
+			ResourceType: ec2types.ResourceTypePrefixList,
+			Tags:         networkv1alpha1.GenerateEC2Tags(p.Tags),
+		}}
+	}
+	return in
+}
+
+// GenerateManagedPrefixListObservation is used to produce
+// networkv1alpha1.ManagedPrefixListObservation from ec2types.ManagedPrefixList.
+func GenerateManagedPrefixListObservation(pl ec2types.ManagedPrefixList) networkv1alpha1.ManagedPrefixListObservation {
+	return networkv1alpha1.ManagedPrefixListObservation{
+		PrefixListID:  aws.ToString(pl.PrefixListId),
+		PrefixListARN: aws.ToString(pl.PrefixListArn),
+		OwnerID:       aws.ToString(pl.OwnerId),
+		State:         string(pl.State),
+		StateMessage:  aws.ToString(pl.StateMessage),
+		Version:       aws.ToInt64(pl.Version),
+	}
+}
+
+// LateInitializeManagedPrefixList fills the empty fields in
+// *networkv1alpha1.ManagedPrefixListParameters with the values seen in
+// ec2types.ManagedPrefixList.
+func LateInitializeManagedPrefixList(in *networkv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList) {
+	if in.MaxEntries == 0 {
+		in.MaxEntries = aws.ToInt32(pl.MaxEntries)
+	}
+	if len(in.Tags) == 0 && len(pl.Tags) != 0 {
+		in.Tags = networkv1alpha1.BuildFromEC2Tags(pl.Tags)
+	}
+}
+
+// DiffPrefixListEntries returns the entries to add and to remove so that the
+// observed entries match the desired ones. An entry whose description changed
+// is added again with its new description.
+func DiffPrefixListEntries(want []networkv1alpha1.PrefixListEntry, have []ec2types.PrefixListEntry) (add []ec2types.AddPrefixListEntry, remove []ec2types.RemovePrefixListEntry) {
+	current := make(map[string]*string, len(have))
+	for _, e := range have {
+		current[aws.ToString(e.Cidr)] = e.Description
+	}
+	desired := make(map[string]struct{}, len(want))
+	for _, e := range want {
+		desired[e.CIDR] = struct{}{}
+		description, ok := current[e.CIDR]
+		if !ok || aws.ToString(description) != aws.ToString(e.Description) {
+			add = append(add, ec2types.AddPrefixListEntry{
+				Cidr:        aws.String(e.CIDR),
+				Description: e.Description,
+			})
+		}
+	}
+	for _, e := range have {
+		if _, ok := desired[aws.ToString(e.Cidr)]; !ok {
+			remove = append(remove, ec2types.RemovePrefixListEntry{Cidr: e.Cidr})
+		}
+	}
+	sort.Slice(remove, func(i, j int) bool {
+		return aws.ToString(remove[i].Cidr) < aws.ToString(remove[j].Cidr)
+	})
+	return add, remove
+}
+
+// IsManagedPrefixListUpToDate checks whether the observed prefix list and its
+// entries match the desired state.
+func IsManagedPrefixListUpToDate(p networkv1alpha1.ManagedPrefixListParameters, pl ec2types.ManagedPrefixList, entries []ec2types.PrefixListEntry) bool {
+	if p.PrefixListName != aws.ToString(pl.PrefixListName) || p.MaxEntries != aws.ToInt32(pl.MaxEntries) {
+		return false
+	}
+	if !networkv1alpha1.CompareTags(p.Tags, pl.Tags) {
+		return false
+	}
+	add, remove := DiffPrefixListEntries(p.Entries, entries)
+	return len(add) == 0 && len(remove) == 0
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ec2
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+)
+
+var (
+	plCIDR1 = "10.0.0.0/16"
+	plCIDR2 = "10.1.0.0/16"
+	plCIDR3 = "10.2.0.0/16"
+	plName  = "pl"
+)
+
+func TestDiffPrefixListEntries(t *testing.T) {
+	type want struct {
+		add    []types.AddPrefixListEntry
+		remove []types.RemovePrefixListEntry
+	}
+
+	cases := map[string]struct {
+		want []networkv1alpha1.PrefixListEntry
+		have []types.PrefixListEntry
+		out  want
+	}{
+		"Same": {
+			want: []networkv1alpha1.PrefixListEntry{{CIDR: plCIDR1, Description: aws.String("a")}},
+			have: []types.PrefixListEntry{{Cidr: aws.String(plCIDR1), Description: aws.String("a")}},
+		},
+		"AddAndRemove": {
+			want: []networkv1alpha1.PrefixListEntry{{CIDR: plCIDR1}, {CIDR: plCIDR2}},
+			have: []types.PrefixListEntry{{Cidr: aws.String(plCIDR3)}, {Cidr: aws.String(plCIDR1)}},
+			out: want{
+				add:    []types.AddPrefixListEntry{{Cidr: aws.String(plCIDR2)}},
+				remove: []types.RemovePrefixListEntry{{Cidr: aws.String(plCIDR3)}},
+			},
+		},
+		"DescriptionChanged": {
+			want: []networkv1alpha1.PrefixListEntry{{CIDR: plCIDR1, Description: aws.String("new")}},
+			have: []types.PrefixListEntry{{Cidr: aws.String(plCIDR1), Description: aws.String("old")}},
+			out: want{
+				add: []types.AddPrefixListEntry{{Cidr: aws.String(plCIDR1), Description: aws.String("new")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffPrefixListEntries(tc.want, tc.have)
+			if diff := cmp.Diff(tc.out.add, add, cmpopts.IgnoreUnexported(types.AddPrefixListEntry{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.out.remove, remove, cmpopts.IgnoreUnexported(types.RemovePrefixListEntry{})); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsManagedPrefixListUpToDate(t *testing.T) {
+	type args struct {
+		p       networkv1alpha1.ManagedPrefixListParameters
+		pl      types.ManagedPrefixList
+		entries []types.PrefixListEntry
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"UpToDate": {
+			args: args{
+				p: networkv1alpha1.ManagedPrefixListParameters{
+					PrefixListName: plName,
+					MaxEntries:     5,
+					Entries:        []networkv1alpha1.PrefixListEntry{{CIDR: plCIDR1}},
+					Tags:           []networkv1alpha1.Tag{{Key: "k", Value: "v"}},
+				},
+				pl: types.ManagedPrefixList{
+					PrefixListName: aws.String(plName),
+					MaxEntries:     aws.Int32(5),
+					Tags:           []types.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+				entries: []types.PrefixListEntry{{Cidr: aws.String(plCIDR1)}},
+			},
+			want: true,
+		},
+		"MaxEntriesChanged": {
+			args: args{
+				p: networkv1alpha1.ManagedPrefixListParameters{
+					PrefixListName: plName,
+					MaxEntries:     10,
+				},
+				pl: types.ManagedPrefixList{
+					PrefixListName: aws.String(plName),
+					MaxEntries:     aws.Int32(5),
+				},
+			},
+			want: false,
+		},
+		"EntriesChanged": {
+			args: args{
+				p: networkv1alpha1.ManagedPrefixListParameters{
+					PrefixListName: plName,
+					MaxEntries:     5,
+					Entries:        []networkv1alpha1.PrefixListEntry{{CIDR: plCIDR2}},
+				},
+				pl: types.ManagedPrefixList{
+					PrefixListName: aws.String(plName),
+					MaxEntries:     aws.Int32(5),
+				},
+				entries: []types.PrefixListEntry{{Cidr: aws.String(plCIDR1)}},
+			},
+			want: false,
+		},
+		"TagsChanged": {
+			args: args{
+				p: networkv1alpha1.ManagedPrefixListParameters{
+					PrefixListName: plName,
+					MaxEntries:     5,
+					Tags:           []networkv1alpha1.Tag{{Key: "k", Value: "new"}},
+				},
+				pl: types.ManagedPrefixList{
+					PrefixListName: aws.String(plName),
+					MaxEntries:     aws.Int32(5),
+					Tags:           []types.Tag{{Key: aws.String("k"), Value: aws.String("old")}},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsManagedPrefixListUpToDate(tc.args.p, tc.args.pl, tc.args.entries)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
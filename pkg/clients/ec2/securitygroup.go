@@ -8,8 +8,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/document"
+	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane-contrib/provider-aws/apis/ec2/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
@@ -124,23 +127,32 @@ func LateInitializeSG(in *v1beta1.SecurityGroupParameters, sg *ec2types.Security
 	}
 }
 
-// IsSGUpToDate checks if the observed security group is up to equal to the desired state
-func IsSGUpToDate(sg v1beta1.SecurityGroupParameters, observed ec2types.SecurityGroup) bool {
+// DiffSGPermissions returns the rules to authorize and to revoke so that the
+// observed rules match the desired ones, according to the rule management
+// policy of the security group. owned are the identifiers of the rules that
+// were authorized by the security group, see RuleIDs.
+func DiffSGPermissions(sg v1beta1.SecurityGroupParameters, want []v1beta1.IPPermission, have []ec2types.IpPermission, owned []string) (add, remove []ec2types.IpPermission) {
+	if aws.StringValue(sg.RuleManagementPolicy) == v1beta1.RuleManagementPolicyAdditive {
+		return DiffPermissionsAdditive(GenerateEC2Permissions(want), have, owned)
+	}
+	return DiffPermissions(GenerateEC2Permissions(want), have)
+}
+
+// IsSGUpToDate checks if the observed security group is up to equal to the desired state.
+// If it is not, it returns the tags and rules that drifted.
+func IsSGUpToDate(sg v1beta1.SecurityGroupParameters, obs v1beta1.SecurityGroupObservation, observed ec2types.SecurityGroup) (bool, []v1alpha1.FieldDrift) {
+	var drift []v1alpha1.FieldDrift
 	if !CompareTags(sg.Tags, observed.Tags) {
-		return false
+		drift = append(drift, awsclients.DriftAt("Tags", v1beta1.GenerateEC2Tags(sg.Tags), observed.Tags, cmpopts.IgnoreTypes(document.NoSerde{}))...)
 	}
 
 	if !awsclients.BoolValue(sg.IgnorIngress) {
-		add, remove := DiffPermissions(GenerateEC2Permissions(sg.Ingress), observed.IpPermissions)
-		if len(add) > 0 || len(remove) > 0 {
-			return false
-		}
+		add, remove := DiffSGPermissions(sg, sg.Ingress, observed.IpPermissions, obs.OwnedIngressRules)
+		drift = append(drift, awsclients.ElementsDrift("Ingress", add, remove)...)
 	}
 	if !awsclients.BoolValue(sg.IgnorEgress) {
-		add, remove := DiffPermissions(GenerateEC2Permissions(sg.Egress), observed.IpPermissionsEgress)
-		if len(add) > 0 || len(remove) > 0 {
-			return false
-		}
+		add, remove := DiffSGPermissions(sg, sg.Egress, observed.IpPermissionsEgress, obs.OwnedEgressRules)
+		drift = append(drift, awsclients.ElementsDrift("Egress", add, remove)...)
 	}
-	return true
+	return len(drift) == 0, drift
 }
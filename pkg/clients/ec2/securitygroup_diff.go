@@ -17,6 +17,8 @@ limitations under the License.
 package ec2
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -231,3 +233,127 @@ func DiffPermissions(want, have []ec2types.IpPermission) (add, remove []ec2types
 
 	return add, remove
 }
+
+// ruleID returns the identifier of a single rule, which is made of its
+// protocol, port range and source or destination.
+func ruleID(key ruleKey, peer string) string {
+	return fmt.Sprintf("%s:%d-%d:%s", key.protocol, key.fromPort, key.toPort, peer)
+}
+
+// rule is a permission that holds exactly one source or destination.
+type rule struct {
+	id   string
+	perm ec2types.IpPermission
+}
+
+// splitRules splits the given permissions into individual rules.
+func splitRules(perms []ec2types.IpPermission) []rule { // nolint:gocyclo
+	var ret []rule
+	for _, perm := range perms {
+		key := getKey(perm)
+		base := ec2types.IpPermission{
+			IpProtocol: perm.IpProtocol,
+			FromPort:   perm.FromPort,
+			ToPort:     perm.ToPort,
+		}
+		for _, r := range perm.IpRanges {
+			p := base
+			p.IpRanges = []ec2types.IpRange{r}
+			ret = append(ret, rule{id: ruleID(key, aws.ToString(r.CidrIp)), perm: p})
+		}
+		for _, r := range perm.Ipv6Ranges {
+			p := base
+			p.Ipv6Ranges = []ec2types.Ipv6Range{r}
+			ret = append(ret, rule{id: ruleID(key, aws.ToString(r.CidrIpv6)), perm: p})
+		}
+		for _, r := range perm.PrefixListIds {
+			p := base
+			p.PrefixListIds = []ec2types.PrefixListId{r}
+			ret = append(ret, rule{id: ruleID(key, aws.ToString(r.PrefixListId)), perm: p})
+		}
+		for _, r := range perm.UserIdGroupPairs {
+			p := base
+			p.UserIdGroupPairs = []ec2types.UserIdGroupPair{r}
+			peer := aws.ToString(r.GroupId)
+			if peer == "" {
+				peer = aws.ToString(r.GroupName)
+			}
+			ret = append(ret, rule{id: ruleID(key, peer), perm: p})
+		}
+	}
+	return ret
+}
+
+// RuleIDs returns the sorted and deduplicated identifiers of the individual
+// rules in the given permissions.
+func RuleIDs(perms []ec2types.IpPermission) []string {
+	rules := splitRules(perms)
+	ids := make([]string, len(rules))
+	for i, r := range rules {
+		ids[i] = r.id
+	}
+	return uniqueSorted(ids)
+}
+
+func uniqueSorted(ids []string) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	set := make(map[string]struct{}, len(ids))
+	ret := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := set[id]; !ok {
+			set[id] = struct{}{}
+			ret = append(ret, id)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// DiffPermissionsAdditive compares two permission sets like DiffPermissions,
+// but leaves alone the rules that are not owned. Only rules whose identifier
+// is in owned are revoked, and rules that exist in have without being owned
+// are never added again, e.g. to change their description.
+func DiffPermissionsAdditive(want, have []ec2types.IpPermission, owned []string) (add, remove []ec2types.IpPermission) {
+	ownedSet := make(map[string]struct{}, len(owned))
+	for _, id := range owned {
+		ownedSet[id] = struct{}{}
+	}
+	haveSet := map[string]struct{}{}
+	for _, r := range splitRules(have) {
+		haveSet[r.id] = struct{}{}
+	}
+
+	allAdd, allRemove := DiffPermissions(want, have)
+	for _, r := range splitRules(allAdd) {
+		_, exists := haveSet[r.id]
+		_, isOwned := ownedSet[r.id]
+		if !exists || isOwned {
+			add = append(add, r.perm)
+		}
+	}
+	for _, r := range splitRules(allRemove) {
+		if _, ok := ownedSet[r.id]; ok {
+			remove = append(remove, r.perm)
+		}
+	}
+	return add, remove
+}
+
+// OwnedRuleIDs returns the identifiers of the rules that are owned once the
+// rules in add have been authorized and the owned rules that are no longer
+// wanted have been revoked.
+func OwnedRuleIDs(want, add []ec2types.IpPermission, owned []string) []string {
+	wanted := map[string]struct{}{}
+	for _, id := range RuleIDs(want) {
+		wanted[id] = struct{}{}
+	}
+	ids := RuleIDs(add)
+	for _, id := range owned {
+		if _, ok := wanted[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	return uniqueSorted(ids)
+}
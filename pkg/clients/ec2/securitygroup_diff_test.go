@@ -205,3 +205,111 @@ func TestDiffPermissions(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffPermissionsAdditive(t *testing.T) {
+	opts := cmp.Options{
+		cmpopts.SortSlices(func(a, b ec2types.IpPermission) bool {
+			return aws.ToInt32(a.FromPort) < aws.ToInt32(b.FromPort) ||
+				(aws.ToInt32(a.FromPort) == aws.ToInt32(b.FromPort) && aws.ToString(a.IpRanges[0].CidrIp) < aws.ToString(b.IpRanges[0].CidrIp))
+		}),
+		cmpopts.IgnoreTypes(document.NoSerde{}),
+	}
+
+	cases := map[string]struct {
+		want   []ec2types.IpPermission
+		have   []ec2types.IpPermission
+		owned  []string
+		add    []ec2types.IpPermission
+		remove []ec2types.IpPermission
+	}{
+		"AddMissing": {
+			want: sgPermissions(port80, "10.0.0.0/8"),
+			add:  sgPermissions(port80, "10.0.0.0/8"),
+		},
+		"KeepForeign": {
+			want: sgPermissions(port80, "10.0.0.0/8"),
+			have: append(sgPermissions(port80, "10.0.0.0/8", "172.16.0.0/12"), sgPermissions(port100, "10.0.0.0/8")...),
+		},
+		"RevokeOwned": {
+			want:   sgPermissions(port80, "10.0.0.0/8"),
+			have:   append(sgPermissions(port80, "10.0.0.0/8", "172.16.0.0/12"), sgUserIDGroupPair(port100, "sg-1", "sg-2")...),
+			owned:  []string{"tcp:80-80:10.0.0.0/8", "tcp:80-80:172.16.0.0/12"},
+			remove: sgPermissions(port80, "172.16.0.0/12"),
+		},
+		"DoNotUpdateForeignDescription": {
+			want: []ec2types.IpPermission{{
+				FromPort:   aws.Int32(port80),
+				ToPort:     aws.Int32(port80),
+				IpProtocol: aws.String(tcpProtocol),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("mine")}},
+			}},
+			have: sgPermissions(port80, "10.0.0.0/8"),
+		},
+		"UpdateOwnedDescription": {
+			want: []ec2types.IpPermission{{
+				FromPort:   aws.Int32(port80),
+				ToPort:     aws.Int32(port80),
+				IpProtocol: aws.String(tcpProtocol),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("mine")}},
+			}},
+			have:  sgPermissions(port80, "10.0.0.0/8"),
+			owned: []string{"tcp:80-80:10.0.0.0/8"},
+			add: []ec2types.IpPermission{{
+				FromPort:   aws.Int32(port80),
+				ToPort:     aws.Int32(port80),
+				IpProtocol: aws.String(tcpProtocol),
+				IpRanges:   []ec2types.IpRange{{CidrIp: aws.String("10.0.0.0/8"), Description: aws.String("mine")}},
+			}},
+			remove: sgPermissions(port80, "10.0.0.0/8"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffPermissionsAdditive(tc.want, tc.have, tc.owned)
+
+			if diff := cmp.Diff(tc.add, add, opts); diff != "" {
+				t.Errorf("r add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.remove, remove, opts); diff != "" {
+				t.Errorf("r remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnedRuleIDs(t *testing.T) {
+	cases := map[string]struct {
+		want  []ec2types.IpPermission
+		add   []ec2types.IpPermission
+		owned []string
+		ids   []string
+	}{
+		"Empty": {},
+		"AddedRulesAreOwned": {
+			want:  append(sgPermissions(port80, "10.0.0.0/8"), sgUserIDGroupPair(port100, "sg-1")...),
+			add:   sgUserIDGroupPair(port100, "sg-1"),
+			owned: []string{"tcp:80-80:10.0.0.0/8"},
+			ids:   []string{"tcp:100-100:sg-1", "tcp:80-80:10.0.0.0/8"},
+		},
+		"ExistingRulesAreNotOwned": {
+			want: sgPermissions(port80, "10.0.0.0/8", "172.16.0.0/12"),
+			add:  sgPermissions(port80, "172.16.0.0/12"),
+			ids:  []string{"tcp:80-80:172.16.0.0/12"},
+		},
+		"RemovedRulesAreReleased": {
+			want:  sgPermissions(port80, "10.0.0.0/8"),
+			owned: []string{"tcp:80-80:10.0.0.0/8", "tcp:80-80:172.16.0.0/12"},
+			ids:   []string{"tcp:80-80:10.0.0.0/8"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ids := OwnedRuleIDs(tc.want, tc.add, tc.owned)
+			if diff := cmp.Diff(tc.ids, ids); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -54,8 +54,9 @@ func sgIPPermission(ports ...int) (ret []ec2types.IpPermission) {
 
 func TestIsSGUpToDate(t *testing.T) {
 	type args struct {
-		sg ec2types.SecurityGroup
-		p  v1beta1.SecurityGroupParameters
+		sg  ec2types.SecurityGroup
+		p   v1beta1.SecurityGroupParameters
+		obs v1beta1.SecurityGroupObservation
 	}
 
 	cases := map[string]struct {
@@ -113,14 +114,59 @@ func TestIsSGUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"AdditiveIgnoresForeignRules": {
+			args: args{
+				sg: ec2types.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(80, 100),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description:          sgDesc,
+					GroupName:            sgName,
+					VPCID:                aws.String(sgVpc),
+					Ingress:              specIPPermission(80),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
+				},
+				obs: v1beta1.SecurityGroupObservation{
+					OwnedIngressRules: []string{"tcp:80-80:" + sgCidr},
+				},
+			},
+			want: true,
+		},
+		"AdditiveRevokesOwnedRules": {
+			args: args{
+				sg: ec2types.SecurityGroup{
+					Description:   aws.String(sgDesc),
+					GroupName:     aws.String(sgName),
+					VpcId:         aws.String(sgVpc),
+					IpPermissions: sgIPPermission(80, 100),
+				},
+				p: v1beta1.SecurityGroupParameters{
+					Description:          sgDesc,
+					GroupName:            sgName,
+					VPCID:                aws.String(sgVpc),
+					Ingress:              specIPPermission(80),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
+				},
+				obs: v1beta1.SecurityGroupObservation{
+					OwnedIngressRules: []string{"tcp:100-100:" + sgCidr, "tcp:80-80:" + sgCidr},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got := IsSGUpToDate(tc.args.p, tc.args.sg)
+			got, drift := IsSGUpToDate(tc.args.p, tc.args.obs, tc.args.sg)
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
+			if got == (len(drift) != 0) {
+				t.Errorf("r: want drift if and only if the security group is not up to date, got %v", drift)
+			}
 		})
 	}
 }
@@ -26,6 +26,9 @@ type SubnetClient interface {
 	ModifySubnetAttribute(ctx context.Context, input *ec2.ModifySubnetAttributeInput, opts ...func(*ec2.Options)) (*ec2.ModifySubnetAttributeOutput, error)
 	CreateTags(ctx context.Context, input *ec2.CreateTagsInput, opts ...func(*ec2.Options)) (*ec2.CreateTagsOutput, error)
 	DeleteTags(ctx context.Context, input *ec2.DeleteTagsInput, opts ...func(*ec2.Options)) (*ec2.DeleteTagsOutput, error)
+	AllocateIpamPoolCidr(ctx context.Context, input *ec2.AllocateIpamPoolCidrInput, opts ...func(*ec2.Options)) (*ec2.AllocateIpamPoolCidrOutput, error)
+	GetIpamPoolAllocations(ctx context.Context, input *ec2.GetIpamPoolAllocationsInput, opts ...func(*ec2.Options)) (*ec2.GetIpamPoolAllocationsOutput, error)
+	ReleaseIpamPoolAllocation(ctx context.Context, input *ec2.ReleaseIpamPoolAllocationInput, opts ...func(*ec2.Options)) (*ec2.ReleaseIpamPoolAllocationOutput, error)
 }
 
 // NewSubnetClient returns a new client using AWS credentials as JSON encoded data.
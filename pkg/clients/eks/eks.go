@@ -20,20 +20,22 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
-	"errors"
-
 	"net"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -49,8 +51,20 @@ const (
 	expireHeader     = "X-Amz-Expires"
 	expireHeaderTime = "60"
 	v1Prefix         = "k8s-aws-v1."
+
+	execAPIVersion  = "client.authentication.k8s.io/v1beta1"
+	execInstallHint = "The AWS CLI is required to authenticate with this cluster. See https://docs.aws.amazon.com/cli/latest/userguide/getting-started-install.html"
+
+	errPresignToken    = "cannot presign token"
+	errDecodeCA        = "cannot decode certificate authority data"
+	errWriteKubeconfig = "cannot write kubeconfig"
 )
 
+// TokenRefreshInterval is the maximum interval at which clusters are observed,
+// so that the tokens in their connection secrets, which are valid for 15
+// minutes, never expire.
+const TokenRefreshInterval = 10 * time.Minute
+
 // Client defines EKS Client operations
 type Client interface {
 	CreateCluster(ctx context.Context, input *eks.CreateClusterInput, opts ...func(*eks.Options)) (*eks.CreateClusterOutput, error)
@@ -362,39 +376,34 @@ func IsUpToDate(p *v1beta1.ClusterParameters, cluster *ekstypes.Cluster) (bool,
 }
 
 // GetConnectionDetails extracts managed.ConnectionDetails out of ekstypes.Cluster.
-func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient) managed.ConnectionDetails {
+// The kubeconfig authenticates as configured by the supplied Kubeconfig, if
+// any, and with a token in KubeconfigModeToken otherwise.
+// It returns an error if the token can not be created, so that the connection
+// details that were published before are not replaced by empty ones.
+func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsClient STSClient, kc *v1beta1.Kubeconfig) (managed.ConnectionDetails, error) {
 	if cluster == nil || cluster.Name == nil || cluster.Endpoint == nil || cluster.CertificateAuthority == nil || cluster.CertificateAuthority.Data == nil {
-		return managed.ConnectionDetails{}
+		return managed.ConnectionDetails{}, nil
 	}
 
-	getCallerIdentity, _ := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
-		func(po *sts.PresignOptions) {
-			po.ClientOptions = []func(*sts.Options){
-				sts.WithAPIOptions(
-					smithyhttp.AddHeaderValue(clusterIDHeader, *cluster.Name),
-					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
-				),
-			}
-		},
-	)
-
-	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
-	// EKS cluster resource. Signing the URL means that anyone in possession of
-	// this Kubeconfig will now be able to access the EKS cluster until this URL
-	// expires. This is necessary for other systems, such as core Crossplane, to
-	// be able to schedule workloads to the cluster for now, but is not the most
-	// secure way of accessing the cluster.
-	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
-	token := v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL))
+	var authInfo *clientcmdapi.AuthInfo
+	if kc != nil && kc.Mode == v1beta1.KubeconfigModeExec {
+		authInfo = &clientcmdapi.AuthInfo{Exec: GenerateExecConfig(cluster, kc.RoleARN)}
+	} else {
+		token, err := GetToken(ctx, *cluster.Name, stsClient)
+		if err != nil {
+			return nil, err
+		}
+		authInfo = &clientcmdapi.AuthInfo{Token: token}
+	}
 
 	// NOTE(hasheddan): We must decode the CA data before constructing our
 	// Kubeconfig, as the raw Kubeconfig will be base64 encoded again when
 	// written as a Secret.
 	caData, err := base64.StdEncoding.DecodeString(*cluster.CertificateAuthority.Data)
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return nil, errors.Wrap(err, errDecodeCA)
 	}
-	kc := clientcmdapi.Config{
+	cfg := clientcmdapi.Config{
 		Clusters: map[string]*clientcmdapi.Cluster{
 			*cluster.Name: {
 				Server:                   *cluster.Endpoint,
@@ -408,20 +417,77 @@ func GetConnectionDetails(ctx context.Context, cluster *ekstypes.Cluster, stsCli
 			},
 		},
 		AuthInfos: map[string]*clientcmdapi.AuthInfo{
-			*cluster.Name: {
-				Token: token,
-			},
+			*cluster.Name: authInfo,
 		},
 		CurrentContext: *cluster.Name,
 	}
 
-	rawConfig, err := clientcmd.Write(kc)
+	rawConfig, err := clientcmd.Write(cfg)
 	if err != nil {
-		return managed.ConnectionDetails{}
+		return nil, errors.Wrap(err, errWriteKubeconfig)
 	}
 	return managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretEndpointKey:   []byte(*cluster.Endpoint),
 		xpv1.ResourceCredentialsSecretKubeconfigKey: rawConfig,
 		xpv1.ResourceCredentialsSecretCAKey:         caData,
+	}, nil
+}
+
+// GetToken returns a token that authenticates with the EKS cluster with the
+// supplied name as the identity of the supplied STS client. The token is
+// valid for 15 minutes.
+func GetToken(ctx context.Context, clusterName string, stsClient STSClient) (string, error) {
+	getCallerIdentity, err := stsClient.PresignGetCallerIdentity(ctx, &sts.GetCallerIdentityInput{},
+		func(po *sts.PresignOptions) {
+			po.ClientOptions = []func(*sts.Options){
+				sts.WithAPIOptions(
+					smithyhttp.AddHeaderValue(clusterIDHeader, clusterName),
+					smithyhttp.AddHeaderValue(expireHeader, expireHeaderTime), // otherwise we get in authenticator log invalid X-Amz-Expires parameter in pre-signed URL: 0
+				),
+			}
+		},
+	)
+	if err != nil {
+		return "", errors.Wrap(err, errPresignToken)
+	}
+
+	// NOTE(hasheddan): This is carried over from the v1alpha3 version of the
+	// EKS cluster resource. Signing the URL means that anyone in possession of
+	// this Kubeconfig will now be able to access the EKS cluster until this URL
+	// expires. This is necessary for other systems, such as core Crossplane, to
+	// be able to schedule workloads to the cluster for now, but is not the most
+	// secure way of accessing the cluster.
+	// More information: https://docs.aws.amazon.com/eks/latest/userguide/create-kubeconfig.html
+	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(getCallerIdentity.URL)), nil
+}
+
+// GenerateExecConfig returns the exec config of a kubeconfig that gets tokens
+// for the supplied cluster with `aws eks get-token`, optionally assuming the
+// supplied role.
+func GenerateExecConfig(cluster *ekstypes.Cluster, roleARN *string) *clientcmdapi.ExecConfig {
+	args := []string{"eks", "get-token", "--cluster-name", awsclients.StringValue(cluster.Name)}
+	if a, err := arn.Parse(awsclients.StringValue(cluster.Arn)); err == nil {
+		args = append(args, "--region", a.Region)
+	}
+	if roleARN != nil {
+		args = append(args, "--role-arn", *roleARN)
+	}
+	return &clientcmdapi.ExecConfig{
+		APIVersion:      execAPIVersion,
+		Command:         "aws",
+		Args:            args,
+		InstallHint:     execInstallHint,
+		InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+	}
+}
+
+// GetKubeconfigTokenConfig returns the AWS config the token of the kubeconfig
+// of the supplied cluster is created with. It assumes the role of the
+// Kubeconfig of the cluster, if any, with the supplied config.
+func GetKubeconfigTokenConfig(ctx context.Context, kube client.Client, cr *v1beta1.Cluster, cfg *aws.Config) (*aws.Config, error) {
+	kc := cr.Spec.Kubeconfig
+	if kc == nil || kc.RoleARN == nil || kc.Mode == v1beta1.KubeconfigModeExec {
+		return cfg, nil
 	}
+	return awsclients.GetAssumeRoleConfig(ctx, kube, cr, aws.ToString(cr.Spec.ForProvider.Region), *kc.RoleARN)
 }
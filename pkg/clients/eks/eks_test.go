@@ -17,20 +17,29 @@ limitations under the License.
 package eks
 
 import (
+	"context"
+	"encoding/base64"
 	"testing"
 	"time"
 
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 
 	"github.com/crossplane-contrib/provider-aws/apis/eks/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks/fake"
 )
 
 var (
@@ -875,3 +884,80 @@ func TestIsUpToDate(t *testing.T) {
 		})
 	}
 }
+
+func TestGetConnectionDetails(t *testing.T) {
+	presignedURL := "https://sts.us-east-1.amazonaws.com/?Action=GetCallerIdentity"
+	cluster := &ekstypes.Cluster{
+		Name:                 &clusterName,
+		Arn:                  aws.String("arn:aws:eks:us-east-1:123456789012:cluster/my-cool-cluster"),
+		Endpoint:             aws.String("https://example.eks.amazonaws.com"),
+		CertificateAuthority: &ekstypes.Certificate{Data: aws.String(base64.StdEncoding.EncodeToString([]byte("ca")))},
+	}
+	presigner := &fake.MockSTSClient{
+		MockPresignGetCallerIdentity: func(_ context.Context, _ *sts.GetCallerIdentityInput, _ []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+			return &v4.PresignedHTTPRequest{URL: presignedURL}, nil
+		},
+	}
+
+	type args struct {
+		sts STSClient
+		kc  *v1beta1.Kubeconfig
+	}
+
+	cases := map[string]struct {
+		args args
+		want *clientcmdapi.AuthInfo
+	}{
+		"Token": {
+			args: args{sts: presigner},
+			want: &clientcmdapi.AuthInfo{
+				Token: v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURL)),
+			},
+		},
+		"Exec": {
+			args: args{
+				sts: presigner,
+				kc:  &v1beta1.Kubeconfig{Mode: v1beta1.KubeconfigModeExec, RoleARN: &roleArn},
+			},
+			want: &clientcmdapi.AuthInfo{
+				Exec: &clientcmdapi.ExecConfig{
+					APIVersion:      execAPIVersion,
+					Command:         "aws",
+					Args:            []string{"eks", "get-token", "--cluster-name", clusterName, "--region", "us-east-1", "--role-arn", roleArn},
+					InstallHint:     execInstallHint,
+					InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+				},
+			},
+		},
+		"PresignFailed": {
+			args: args{
+				sts: &fake.MockSTSClient{
+					MockPresignGetCallerIdentity: func(_ context.Context, _ *sts.GetCallerIdentityInput, _ []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+						return nil, errors.New("boom")
+					},
+				},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn, err := GetConnectionDetails(context.TODO(), cluster, tc.args.sts, tc.args.kc)
+			if tc.want == nil {
+				if err == nil {
+					t.Errorf("r: want error, got connection details %v", conn)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			cfg, err := clientcmd.Load(conn[xpv1.ResourceCredentialsSecretKubeconfigKey])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.want, cfg.AuthInfos[clusterName], cmpopts.IgnoreFields(clientcmdapi.AuthInfo{}, "LocationOfOrigin", "Extensions"), cmpopts.IgnoreFields(clientcmdapi.ExecConfig{}, "Env")); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -5,14 +5,13 @@ import (
 	"net/url"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
-	"github.com/google/go-cmp/cmp"
-
-	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // PolicyClient is the external client used for Policy Custom Resource
@@ -45,12 +44,8 @@ func NewSTSClient(cfg aws.Config) STSClient {
 
 // IsPolicyUpToDate checks whether there is a change in any of the modifiable fields in policy.
 func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion) (bool, error) {
-	// The AWS API returns Policy Document as an escaped string.
-	// Due to differences in the methods to escape a string, the comparison result between
-	// the spec.Document and policy.Document can sometimes be false negative (due to spaces, line feeds).
-	// Escaping with a common method and then comparing is a safe way.
-
-	if *policy.Document == "" || in.Document == "" {
+	// The AWS API returns Policy Document as an URL escaped string.
+	if aws.ToString(policy.Document) == "" || in.Document == "" {
 		return false, nil
 	}
 
@@ -59,14 +54,27 @@ func IsPolicyUpToDate(in v1beta1.PolicyParameters, policy iamtypes.PolicyVersion
 		return false, nil
 	}
 
-	compactPolicy, err := awsclients.CompactAndEscapeJSON(unescapedPolicy)
-	if err != nil {
-		return false, err
+	// The order of statements and of the values of a statement is ignored,
+	// and a list with a single element equals the element itself.
+	return awsclients.IsPolicyUpToDate(&in.Document, &unescapedPolicy), nil
+}
+
+// GeneratePolicyVersions converts the policy versions returned by the IAM
+// API to their representation in status.atProvider.
+func GeneratePolicyVersions(versions []iamtypes.PolicyVersion) []v1beta1.PolicyVersion {
+	if len(versions) == 0 {
+		return nil
 	}
-	compactSpecPolicy, err := awsclients.CompactAndEscapeJSON(in.Document)
-	if err != nil {
-		return false, err
+	res := make([]v1beta1.PolicyVersion, len(versions))
+	for i, v := range versions {
+		res[i] = v1beta1.PolicyVersion{
+			VersionID:        aws.ToString(v.VersionId),
+			IsDefaultVersion: v.IsDefaultVersion,
+		}
+		if v.CreateDate != nil {
+			t := metav1.NewTime(*v.CreateDate)
+			res[i].CreateDate = &t
+		}
 	}
-
-	return cmp.Equal(compactPolicy, compactSpecPolicy), nil
+	return res
 }
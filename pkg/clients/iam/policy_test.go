@@ -37,6 +37,32 @@ var (
 	   }`
 )
 
+var (
+	document3 = `{
+		"Version": "2012-10-17",
+		"Statement": [
+		  {
+			"Sid": "List",
+			"Effect": "Allow",
+			"Action": ["s3:ListBucket"],
+			"Resource": "arn:aws:s3:::bucket"
+		  },
+		  {
+			"Sid": "Read",
+			"Effect": "Allow",
+			"Action": ["s3:GetObject", "s3:GetObjectVersion"],
+			"Resource": ["arn:aws:s3:::bucket/*"]
+		  }
+		]
+	   }`
+
+	document3Reordered = `{"Version":"2012-10-17","Statement":[` +
+		`{"Sid":"Read","Effect":"Allow","Action":["s3:GetObjectVersion","s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"},` +
+		`{"Sid":"List","Effect":"Allow","Action":"s3:ListBucket","Resource":"arn:aws:s3:::bucket"}]}`
+
+	document3Escaped = "%7B%22Version%22%3A%222012-10-17%22%2C%22Statement%22%3A%7B%22Sid%22%3A%22List%22%2C%22Effect%22%3A%22Allow%22%2C%22Action%22%3A%22s3%3AListBucket%22%2C%22Resource%22%3A%22arn%3Aaws%3As3%3A%3A%3Abucket%22%7D%7D"
+)
+
 func TestIsPolicyUpToDate(t *testing.T) {
 	type args struct {
 		p       v1beta1.PolicyParameters
@@ -69,6 +95,28 @@ func TestIsPolicyUpToDate(t *testing.T) {
 			},
 			want: false,
 		},
+		"ReorderedStatementsAndStringVsArray": {
+			args: args{
+				p: v1beta1.PolicyParameters{
+					Document: document3,
+				},
+				version: iamtypes.PolicyVersion{
+					Document: &document3Reordered,
+				},
+			},
+			want: true,
+		},
+		"EscapedDocumentMissingStatement": {
+			args: args{
+				p: v1beta1.PolicyParameters{
+					Document: document3,
+				},
+				version: iamtypes.PolicyVersion{
+					Document: &document3Escaped,
+				},
+			},
+			want: false,
+		},
 		"EmptyPolicy": {
 			args: args{
 				p: v1beta1.PolicyParameters{},
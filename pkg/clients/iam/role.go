@@ -10,7 +10,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
-	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/mitchellh/copystructure"
 	"github.com/pkg/errors"
@@ -18,6 +17,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
 
@@ -161,44 +161,52 @@ func isAssumeRolePolicyUpToDate(a, b *string) (bool, error) {
 }
 
 // IsRoleUpToDate checks whether there is a change in any of the modifiable fields in role.
-func IsRoleUpToDate(in v1beta1.RoleParameters, observed iamtypes.Role) (bool, string, error) {
+// If there is, it returns the fields that drifted.
+func IsRoleUpToDate(in v1beta1.RoleParameters, observed iamtypes.Role) (bool, []v1alpha1.FieldDrift, error) {
 	generated, err := copystructure.Copy(&observed)
 	if err != nil {
-		return true, "", errors.Wrap(err, errCheckUpToDate)
+		return true, nil, errors.Wrap(err, errCheckUpToDate)
 	}
 	desired, ok := generated.(*iamtypes.Role)
 	if !ok {
-		return true, "", errors.New(errCheckUpToDate)
+		return true, nil, errors.New(errCheckUpToDate)
 	}
 
 	if err = GenerateRole(in, desired); err != nil {
-		return false, "", err
+		return false, nil, err
 	}
 
 	policyUpToDate, err := isAssumeRolePolicyUpToDate(desired.AssumeRolePolicyDocument, observed.AssumeRolePolicyDocument)
 	if err != nil {
-		return false, "", err
+		return false, nil, err
 	}
 
-	diff := cmp.Diff(desired, &observed,
+	drift := awsclients.Drift(desired, &observed,
 		cmpopts.IgnoreInterfaces(struct{ resource.AttributeReferencer }{}),
 		cmpopts.SortSlices(func(a, b iamtypes.Tag) bool {
 			return aws.ToString(a.Key) < aws.ToString(b.Key)
 		}),
 		cmpopts.IgnoreFields(observed, "AssumeRolePolicyDocument"),
 		cmpopts.IgnoreTypes(document.NoSerde{}))
-	if diff == "" && policyUpToDate {
-		return true, diff, nil
-	}
-
-	diff = "Found observed difference in IAM role\n" + diff
 
-	// Add extra logging for AssumeRolePolicyDocument because cmp.Diff doesn't show the full difference
+	// The policy documents are compared semantically, so the drift
+	// contains them in full.
 	if !policyUpToDate {
-		diff += "\ndesired assume role policy: "
-		diff += *desired.AssumeRolePolicyDocument
-		diff += "\nobserved assume role policy: "
-		diff += *observed.AssumeRolePolicyDocument
+		drift = append(drift, v1alpha1.FieldDrift{
+			Path:     "AssumeRolePolicyDocument",
+			Desired:  unescapePolicy(desired.AssumeRolePolicyDocument),
+			Observed: unescapePolicy(observed.AssumeRolePolicyDocument),
+		})
+	}
+	return len(drift) == 0, drift, nil
+}
+
+// unescapePolicy returns the supplied URL encoded policy document, unescaped
+// if possible.
+func unescapePolicy(p *string) string {
+	s := aws.ToString(p)
+	if u, err := url.QueryUnescape(s); err == nil {
+		return u
 	}
-	return false, diff, nil
+	return s
 }
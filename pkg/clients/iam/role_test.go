@@ -1,7 +1,6 @@
 package iam
 
 import (
-	"strings"
 	"testing"
 	"time"
 
@@ -13,6 +12,7 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 
 	"github.com/crossplane-contrib/provider-aws/apis/iam/v1beta1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 )
 
@@ -241,9 +241,9 @@ func TestIsRoleUpToDate(t *testing.T) {
 	}
 
 	cases := map[string]struct {
-		args     args
-		want     bool
-		wantDiff string
+		args      args
+		want      bool
+		wantDrift []v1alpha1.FieldDrift
 	}{
 		"SameFields": {
 			args: args{
@@ -279,8 +279,7 @@ func TestIsRoleUpToDate(t *testing.T) {
 					},
 				},
 			},
-			want:     true,
-			wantDiff: "",
+			want: true,
 		},
 		"DifferentPolicy": {
 			args: args{
@@ -298,10 +297,11 @@ func TestIsRoleUpToDate(t *testing.T) {
 				},
 			},
 			want: false,
-			wantDiff: `Found observed difference in IAM role
-
-desired assume role policy: %7B%22Version%22%3A%222012-10-17%22%2C%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Principal%22%3A%7B%22Service%22%3A%22eks.amazonaws.com%22%7D%2C%22Action%22%3A%22sts%3AAssumeRole%22%2C%22Condition%22%3A%7B%22StringEquals%22%3A%7B%22foo%22%3A%22bar%22%7D%7D%7D%5D%7D
-observed assume role policy: %7B%22Version%22%3A%222012-10-17%22%2C%22Statement%22%3A%5B%7B%22Effect%22%3A%22Allow%22%2C%22Principal%22%3A%7B%22Service%22%3A%22eks.amazonaws.com%22%7D%2C%22Action%22%3A%22sts%3AAssumeRole%22%7D%5D%7D`,
+			wantDrift: []v1alpha1.FieldDrift{{
+				Path:     "AssumeRolePolicyDocument",
+				Desired:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"},"Action":"sts:AssumeRole","Condition":{"StringEquals":{"foo":"bar"}}}]}`,
+				Observed: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"eks.amazonaws.com"},"Action":"sts:AssumeRole"}]}`,
+			}},
 		},
 		"DifferentFields": {
 			args: args{
@@ -326,30 +326,22 @@ observed assume role policy: %7B%22Version%22%3A%222012-10-17%22%2C%22Statement%
 					}},
 				},
 			},
-			want:     false,
-			wantDiff: "Found observed difference in IAM role",
+			want:      false,
+			wantDrift: []v1alpha1.FieldDrift{{Path: "Path", Desired: `"/"`, Observed: `"//"`}},
 		},
 	}
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			got, testDiff, err := IsRoleUpToDate(tc.args.p, tc.args.role)
+			got, drift, err := IsRoleUpToDate(tc.args.p, tc.args.role)
 			if err != nil {
 				t.Errorf("r: unexpected error: %v", err)
 			}
 			if diff := cmp.Diff(tc.want, got); diff != "" {
 				t.Errorf("r: -want, +got:\n%s", diff)
 			}
-			if tc.wantDiff == "" {
-				if tc.wantDiff != testDiff {
-					t.Errorf("r: -want, +got:\n%s", testDiff)
-				}
-			}
-
-			if tc.wantDiff == "Found observed difference in IAM role" {
-				if !strings.Contains(testDiff, tc.wantDiff) {
-					t.Errorf("r: -want, +got:\n%s", testDiff)
-				}
+			if diff := cmp.Diff(tc.wantDrift, drift); diff != "" {
+				t.Errorf("r: -want drift, +got drift:\n%s", diff)
 			}
 		})
 	}
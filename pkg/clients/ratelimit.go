@@ -0,0 +1,186 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awsv1 "github.com/aws/aws-sdk-go/aws"
+	requestv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+var throttledRequests = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "aws_api_requests_throttled_total",
+		Help: "Number of requests to the AWS API that were throttled",
+	},
+	[]string{"provider_config", "service"},
+)
+
+// RegisterMetrics registers the metrics of the AWS clients with the supplied
+// registry. It must only be called once.
+func RegisterMetrics(registry prometheus.Registerer) {
+	registry.MustRegister(throttledRequests)
+}
+
+// limiters holds the rate limiters of all ProviderConfigs. Limiters outlive
+// the configs built from a ProviderConfig, so that the rate of requests is
+// limited across all of its versions.
+var limiters = newRateLimiters()
+
+type rateLimiters struct {
+	mu sync.Mutex
+	m  map[string]*rate.Limiter
+}
+
+func newRateLimiters() *rateLimiters {
+	return &rateLimiters{m: map[string]*rate.Limiter{}}
+}
+
+// Get returns the rate limiter of the supplied ProviderConfig, updating its
+// limit if it changed. It returns nil if the ProviderConfig does not limit
+// the rate of requests.
+func (l *rateLimiters) Get(pc *v1beta1.ProviderConfig) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rl := pc.Spec.RateLimit
+	if rl == nil {
+		delete(l.m, pc.GetName())
+		return nil
+	}
+	limit := rate.Limit(rl.RequestsPerSecond)
+	burst := int(rl.RequestsPerSecond)
+	if rl.Burst != nil {
+		burst = int(*rl.Burst)
+	}
+	lim, ok := l.m[pc.GetName()]
+	if !ok {
+		lim = rate.NewLimiter(limit, burst)
+		l.m[pc.GetName()] = lim
+	}
+	if lim.Limit() != limit {
+		lim.SetLimit(limit)
+	}
+	if lim.Burst() != burst {
+		lim.SetBurst(burst)
+	}
+	return lim
+}
+
+// isErrorThrottle returns true if the supplied AWS SDK v2 error indicates
+// that a request was throttled.
+func isErrorThrottle(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary
+}
+
+// SetRetryPolicy configures the supplied config to retry requests and limit
+// their rate as configured by the supplied ProviderConfig, and to count the
+// requests that are throttled.
+func SetRetryPolicy(pc *v1beta1.ProviderConfig, cfg *aws.Config) *aws.Config {
+	if rp := pc.Spec.Retry; rp != nil {
+		cfg.Retryer = newRetryer(rp)
+	}
+	lim := limiters.Get(pc)
+	name := pc.GetName()
+	mw := middleware.FinalizeMiddlewareFunc("crossplane.RequestRateLimit", func(ctx context.Context, in middleware.FinalizeInput, next middleware.FinalizeHandler) (middleware.FinalizeOutput, middleware.Metadata, error) {
+		if lim != nil {
+			if err := lim.Wait(ctx); err != nil {
+				return middleware.FinalizeOutput{}, middleware.Metadata{}, err
+			}
+		}
+		out, md, err := next.HandleFinalize(ctx, in)
+		if err != nil && isErrorThrottle(err) {
+			throttledRequests.WithLabelValues(name, awsmiddleware.GetServiceID(ctx)).Inc()
+		}
+		return out, md, err
+	})
+	// The middleware is inserted after the retry middleware, so that every
+	// attempt is limited and counted.
+	cfg.APIOptions = append(cfg.APIOptions[:len(cfg.APIOptions):len(cfg.APIOptions)], func(s *middleware.Stack) error {
+		if err := s.Finalize.Insert(mw, (&retry.Attempt{}).ID(), middleware.After); err != nil {
+			return s.Finalize.Add(mw, middleware.After)
+		}
+		return nil
+	})
+	return cfg
+}
+
+// newRetryer returns a function that returns the AWS SDK v2 retryer for the
+// supplied policy. Adaptive retryers are shared by all clients, so that
+// all requests sent with a ProviderConfig are slowed down when some of them
+// are throttled.
+func newRetryer(rp *v1beta1.RetryPolicy) func() aws.Retryer {
+	std := func(o *retry.StandardOptions) {
+		if rp.MaxRetries != nil {
+			o.MaxAttempts = int(*rp.MaxRetries) + 1
+		}
+	}
+	if rp.Mode != nil && *rp.Mode == v1beta1.RetryModeAdaptive {
+		r := retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+			o.StandardOptions = append(o.StandardOptions, std)
+		})
+		return func() aws.Retryer { return r }
+	}
+	return func() aws.Retryer { return retry.NewStandard(std) }
+}
+
+// SetRetryPolicyV1 configures the supplied AWS SDK v1 config to retry
+// requests as configured by the supplied ProviderConfig. AWS SDK v1 has no
+// adaptive retry mode, so requests are always retried in standard mode.
+func SetRetryPolicyV1(pc *v1beta1.ProviderConfig, cfg *awsv1.Config) *awsv1.Config {
+	if rp := pc.Spec.Retry; rp != nil && rp.MaxRetries != nil {
+		cfg.MaxRetries = awsv1.Int(int(*rp.MaxRetries))
+	}
+	return cfg
+}
+
+// SetRequestHandlersV1 configures the supplied AWS SDK v1 session to limit
+// the rate of requests as configured by the supplied ProviderConfig, and to
+// count the requests that are throttled.
+func SetRequestHandlersV1(pc *v1beta1.ProviderConfig, sess *session.Session) *session.Session {
+	if lim := limiters.Get(pc); lim != nil {
+		// Requests are signed before every attempt.
+		sess.Handlers.Sign.PushFrontNamed(requestv1.NamedHandler{
+			Name: "crossplane.RequestRateLimit",
+			Fn: func(r *requestv1.Request) {
+				if err := lim.Wait(r.Context()); err != nil {
+					r.Error = err
+				}
+			},
+		})
+	}
+	name := pc.GetName()
+	sess.Handlers.CompleteAttempt.PushBackNamed(requestv1.NamedHandler{
+		Name: "crossplane.ThrottledRequests",
+		Fn: func(r *requestv1.Request) {
+			if r.Error != nil && requestv1.IsErrorThrottle(r.Error) {
+				throttledRequests.WithLabelValues(name, r.ClientInfo.ServiceID).Inc()
+			}
+		},
+	})
+	return sess
+}
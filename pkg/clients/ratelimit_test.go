@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const throttlingResponse = `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>Throttling</Code>
+    <Message>Rate exceeded</Message>
+  </Error>
+  <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+</ErrorResponse>`
+
+func TestRateLimitersGet(t *testing.T) {
+	type want struct {
+		limit rate.Limit
+		burst int
+		nil   bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		limits []*v1beta1.RateLimit
+		want   want
+	}{
+		"NoRateLimit": {
+			reason: "No limiter should be returned if the ProviderConfig does not limit the rate of requests.",
+			limits: []*v1beta1.RateLimit{nil},
+			want:   want{nil: true},
+		},
+		"DefaultBurst": {
+			reason: "The burst should default to the requests per second.",
+			limits: []*v1beta1.RateLimit{{RequestsPerSecond: 5}},
+			want:   want{limit: 5, burst: 5},
+		},
+		"Updated": {
+			reason: "The limiter of a ProviderConfig should be updated when its rate limit changes.",
+			limits: []*v1beta1.RateLimit{
+				{RequestsPerSecond: 5},
+				{RequestsPerSecond: 10, Burst: aws.Int32(20)},
+			},
+			want: want{limit: 10, burst: 20},
+		},
+		"Removed": {
+			reason: "No limiter should be returned once the rate limit of a ProviderConfig is removed.",
+			limits: []*v1beta1.RateLimit{{RequestsPerSecond: 5}, nil},
+			want:   want{nil: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			l := newRateLimiters()
+			var first, got *rate.Limiter
+			for i, rl := range tc.limits {
+				pc := &v1beta1.ProviderConfig{
+					ObjectMeta: metav1.ObjectMeta{Name: "pc"},
+					Spec:       v1beta1.ProviderConfigSpec{RateLimit: rl},
+				}
+				got = l.Get(pc)
+				if i == 0 {
+					first = got
+				}
+			}
+			if tc.want.nil {
+				if got != nil {
+					t.Errorf("\n%s\nGet(...): want nil limiter, got %v", tc.reason, got)
+				}
+				return
+			}
+			if got != first {
+				t.Errorf("\n%s\nGet(...): want the same limiter for all versions of a ProviderConfig", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want, want{limit: got.Limit(), burst: got.Burst()}, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetRetryPolicy(t *testing.T) {
+	adaptive := v1beta1.RetryModeAdaptive
+
+	cases := map[string]struct {
+		reason   string
+		retry    *v1beta1.RetryPolicy
+		attempts int
+	}{
+		"Default": {
+			reason:   "Throttled requests should be attempted three times by default.",
+			attempts: 3,
+		},
+		"MaxRetries": {
+			reason:   "Throttled requests should be retried up to maxRetries times.",
+			retry:    &v1beta1.RetryPolicy{MaxRetries: aws.Int32(0)},
+			attempts: 1,
+		},
+		"Adaptive": {
+			reason:   "Throttled requests should be retried up to maxRetries times in adaptive mode.",
+			retry:    &v1beta1.RetryPolicy{MaxRetries: aws.Int32(1), Mode: &adaptive},
+			attempts: 2,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			attempts := 0
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempts++
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, throttlingResponse)
+			}))
+			defer srv.Close()
+
+			pc := &v1beta1.ProviderConfig{
+				ObjectMeta: metav1.ObjectMeta{Name: "retry-" + name},
+				Spec: v1beta1.ProviderConfigSpec{
+					Retry:     tc.retry,
+					RateLimit: &v1beta1.RateLimit{RequestsPerSecond: 100},
+				},
+			}
+			cfg := SetRetryPolicy(pc, &aws.Config{
+				Region:      "us-east-1",
+				Credentials: credentials.NewStaticCredentialsProvider("akid", "secret", ""),
+				EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(_, _ string, _ ...interface{}) (aws.Endpoint, error) {
+					return aws.Endpoint{URL: srv.URL}, nil
+				}),
+			})
+
+			if _, err := sts.NewFromConfig(*cfg).GetCallerIdentity(context.TODO(), &sts.GetCallerIdentityInput{}); err == nil {
+				t.Fatalf("\n%s\nGetCallerIdentity(...): want error, got nil", tc.reason)
+			}
+			if attempts != tc.attempts {
+				t.Errorf("\n%s\nGetCallerIdentity(...): want %d attempts, got %d", tc.reason, tc.attempts, attempts)
+			}
+			if got := testutil.ToFloat64(throttledRequests.WithLabelValues(pc.GetName(), "STS")); int(got) != tc.attempts {
+				t.Errorf("\n%s\nthrottledRequests: want %d, got %v", tc.reason, tc.attempts, got)
+			}
+		})
+	}
+}
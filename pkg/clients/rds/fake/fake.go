@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 )
 
 // MockRDSClient for testing.
@@ -73,3 +74,13 @@ func (m *MockRDSClient) DeleteDBInstance(ctx context.Context, i *rds.DeleteDBIns
 func (m *MockRDSClient) AddTagsToResource(ctx context.Context, i *rds.AddTagsToResourceInput, opts ...func(*rds.Options)) (*rds.AddTagsToResourceOutput, error) {
 	return m.MockAddTags(ctx, i, opts)
 }
+
+// MockSecretsManagerClient for testing.
+type MockSecretsManagerClient struct {
+	MockGetSecretValue func(context.Context, *secretsmanager.GetSecretValueInput, []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// GetSecretValue gets the value of a secret
+func (m *MockSecretsManagerClient) GetSecretValue(ctx context.Context, i *secretsmanager.GetSecretValueInput, opts ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	return m.MockGetSecretValue(ctx, i, opts)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
@@ -36,6 +37,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/password"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
 
@@ -45,8 +48,53 @@ import (
 
 const (
 	errGetPasswordSecretFailed = "cannot get password secret"
+	errNoPasswordSecretRef     = "no password secret reference given, unable to store the generated password"
+	errGeneratePasswordFailed  = "cannot generate a password"
+	errNoRotationSecretRef     = "a master password secret reference is required to rotate the master password"
+	errStagePasswordFailed     = "cannot stage the rotated password in the password secret"
+	errCommitPasswordFailed    = "cannot commit the rotated password to the password secret"
+	errGetMasterUserSecret     = "cannot get master user secret value"
+	errParseMasterUserSecret   = "cannot parse master user secret value"
 )
 
+// TypeMasterUserSecret is the type of the condition that indicates whether
+// the master user secret managed by RDS in Secrets Manager could be read.
+const TypeMasterUserSecret xpv1.ConditionType = "MasterUserSecret"
+
+// Reasons the master user secret could or could not be read.
+const (
+	ReasonMasterUserSecretAvailable   xpv1.ConditionReason = "MasterUserSecretAvailable"
+	ReasonMasterUserSecretUnavailable xpv1.ConditionReason = "MasterUserSecretUnavailable"
+)
+
+// MasterUserSecretAvailable returns a condition that indicates that the
+// master user secret was read.
+func MasterUserSecretAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMasterUserSecret,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMasterUserSecretAvailable,
+	}
+}
+
+// MasterUserSecretUnavailable returns a condition that indicates that the
+// master user secret could not be read because of the supplied error.
+func MasterUserSecretUnavailable(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeMasterUserSecret,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonMasterUserSecretUnavailable,
+		Message:            err.Error(),
+	}
+}
+
+// stagedPasswordKeySuffix is appended to the key of the password secret to
+// get the key a rotated password is staged at until the password of the
+// external resource was changed.
+const stagedPasswordKeySuffix = "-staged"
+
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateDBInstance(context.Context, *rds.CreateDBInstanceInput, ...func(*rds.Options)) (*rds.CreateDBInstanceOutput, error)
@@ -99,7 +147,9 @@ func GenerateCreateDBInstanceInput(name, password string, p *v1beta1.RDSInstance
 		Iops:                               awsclients.Int32Address(p.IOPS),
 		KmsKeyId:                           p.KMSKeyID,
 		LicenseModel:                       p.LicenseModel,
+		ManageMasterUserPassword:           p.ManageMasterUserPassword,
 		MasterUserPassword:                 awsclients.String(password),
+		MasterUserSecretKmsKeyId:           p.MasterUserSecretKMSKeyID,
 		MasterUsername:                     p.MasterUsername,
 		MaxAllocatedStorage:                awsclients.Int32Address(p.MaxAllocatedStorage),
 		MonitoringInterval:                 awsclients.Int32Address(p.MonitoringInterval),
@@ -169,7 +219,9 @@ func GenerateRestoreDBInstanceFromS3Input(name, password string, p *v1beta1.RDSI
 		Iops:                               awsclients.Int32Address(p.IOPS),
 		KmsKeyId:                           p.KMSKeyID,
 		LicenseModel:                       p.LicenseModel,
+		ManageMasterUserPassword:           p.ManageMasterUserPassword,
 		MasterUserPassword:                 awsclients.String(password),
+		MasterUserSecretKmsKeyId:           p.MasterUserSecretKMSKeyID,
 		MasterUsername:                     p.MasterUsername,
 		MonitoringInterval:                 awsclients.Int32Address(p.MonitoringInterval),
 		MonitoringRoleArn:                  p.MonitoringRoleARN,
@@ -363,6 +415,10 @@ func CreatePatch(in *rdstypes.DBInstance, target *v1beta1.RDSInstanceParameters)
 		}
 	}
 
+	if target.ManageMasterUserPassword != nil {
+		currentParams.ManageMasterUserPassword = aws.Bool(in.MasterUserSecret != nil)
+	}
+
 	jsonPatch, err := awsclients.CreateJSONPatch(currentParams, target)
 	if err != nil {
 		return nil, err
@@ -430,6 +486,14 @@ func GenerateModifyDBInstanceInput(name string, p *v1beta1.RDSInstanceParameters
 		UseDefaultProcessorFeatures:        p.UseDefaultProcessorFeatures,
 		VpcSecurityGroupIds:                p.VPCSecurityGroupIDs,
 	}
+	// NOTE: The KMS key of the secret can only be set when Secrets Manager
+	// starts to manage the master user password.
+	if p.ManageMasterUserPassword != nil {
+		m.ManageMasterUserPassword = p.ManageMasterUserPassword
+		if aws.ToBool(p.ManageMasterUserPassword) {
+			m.MasterUserSecretKmsKeyId = p.MasterUserSecretKMSKeyID
+		}
+	}
 	if len(p.ProcessorFeatures) != 0 {
 		m.ProcessorFeatures = make([]rdstypes.ProcessorFeature, len(p.ProcessorFeatures))
 		for i, val := range p.ProcessorFeatures {
@@ -502,6 +566,13 @@ func GenerateObservation(db rdstypes.DBInstance) v1beta1.RDSInstanceObservation
 		t := metav1.NewTime(*db.InstanceCreateTime)
 		o.InstanceCreateTime = &t
 	}
+	if db.MasterUserSecret != nil {
+		o.MasterUserSecret = &v1beta1.MasterUserSecret{
+			SecretARN:    aws.ToString(db.MasterUserSecret.SecretArn),
+			SecretStatus: aws.ToString(db.MasterUserSecret.SecretStatus),
+			KMSKeyID:     aws.ToString(db.MasterUserSecret.KmsKeyId),
+		}
+	}
 	if len(db.DBParameterGroups) != 0 {
 		o.DBParameterGroups = make([]v1beta1.DBParameterGroupStatus, len(db.DBParameterGroups))
 		for i, val := range db.DBParameterGroups {
@@ -714,9 +785,13 @@ func LateInitialize(in *v1beta1.RDSInstanceParameters, db *rdstypes.DBInstance)
 
 // IsUpToDate checks whether there is a change in any of the modifiable fields.
 func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance, db rdstypes.DBInstance) (bool, error) {
-	_, pwdChanged, err := GetPassword(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference)
-	if err != nil {
-		return false, err
+	pwdChanged := false
+	if !aws.ToBool(r.Spec.ForProvider.ManageMasterUserPassword) {
+		_, changed, err := GetPassword(ctx, kube, r.Spec.ForProvider.MasterPasswordSecretRef, r.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return false, err
+		}
+		pwdChanged = changed
 	}
 
 	patch, err := CreatePatch(&db, &r.Spec.ForProvider)
@@ -734,6 +809,8 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "ApplyModificationsImmediately"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AllowMajorVersionUpgrade"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterPasswordSecretRef"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "PasswordRotation"),
+		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "MasterUserSecretKMSKeyID"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "CloudwatchLogsExportConfiguration"),
 		cmpopts.IgnoreFields(v1beta1.RDSInstanceParameters{}, "AvailabilityZone"),
 	)
@@ -752,7 +829,7 @@ func IsUpToDate(ctx context.Context, kube client.Client, r *v1beta1.RDSInstance,
 
 	log.Println(r.Name, "len(diff)", "pwdChanged", pwdChanged)
 
-	return diff == "" && !pwdChanged, nil
+	return diff == "" && !pwdChanged && !IsPasswordRotationDue(r, r.Spec.ForProvider.PasswordRotation.GetInterval(), r.Status.AtProvider.PasswordRotatedAt), nil
 }
 
 // GetPassword fetches the referenced input password for an RDSInstance CRD and determines whether it has changed or not
@@ -789,6 +866,173 @@ func GetPassword(ctx context.Context, kube client.Client, in *xpv1.SecretKeySele
 	return newPwd, changed, nil
 }
 
+// ValidatePasswordRotation returns an error if the master password is rotated
+// with the supplied interval, but there is no secret to store it in. Master
+// passwords that are managed in Secrets Manager are rotated by RDS.
+func ValidatePasswordRotation(interval *metav1.Duration, managed bool, ref *xpv1.SecretKeySelector) error {
+	if interval == nil || interval.Duration <= 0 || managed || ref != nil {
+		return nil
+	}
+	return errors.New(errNoRotationSecretRef)
+}
+
+// IsPasswordRotationDue returns true if the master password of the supplied
+// managed resource, which was last rotated at the supplied time, was not
+// rotated within the supplied interval. The password of a resource that was
+// never rotated is as old as the resource.
+func IsPasswordRotationDue(mg resource.Managed, interval *metav1.Duration, rotatedAt *metav1.Time) bool {
+	if interval == nil || interval.Duration <= 0 {
+		return false
+	}
+	last := mg.GetCreationTimestamp()
+	if rotatedAt != nil {
+		last = *rotatedAt
+	}
+	return !time.Now().Before(last.Add(interval.Duration))
+}
+
+// StagePassword returns the password that is staged to replace the password
+// in the referenced secret. If none is staged, it generates a new password
+// and stages it in the secret, next to the current password. The staged
+// password is only committed with CommitPassword once the password of the
+// external resource was changed, so that the secret never contains a
+// password that does not work, and a rotation that failed after the password
+// of the external resource was changed is retried with the same password.
+func StagePassword(ctx context.Context, kube client.Client, ref *xpv1.SecretKeySelector) (string, error) {
+	if ref == nil {
+		return "", errors.New(errNoPasswordSecretRef)
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return "", errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	if pw := s.Data[ref.Key+stagedPasswordKeySuffix]; len(pw) > 0 {
+		return string(pw), nil
+	}
+	pw, err := password.Generate()
+	if err != nil {
+		return "", errors.Wrap(err, errGeneratePasswordFailed)
+	}
+	if s.Data == nil {
+		s.Data = map[string][]byte{}
+	}
+	s.Data[ref.Key+stagedPasswordKeySuffix] = []byte(pw)
+	if err := kube.Update(ctx, s); err != nil {
+		return "", errors.Wrap(err, errStagePasswordFailed)
+	}
+	return pw, nil
+}
+
+// CommitPassword replaces the password in the referenced secret with the
+// password staged by StagePassword, if any.
+func CommitPassword(ctx context.Context, kube client.Client, ref *xpv1.SecretKeySelector) error {
+	if ref == nil {
+		return nil
+	}
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, s); err != nil {
+		return errors.Wrap(err, errGetPasswordSecretFailed)
+	}
+	pw, ok := s.Data[ref.Key+stagedPasswordKeySuffix]
+	if !ok {
+		return nil
+	}
+	s.Data[ref.Key] = pw
+	delete(s.Data, ref.Key+stagedPasswordKeySuffix)
+	return errors.Wrap(kube.Update(ctx, s), errCommitPasswordFailed)
+}
+
+// SecretsManagerClient defines the Secrets Manager operations that are used to
+// read the master user secret managed by RDS.
+type SecretsManagerClient interface {
+	GetSecretValue(context.Context, *secretsmanager.GetSecretValueInput, ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error)
+}
+
+// NewSecretsManagerClient creates a new Secrets Manager client with the
+// provided AWS configuration.
+func NewSecretsManagerClient(cfg *aws.Config) SecretsManagerClient {
+	return secretsmanager.NewFromConfig(*cfg)
+}
+
+// NewSecretsManagerClientFor creates a new Secrets Manager client that uses
+// the AWS configuration of the supplied managed resource. The configuration
+// is only loaded when the client is used.
+func NewSecretsManagerClientFor(kube client.Client, mg resource.Managed, region string) SecretsManagerClient {
+	return &configuredSecretsManagerClient{kube: kube, mg: mg, region: region}
+}
+
+type configuredSecretsManagerClient struct {
+	kube   client.Client
+	mg     resource.Managed
+	region string
+}
+
+func (c *configuredSecretsManagerClient) GetSecretValue(ctx context.Context, in *secretsmanager.GetSecretValueInput, opts ...func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+	cfg, err := awsclients.GetConfig(ctx, c.kube, c.mg, c.region)
+	if err != nil {
+		return nil, err
+	}
+	return secretsmanager.NewFromConfig(*cfg).GetSecretValue(ctx, in, opts...)
+}
+
+// masterUserSecret is the content of the secret that RDS manages in Secrets
+// Manager for the master user.
+type masterUserSecret struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// ParseMasterUserSecret returns the username and password stored in the value
+// of a master user secret managed by RDS as connection details.
+func ParseMasterUserSecret(value string) (managed.ConnectionDetails, error) {
+	s := masterUserSecret{}
+	if err := json.Unmarshal([]byte(value), &s); err != nil {
+		return nil, errors.Wrap(err, errParseMasterUserSecret)
+	}
+	return managed.ConnectionDetails{
+		xpv1.ResourceCredentialsSecretUserKey:     []byte(s.Username),
+		xpv1.ResourceCredentialsSecretPasswordKey: []byte(s.Password),
+	}, nil
+}
+
+// GetMasterUserSecret reads the username and password from the master user
+// secret with the supplied ARN.
+func GetMasterUserSecret(ctx context.Context, client SecretsManagerClient, arn string) (managed.ConnectionDetails, error) {
+	out, err := client.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{SecretId: aws.String(arn)})
+	if err != nil {
+		return nil, errors.Wrap(err, errGetMasterUserSecret)
+	}
+	return ParseMasterUserSecret(aws.ToString(out.SecretString))
+}
+
+// AddMasterUserSecret adds the username and password from the master user
+// secret with the supplied ARN to the supplied connection details. The secret
+// is not read if the managed resource is being deleted. Errors are reported
+// in the MasterUserSecret condition of the managed resource instead of being
+// returned, so that the connection secret keeps the last known password and
+// the managed resource can still be reconciled and deleted.
+func AddMasterUserSecret(ctx context.Context, client SecretsManagerClient, mg resource.Managed, arn string, conn managed.ConnectionDetails) {
+	if arn == "" || meta.WasDeleted(mg) {
+		return
+	}
+	creds, err := GetMasterUserSecret(ctx, client, arn)
+	if err != nil {
+		mg.SetConditions(MasterUserSecretUnavailable(err))
+		return
+	}
+	mg.SetConditions(MasterUserSecretAvailable())
+	for k, v := range creds {
+		conn[k] = v
+	}
+}
+
+// IsMasterUserPasswordManagementChanged returns true if the desired
+// management of the master user password in Secrets Manager differs from
+// whether the master user secret is observed to be managed by RDS.
+func IsMasterUserPasswordManagementChanged(desired *bool, managed bool) bool {
+	return desired != nil && aws.ToBool(desired) != managed
+}
+
 // GetConnectionDetails extracts managed.ConnectionDetails out of v1beta1.RDSInstance.
 func GetConnectionDetails(in v1beta1.RDSInstance) managed.ConnectionDetails {
 	if in.Status.AtProvider.Endpoint.Address == "" {
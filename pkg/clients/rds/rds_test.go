@@ -25,8 +25,10 @@ import (
 	"github.com/aws/smithy-go/document"
 	"github.com/google/go-cmp/cmp/cmpopts"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -41,6 +43,7 @@ import (
 
 	"github.com/crossplane-contrib/provider-aws/apis/database/v1beta1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/rds/fake"
 )
 
 var (
@@ -555,6 +558,434 @@ func TestGetPassword(t *testing.T) {
 	}
 }
 
+func TestValidatePasswordRotation(t *testing.T) {
+	interval := &metav1.Duration{Duration: time.Hour}
+	ref := &xpv1.SecretKeySelector{Key: connectionSecretKey}
+
+	type args struct {
+		interval *metav1.Duration
+		managed  bool
+		ref      *xpv1.SecretKeySelector
+	}
+
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"NotRotated": {
+			args: args{},
+		},
+		"Rotated": {
+			args: args{interval: interval, ref: ref},
+		},
+		"RotatedByRDS": {
+			args: args{interval: interval, managed: true},
+		},
+		"NoSecretRef": {
+			args: args{interval: interval},
+			want: errors.New(errNoRotationSecretRef),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := ValidatePasswordRotation(tc.args.interval, tc.args.managed, tc.args.ref)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsPasswordRotationDue(t *testing.T) {
+	hourAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	type args struct {
+		created   metav1.Time
+		rotatedAt *metav1.Time
+		interval  *metav1.Duration
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NoInterval": {
+			args: args{
+				created: hourAgo,
+			},
+			want: false,
+		},
+		"NeverRotated": {
+			args: args{
+				created:  hourAgo,
+				interval: &metav1.Duration{Duration: time.Minute},
+			},
+			want: true,
+		},
+		"NeverRotatedNotDue": {
+			args: args{
+				created:  hourAgo,
+				interval: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			want: false,
+		},
+		"RotatedRecently": {
+			args: args{
+				created:   metav1.NewTime(time.Now().Add(-48 * time.Hour)),
+				rotatedAt: &hourAgo,
+				interval:  &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			want: false,
+		},
+		"RotatedLongAgo": {
+			args: args{
+				created:   metav1.NewTime(time.Now().Add(-48 * time.Hour)),
+				rotatedAt: &hourAgo,
+				interval:  &metav1.Duration{Duration: time.Minute},
+			},
+			want: true,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &v1beta1.RDSInstance{}
+			r.SetCreationTimestamp(tc.args.created)
+			if got := IsPasswordRotationDue(r, tc.args.interval, tc.args.rotatedAt); got != tc.want {
+				t.Errorf("IsPasswordRotationDue(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestStagePassword(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Key: connectionSecretKey,
+	}
+	stagedKey := connectionSecretKey + stagedPasswordKeySuffix
+	secret := func(data map[string][]byte) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		})
+	}
+
+	type want struct {
+		pw     string
+		staged bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		ref  *xpv1.SecretKeySelector
+		kube client.Client
+		want want
+	}{
+		"NoSecretRef": {
+			kube: &test.MockClient{},
+			want: want{
+				err: errors.New(errNoPasswordSecretRef),
+			},
+		},
+		"Staged": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet: secret(map[string][]byte{connectionSecretKey: []byte("old")}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					d := obj.(*corev1.Secret).Data
+					if string(d[connectionSecretKey]) != "old" || len(d[stagedKey]) == 0 {
+						t.Errorf("Update(...): want the current and the staged password, got %v", d)
+					}
+					return nil
+				}),
+			},
+			want: want{
+				staged: true,
+			},
+		},
+		"AlreadyStaged": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet: secret(map[string][]byte{connectionSecretKey: []byte("old"), stagedKey: []byte("new")}),
+			},
+			want: want{
+				pw: "new",
+			},
+		},
+		"GetFailed": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet: test.NewMockGetFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errGetPasswordSecretFailed),
+			},
+		},
+		"UpdateFailed": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet:    secret(nil),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errStagePasswordFailed),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			pw, err := StagePassword(context.Background(), tc.kube, tc.ref)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if tc.want.staged {
+				if pw == "" {
+					t.Errorf("r: want a generated password, got none")
+				}
+				return
+			}
+			if diff := cmp.Diff(tc.want.pw, pw); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCommitPassword(t *testing.T) {
+	errBoom := errors.New("boom")
+	ref := &xpv1.SecretKeySelector{
+		SecretReference: xpv1.SecretReference{
+			Name:      connectionSecretName,
+			Namespace: secretNamespace,
+		},
+		Key: connectionSecretKey,
+	}
+	stagedKey := connectionSecretKey + stagedPasswordKeySuffix
+	secret := func(data map[string][]byte) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.(*corev1.Secret).Data = data
+			return nil
+		})
+	}
+
+	cases := map[string]struct {
+		ref  *xpv1.SecretKeySelector
+		kube client.Client
+		want error
+	}{
+		"NoSecretRef": {
+			kube: &test.MockClient{},
+		},
+		"NothingStaged": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet: secret(map[string][]byte{connectionSecretKey: []byte("old")}),
+			},
+		},
+		"Committed": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet: secret(map[string][]byte{connectionSecretKey: []byte("old"), stagedKey: []byte("new")}),
+				MockUpdate: test.NewMockUpdateFn(nil, func(obj client.Object) error {
+					if diff := cmp.Diff(map[string][]byte{connectionSecretKey: []byte("new")}, obj.(*corev1.Secret).Data); diff != "" {
+						t.Errorf("Update(...): -want, +got:\n%s", diff)
+					}
+					return nil
+				}),
+			},
+		},
+		"UpdateFailed": {
+			ref: ref,
+			kube: &test.MockClient{
+				MockGet:    secret(map[string][]byte{stagedKey: []byte("new")}),
+				MockUpdate: test.NewMockUpdateFn(errBoom),
+			},
+			want: errors.Wrap(errBoom, errCommitPasswordFailed),
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := CommitPassword(context.Background(), tc.kube, tc.ref)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseMasterUserSecret(t *testing.T) {
+	type want struct {
+		conn managed.ConnectionDetails
+		err  error
+	}
+
+	cases := map[string]struct {
+		value string
+		want  want
+	}{
+		"Valid": {
+			value: `{"username":"admin","password":"` + connectionCredData + `"}`,
+			want: want{
+				conn: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte("admin"),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(connectionCredData),
+				},
+			},
+		},
+		"Invalid": {
+			value: "admin",
+			want: want{
+				err: errors.Wrap(errors.New("invalid character 'a' looking for beginning of value"), errParseMasterUserSecret),
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			conn, err := ParseMasterUserSecret(tc.value)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAddMasterUserSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	secretARN := "arn:aws:secretsmanager:us-east-1:123456789012:secret:rds!db-1234"
+	deleted := metav1.NewTime(time.Unix(0, 0))
+
+	type args struct {
+		client  SecretsManagerClient
+		deleted bool
+		arn     string
+	}
+	type want struct {
+		conn       managed.ConnectionDetails
+		conditions []xpv1.Condition
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Read": {
+			args: args{
+				client: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, in *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						if aws.ToString(in.SecretId) != secretARN {
+							return nil, errBoom
+						}
+						return &secretsmanager.GetSecretValueOutput{SecretString: aws.String(`{"username":"root","password":"secret"}`)}, nil
+					},
+				},
+				arn: secretARN,
+			},
+			want: want{
+				conn: managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretUserKey:     []byte("root"),
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte("secret"),
+				},
+				conditions: []xpv1.Condition{MasterUserSecretAvailable()},
+			},
+		},
+		"ReadFailed": {
+			args: args{
+				client: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, _ *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				arn: secretARN,
+			},
+			want: want{
+				conn:       managed.ConnectionDetails{},
+				conditions: []xpv1.Condition{MasterUserSecretUnavailable(errors.Wrap(errBoom, errGetMasterUserSecret))},
+			},
+		},
+		"Deleted": {
+			args: args{
+				client: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, _ *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				deleted: true,
+				arn:     secretARN,
+			},
+			want: want{
+				conn: managed.ConnectionDetails{},
+			},
+		},
+		"NoSecretARN": {
+			args: args{
+				client: &fake.MockSecretsManagerClient{},
+			},
+			want: want{
+				conn: managed.ConnectionDetails{},
+			},
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1beta1.RDSInstance{}
+			if tc.args.deleted {
+				cr.SetDeletionTimestamp(&deleted)
+			}
+			conn := managed.ConnectionDetails{}
+			AddMasterUserSecret(context.Background(), tc.args.client, cr, tc.args.arn, conn)
+			if diff := cmp.Diff(tc.want.conn, conn); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsMasterUserPasswordManagementChanged(t *testing.T) {
+	type args struct {
+		desired *bool
+		managed bool
+	}
+
+	cases := map[string]struct {
+		args args
+		want bool
+	}{
+		"NotSpecified": {
+			args: args{managed: true},
+			want: false,
+		},
+		"Enabled": {
+			args: args{desired: aws.Bool(true)},
+			want: true,
+		},
+		"Disabled": {
+			args: args{desired: aws.Bool(false), managed: true},
+			want: true,
+		},
+		"Unchanged": {
+			args: args{desired: aws.Bool(true), managed: true},
+			want: false,
+		},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := IsMasterUserPasswordManagementChanged(tc.args.desired, tc.args.managed); got != tc.want {
+				t.Errorf("IsMasterUserPasswordManagementChanged(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
 func TestGenerateObservation(t *testing.T) {
 	lastRestoreTime, createTime := time.Now(), time.Now()
 	rdsAz := rdstypes.AvailabilityZone{Name: &name}
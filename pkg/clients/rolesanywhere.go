@@ -0,0 +1,350 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	endpointsv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	// RolesAnywhereProviderName is the source of credentials obtained from
+	// IAM Roles Anywhere.
+	RolesAnywhereProviderName = "RolesAnywhereProvider"
+
+	rolesAnywhereService        = "rolesanywhere"
+	rolesAnywhereServiceID      = "RolesAnywhere"
+	rolesAnywhereAlgorithmRSA   = "AWS4-X509-RSA-SHA256"
+	rolesAnywhereAlgorithmECDSA = "AWS4-X509-ECDSA-SHA256"
+	rolesAnywhereTimeFormat     = "20060102T150405Z"
+	rolesAnywhereDateFormat     = "20060102"
+
+	errRolesAnywhereNotSet        = "rolesAnywhere must be set to use the RolesAnywhere credentials source"
+	errGetCertificateSecret       = "cannot get certificate secret"
+	errParseTrustAnchorARN        = "cannot parse trust anchor ARN"
+	errNoCertificate              = "no certificate found in the certificate secret"
+	errNoPrivateKey               = "no private key found in the certificate secret"
+	errParseCertificate           = "cannot parse certificate"
+	errParsePrivateKey            = "cannot parse private key"
+	errUnsupportedPrivateKey      = "unsupported private key type %T, must be RSA or ECDSA"
+	errResolveRolesAnywhere       = "cannot resolve IAM Roles Anywhere endpoint"
+	errCreateRolesAnywhereSession = "cannot create IAM Roles Anywhere session"
+	errNoRolesAnywhereCredential  = "IAM Roles Anywhere returned no credentials"
+)
+
+// extractRolesAnywhereCertificate returns the PEM encoded certificate and
+// private key referenced by the supplied options.
+func extractRolesAnywhereCertificate(ctx context.Context, c client.Client, o *v1beta1.RolesAnywhereCredentials) ([]byte, error) {
+	if o == nil {
+		return nil, errors.New(errRolesAnywhereNotSet)
+	}
+	s := &corev1.Secret{}
+	ref := o.CertificateSecretRef
+	if err := c.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, errors.Wrap(err, errGetCertificateSecret)
+	}
+	data := make([]byte, 0, len(s.Data[corev1.TLSCertKey])+len(s.Data[corev1.TLSPrivateKeyKey])+1)
+	data = append(data, s.Data[corev1.TLSCertKey]...)
+	data = append(data, '\n')
+	return append(data, s.Data[corev1.TLSPrivateKeyKey]...), nil
+}
+
+// A rolesAnywhereProvider retrieves temporary credentials from IAM Roles
+// Anywhere by creating a session that is signed with an X.509 certificate.
+// See https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-sign-process.html
+type rolesAnywhereProvider struct {
+	client   aws.HTTPClient
+	endpoint string
+	region   string
+
+	// certs is the certificate followed by any intermediate certificates.
+	certs []*x509.Certificate
+	key   crypto.Signer
+	input rolesAnywhereSessionInput
+
+	now func() time.Time
+}
+
+type rolesAnywhereSessionInput struct {
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+	ProfileARN      string `json:"profileArn"`
+	RoleARN         string `json:"roleArn"`
+	TrustAnchorARN  string `json:"trustAnchorArn"`
+}
+
+type rolesAnywhereSessionOutput struct {
+	CredentialSet []struct {
+		Credentials struct {
+			AccessKeyID     string    `json:"accessKeyId"`
+			SecretAccessKey string    `json:"secretAccessKey"`
+			SessionToken    string    `json:"sessionToken"`
+			Expiration      time.Time `json:"expiration"`
+		} `json:"credentials"`
+	} `json:"credentialSet"`
+}
+
+// newRolesAnywhereProvider returns a provider that obtains credentials as
+// configured by the supplied options, signing its requests with the PEM
+// encoded certificates and private key in the supplied data. Requests are
+// sent with the HTTP client and to the endpoint of the supplied config.
+func newRolesAnywhereProvider(data []byte, o *v1beta1.RolesAnywhereCredentials, cfg aws.Config) (*rolesAnywhereProvider, error) {
+	if o == nil {
+		return nil, errors.New(errRolesAnywhereNotSet)
+	}
+	a, err := arn.Parse(o.TrustAnchorARN)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseTrustAnchorARN)
+	}
+	certs, key, err := parseCertificateAndKey(data)
+	if err != nil {
+		return nil, err
+	}
+	e, err := resolveRolesAnywhereEndpoint(cfg, a.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errResolveRolesAnywhere)
+	}
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	p := &rolesAnywhereProvider{
+		client:   client,
+		endpoint: e.URL,
+		region:   e.SigningRegion,
+		certs:    certs,
+		key:      key,
+		input: rolesAnywhereSessionInput{
+			ProfileARN:     o.ProfileARN,
+			RoleARN:        o.RoleARN,
+			TrustAnchorARN: o.TrustAnchorARN,
+		},
+		now: time.Now,
+	}
+	if o.SessionDuration != nil {
+		p.input.DurationSeconds = aws.Int32(int32(o.SessionDuration.Duration / time.Second))
+	}
+	return p, nil
+}
+
+// resolveRolesAnywhereEndpoint resolves the IAM Roles Anywhere endpoint of
+// the supplied region with the endpoint resolver of the supplied config,
+// falling back to the default endpoint if the config has none.
+func resolveRolesAnywhereEndpoint(cfg aws.Config, region string) (aws.Endpoint, error) {
+	if cfg.EndpointResolverWithOptions != nil {
+		e, err := cfg.EndpointResolverWithOptions.ResolveEndpoint(rolesAnywhereServiceID, region)
+		nf := &aws.EndpointNotFoundError{}
+		if !errors.As(err, &nf) {
+			if e.SigningRegion == "" {
+				e.SigningRegion = region
+			}
+			return e, err
+		}
+	}
+	e, err := endpointsv1.DefaultResolver().EndpointFor(rolesAnywhereService, region, endpointsv1.ResolveUnknownServiceOption)
+	if err != nil {
+		return aws.Endpoint{}, err
+	}
+	return aws.Endpoint{URL: e.URL, SigningRegion: region}, nil
+}
+
+// Retrieve creates an IAM Roles Anywhere session and returns its
+// credentials.
+func (p *rolesAnywhereProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	body, err := json.Marshal(p.input)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(p.endpoint, "/")+"/sessions", bytes.NewReader(body))
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	if err := p.sign(req, body); err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	defer resp.Body.Close() //nolint:errcheck
+	rb, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return aws.Credentials{}, errors.Errorf("%s: %s: %s", errCreateRolesAnywhereSession, resp.Status, string(rb))
+	}
+	out := &rolesAnywhereSessionOutput{}
+	if err := json.Unmarshal(rb, out); err != nil {
+		return aws.Credentials{}, errors.Wrap(err, errCreateRolesAnywhereSession)
+	}
+	if len(out.CredentialSet) == 0 {
+		return aws.Credentials{}, errors.New(errNoRolesAnywhereCredential)
+	}
+	c := out.CredentialSet[0].Credentials
+	return aws.Credentials{
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		Source:          RolesAnywhereProviderName,
+		CanExpire:       true,
+		Expires:         c.Expiration,
+	}, nil
+}
+
+// sign signs the supplied request with the certificate and private key of
+// the provider.
+func (p *rolesAnywhereProvider) sign(req *http.Request, body []byte) error {
+	algorithm := rolesAnywhereAlgorithmRSA
+	if _, ok := p.key.(*ecdsa.PrivateKey); ok {
+		algorithm = rolesAnywhereAlgorithmECDSA
+	}
+
+	t := p.now().UTC()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Amz-Date", t.Format(rolesAnywhereTimeFormat))
+	req.Header.Set("X-Amz-X509", base64.StdEncoding.EncodeToString(p.certs[0].Raw))
+	if len(p.certs) > 1 {
+		chain := make([]string, len(p.certs)-1)
+		for i, c := range p.certs[1:] {
+			chain[i] = base64.StdEncoding.EncodeToString(c.Raw)
+		}
+		req.Header.Set("X-Amz-X509-Chain", strings.Join(chain, ","))
+	}
+
+	scope := strings.Join([]string{t.Format(rolesAnywhereDateFormat), p.region, rolesAnywhereService, "aws4_request"}, "/")
+	headers, signed := canonicalHeaders(req)
+	cr := strings.Join([]string{req.Method, req.URL.EscapedPath(), req.URL.RawQuery, headers, signed, hexSHA256(body)}, "\n")
+	sts := strings.Join([]string{algorithm, t.Format(rolesAnywhereTimeFormat), scope, hexSHA256([]byte(cr))}, "\n")
+
+	digest := sha256.Sum256([]byte(sts))
+	sig, err := p.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		algorithm, p.certs[0].SerialNumber.String(), scope, signed, hex.EncodeToString(sig)))
+	return nil
+}
+
+// canonicalHeaders returns the canonical headers and the signed headers of
+// the supplied request, as defined by AWS Signature Version 4.
+func canonicalHeaders(req *http.Request) (string, string) {
+	values := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		values[strings.ToLower(k)] = strings.Join(v, ",")
+	}
+	names := make([]string, 0, len(values))
+	for k := range values {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	b := &strings.Builder{}
+	for _, k := range names {
+		b.WriteString(k + ":" + strings.TrimSpace(values[k]) + "\n")
+	}
+	return b.String(), strings.Join(names, ";")
+}
+
+func hexSHA256(b []byte) string {
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:])
+}
+
+// parseCertificateAndKey parses the PEM encoded certificates and private key
+// in the supplied data. The first certificate is the one that identifies the
+// client, any others are intermediate certificates.
+func parseCertificateAndKey(data []byte) ([]*x509.Certificate, crypto.Signer, error) {
+	var certs []*x509.Certificate
+	var key crypto.Signer
+	for {
+		var b *pem.Block
+		b, data = pem.Decode(data)
+		if b == nil {
+			break
+		}
+		switch b.Type {
+		case "CERTIFICATE":
+			c, err := x509.ParseCertificate(b.Bytes)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, errParseCertificate)
+			}
+			certs = append(certs, c)
+		case "PRIVATE KEY", "RSA PRIVATE KEY", "EC PRIVATE KEY":
+			k, err := parsePrivateKey(b)
+			if err != nil {
+				return nil, nil, err
+			}
+			key = k
+		}
+	}
+	if len(certs) == 0 {
+		return nil, nil, errors.New(errNoCertificate)
+	}
+	if key == nil {
+		return nil, nil, errors.New(errNoPrivateKey)
+	}
+	return certs, key, nil
+}
+
+func parsePrivateKey(b *pem.Block) (crypto.Signer, error) {
+	switch b.Type {
+	case "RSA PRIVATE KEY":
+		k, err := x509.ParsePKCS1PrivateKey(b.Bytes)
+		return k, errors.Wrap(err, errParsePrivateKey)
+	case "EC PRIVATE KEY":
+		k, err := x509.ParseECPrivateKey(b.Bytes)
+		return k, errors.Wrap(err, errParsePrivateKey)
+	}
+	k, err := x509.ParsePKCS8PrivateKey(b.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, errParsePrivateKey)
+	}
+	switch k := k.(type) {
+	case *rsa.PrivateKey:
+		return k, nil
+	case *ecdsa.PrivateKey:
+		return k, nil
+	default:
+		return nil, errors.Errorf(errUnsupportedPrivateKey, k)
+	}
+}
@@ -0,0 +1,307 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const rolesAnywhereResponse = `{
+  "credentialSet": [{
+    "credentials": {
+      "accessKeyId": "akid",
+      "secretAccessKey": "secret",
+      "sessionToken": "token",
+      "expiration": "2022-07-12T21:00:00Z"
+    }
+  }]
+}`
+
+// newTestCertificate returns a self-signed PEM encoded certificate and
+// private key for the supplied key.
+func newTestCertificate(t *testing.T, key crypto.Signer, keyType string, keyBytes []byte) []byte {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(42),
+		Subject:      pkix.Name{CommonName: "crossplane"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	return append(data, pem.EncodeToMemory(&pem.Block{Type: keyType, Bytes: keyBytes})...)
+}
+
+// verifyRolesAnywhereSignature verifies the signature of the supplied
+// CreateSession request as described in
+// https://docs.aws.amazon.com/rolesanywhere/latest/userguide/authentication-sign-process.html
+func verifyRolesAnywhereSignature(r *http.Request, body []byte) error {
+	der, err := base64.StdEncoding.DecodeString(r.Header.Get("X-Amz-X509"))
+	if err != nil {
+		return err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return err
+	}
+	auth := r.Header.Get("Authorization")
+	var algorithm, credential, signedHeaders, signature string
+	if _, err := fmt.Sscanf(auth, "%s Credential=%s SignedHeaders=%s Signature=%s", &algorithm, &credential, &signedHeaders, &signature); err != nil {
+		return errors.Wrapf(err, "cannot parse Authorization header %q", auth)
+	}
+	credential = strings.TrimSuffix(credential, ",")
+	signedHeaders = strings.TrimSuffix(signedHeaders, ",")
+	if want := "content-type;host;x-amz-date;x-amz-x509"; signedHeaders != want {
+		return errors.Errorf("signed headers: want %q, got %q", want, signedHeaders)
+	}
+	scope := strings.SplitN(credential, "/", 2)
+	if scope[0] != cert.SerialNumber.String() {
+		return errors.Errorf("credential: want serial number %s, got %s", cert.SerialNumber, scope[0])
+	}
+	bodyHash := sha256.Sum256(body)
+	cr := fmt.Sprintf("POST\n/sessions\n\ncontent-type:%s\nhost:%s\nx-amz-date:%s\nx-amz-x509:%s\n\n%s\n%s",
+		r.Header.Get("Content-Type"), r.Host, r.Header.Get("X-Amz-Date"), r.Header.Get("X-Amz-X509"),
+		signedHeaders, hex.EncodeToString(bodyHash[:]))
+	crHash := sha256.Sum256([]byte(cr))
+	sts := strings.Join([]string{algorithm, r.Header.Get("X-Amz-Date"), scope[1], hex.EncodeToString(crHash[:])}, "\n")
+	digest := sha256.Sum256([]byte(sts))
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return err
+	}
+	switch k := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], sig)
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], sig) {
+			return errors.New("invalid ECDSA signature")
+		}
+	}
+	return nil
+}
+
+func TestRolesAnywhereProvider(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecBytes, err := x509.MarshalPKCS8PrivateKey(ecKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := &v1beta1.RolesAnywhereCredentials{
+		TrustAnchorARN:  "arn:aws:rolesanywhere:eu-west-1:123456789012:trust-anchor/ta",
+		ProfileARN:      "arn:aws:rolesanywhere:eu-west-1:123456789012:profile/p",
+		RoleARN:         "arn:aws:iam::123456789012:role/crossplane",
+		SessionDuration: &metav1.Duration{Duration: 2 * time.Hour},
+	}
+
+	type want struct {
+		algorithm string
+		creds     aws.Credentials
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason string
+		data   []byte
+		want   want
+	}{
+		"RSA": {
+			reason: "A session should be created with a request signed by an RSA key.",
+			data:   newTestCertificate(t, rsaKey, "RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(rsaKey)),
+			want: want{
+				algorithm: rolesAnywhereAlgorithmRSA,
+				creds: aws.Credentials{
+					AccessKeyID:     "akid",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Source:          RolesAnywhereProviderName,
+					CanExpire:       true,
+					Expires:         time.Date(2022, 7, 12, 21, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		"ECDSA": {
+			reason: "A session should be created with a request signed by an ECDSA key.",
+			data:   newTestCertificate(t, ecKey, "PRIVATE KEY", ecBytes),
+			want: want{
+				algorithm: rolesAnywhereAlgorithmECDSA,
+				creds: aws.Credentials{
+					AccessKeyID:     "akid",
+					SecretAccessKey: "secret",
+					SessionToken:    "token",
+					Source:          RolesAnywhereProviderName,
+					CanExpire:       true,
+					Expires:         time.Date(2022, 7, 12, 21, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		"NoPrivateKey": {
+			reason: "An error should be returned if the secret contains no private key.",
+			data:   newTestCertificate(t, rsaKey, "UNKNOWN", nil),
+			want: want{
+				err: errors.New(errNoPrivateKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var algorithm string
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if err := verifyRolesAnywhereSignature(r, body); err != nil {
+					t.Errorf("\n%s\nverifyRolesAnywhereSignature(...): %s", tc.reason, err)
+				}
+				in := rolesAnywhereSessionInput{}
+				_ = json.Unmarshal(body, &in)
+				if diff := cmp.Diff(rolesAnywhereSessionInput{
+					DurationSeconds: aws.Int32(7200),
+					ProfileARN:      opts.ProfileARN,
+					RoleARN:         opts.RoleARN,
+					TrustAnchorARN:  opts.TrustAnchorARN,
+				}, in); diff != "" {
+					t.Errorf("\n%s\nCreateSession(...): -want input, +got input:\n%s", tc.reason, diff)
+				}
+				algorithm = strings.Fields(r.Header.Get("Authorization"))[0]
+				fmt.Fprint(w, rolesAnywhereResponse)
+			}))
+			defer srv.Close()
+
+			client := &countingHTTPClient{client: srv.Client()}
+			cfg := aws.Config{
+				HTTPClient: client,
+				EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(_, _ string, _ ...interface{}) (aws.Endpoint, error) {
+					return aws.Endpoint{URL: srv.URL}, nil
+				}),
+			}
+			p, err := newRolesAnywhereProvider(tc.data, opts, cfg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nnewRolesAnywhereProvider(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+
+			creds, err := p.Retrieve(context.TODO())
+			if err != nil {
+				t.Fatalf("\n%s\nRetrieve(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.creds, creds); diff != "" {
+				t.Errorf("\n%s\nRetrieve(...): -want credentials, +got credentials:\n%s", tc.reason, diff)
+			}
+			if algorithm != tc.want.algorithm {
+				t.Errorf("\n%s\nRetrieve(...): want algorithm %s, got %s", tc.reason, tc.want.algorithm, algorithm)
+			}
+			if client.calls != 1 {
+				t.Errorf("\n%s\nRetrieve(...): want 1 request sent with the configured HTTP client, got %d", tc.reason, client.calls)
+			}
+		})
+	}
+}
+
+// A countingHTTPClient counts the requests it sends.
+type countingHTTPClient struct {
+	client *http.Client
+	calls  int
+}
+
+func (c *countingHTTPClient) Do(r *http.Request) (*http.Response, error) {
+	c.calls++
+	return c.client.Do(r)
+}
+
+func TestResolveRolesAnywhereEndpoint(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cfg    aws.Config
+		want   aws.Endpoint
+	}{
+		"Default": {
+			reason: "The default endpoint of the region should be used if the config has no endpoint resolver.",
+			want:   aws.Endpoint{URL: "https://rolesanywhere.eu-west-1.amazonaws.com", SigningRegion: "eu-west-1"},
+		},
+		"NotFound": {
+			reason: "The default endpoint of the region should be used if the endpoint resolver does not resolve it.",
+			cfg: aws.Config{
+				EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(_, _ string, _ ...interface{}) (aws.Endpoint, error) {
+					return aws.Endpoint{}, &aws.EndpointNotFoundError{}
+				}),
+			},
+			want: aws.Endpoint{URL: "https://rolesanywhere.eu-west-1.amazonaws.com", SigningRegion: "eu-west-1"},
+		},
+		"Custom": {
+			reason: "The endpoint configured by the ProviderConfig should be used.",
+			cfg: *SetResolver(&v1beta1.ProviderConfig{
+				Spec: v1beta1.ProviderConfigSpec{
+					Endpoint: &v1beta1.EndpointConfig{URL: v1beta1.URLConfig{Type: URLConfigTypeStatic, Static: aws.String("http://localstack:4566")}},
+				},
+			}, &aws.Config{}),
+			want: aws.Endpoint{URL: "http://localstack:4566", SigningRegion: "eu-west-1"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := resolveRolesAnywhereEndpoint(tc.cfg, "eu-west-1")
+			if err != nil {
+				t.Fatalf("\n%s\nresolveRolesAnywhereEndpoint(...): %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nresolveRolesAnywhereEndpoint(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
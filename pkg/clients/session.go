@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const (
+	// minSessionNameLength and maxSessionNameLength are the minimum and
+	// maximum length of role session names and source identities.
+	minSessionNameLength = 2
+	maxSessionNameLength = 64
+
+	errParseSessionTemplate  = "cannot parse role session template"
+	errRenderSessionTemplate = "cannot render role session template"
+	errSessionNameTooShort   = "rendered role session template %q is shorter than 2 characters"
+)
+
+// invalidSessionNameChars matches the characters that are not allowed in
+// role session names and source identities.
+var invalidSessionNameChars = regexp.MustCompile(`[^\w+=,.@-]`)
+
+// SessionParameters are the parameters role session name and source identity
+// templates can refer to.
+type SessionParameters struct {
+	// ProviderConfig is the name of the ProviderConfig.
+	ProviderConfig string
+
+	// Group is the API group of the managed resource.
+	Group string
+
+	// Kind is the kind of the managed resource.
+	Kind string
+
+	// Name is the name of the managed resource.
+	Name string
+}
+
+// RenderSessionTemplate renders the supplied role session name or source
+// identity template, replacing characters that are not allowed with a dash
+// and truncating the result to the maximum length. It returns an error if the
+// result is shorter than the minimum length.
+func RenderSessionTemplate(tmpl string, p SessionParameters) (string, error) {
+	t, err := template.New("session").Option("missingkey=error").Parse(tmpl)
+	if err != nil {
+		return "", errors.Wrap(err, errParseSessionTemplate)
+	}
+	b := &bytes.Buffer{}
+	if err := t.Execute(b, p); err != nil {
+		return "", errors.Wrap(err, errRenderSessionTemplate)
+	}
+	s := invalidSessionNameChars.ReplaceAllString(b.String(), "-")
+	if len(s) > maxSessionNameLength {
+		s = s[:maxSessionNameLength]
+	}
+	if len(s) < minSessionNameLength {
+		return "", errors.Errorf(errSessionNameTooShort, s)
+	}
+	return s, nil
+}
+
+// renderSessionTemplates returns a copy of the supplied ProviderConfig whose
+// role session names and source identities are rendered for the supplied
+// managed resource. The supplied ProviderConfig is returned as is if none of
+// them are templated.
+func renderSessionTemplates(pc *v1beta1.ProviderConfig, mg resource.Managed) (*v1beta1.ProviderConfig, error) {
+	if !hasSessionTemplates(pc) {
+		return pc, nil
+	}
+	gvk := mg.GetObjectKind().GroupVersionKind()
+	p := SessionParameters{
+		ProviderConfig: pc.GetName(),
+		Group:          gvk.Group,
+		Kind:           gvk.Kind,
+		Name:           mg.GetName(),
+	}
+	render := func(s *string) error {
+		if s == nil || *s == "" {
+			return nil
+		}
+		r, err := RenderSessionTemplate(*s, p)
+		*s = r
+		return err
+	}
+
+	out := pc.DeepCopy()
+	if ar := out.Spec.AssumeRole; ar != nil {
+		if err := render(ar.RoleSessionName); err != nil {
+			return nil, err
+		}
+		if err := render(ar.SourceIdentity); err != nil {
+			return nil, err
+		}
+	}
+	for i := range out.Spec.AssumeRoleChain {
+		if err := render(out.Spec.AssumeRoleChain[i].RoleSessionName); err != nil {
+			return nil, err
+		}
+		if err := render(out.Spec.AssumeRoleChain[i].SourceIdentity); err != nil {
+			return nil, err
+		}
+	}
+	if wi := out.Spec.AssumeRoleWithWebIdentity; wi != nil {
+		if err := render(&wi.RoleSessionName); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// sessionNames returns the role session names and source identities of the
+// supplied ProviderConfig.
+func sessionNames(pc *v1beta1.ProviderConfig) []string {
+	var names []string
+	if ar := pc.Spec.AssumeRole; ar != nil {
+		names = append(names, StringValue(ar.RoleSessionName), StringValue(ar.SourceIdentity))
+	}
+	for _, ar := range pc.Spec.AssumeRoleChain {
+		names = append(names, StringValue(ar.RoleSessionName), StringValue(ar.SourceIdentity))
+	}
+	if wi := pc.Spec.AssumeRoleWithWebIdentity; wi != nil {
+		names = append(names, wi.RoleSessionName)
+	}
+	return names
+}
+
+func hasSessionTemplates(pc *v1beta1.ProviderConfig) bool {
+	for _, n := range sessionNames(pc) {
+		if strings.Contains(n, "{{") {
+			return true
+		}
+	}
+	return false
+}
+
+// policyDescriptors returns the supplied managed IAM policy ARNs in the form
+// the STS API expects them.
+func policyDescriptors(arns []string) []ststypes.PolicyDescriptorType {
+	if len(arns) == 0 {
+		return nil
+	}
+	out := make([]ststypes.PolicyDescriptorType, len(arns))
+	for i := range arns {
+		out[i] = ststypes.PolicyDescriptorType{Arn: aws.String(arns[i])}
+	}
+	return out
+}
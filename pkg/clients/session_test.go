@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestRenderSessionTemplate(t *testing.T) {
+	p := SessionParameters{
+		ProviderConfig: "default",
+		Group:          "ec2.aws.crossplane.io",
+		Kind:           "SecurityGroup",
+		Name:           "my-sg",
+	}
+
+	type want struct {
+		name string
+		err  bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		tmpl   string
+		want   want
+	}{
+		"Literal": {
+			reason: "A session name without template actions should be returned as is.",
+			tmpl:   "crossplane",
+			want:   want{name: "crossplane"},
+		},
+		"Template": {
+			reason: "Template actions should be replaced with the session parameters.",
+			tmpl:   "{{.ProviderConfig}}-{{.Kind}}-{{.Name}}",
+			want:   want{name: "default-SecurityGroup-my-sg"},
+		},
+		"InvalidCharacters": {
+			reason: "Characters that are not allowed in session names should be replaced.",
+			tmpl:   "{{.Kind}}/{{.Name}} x",
+			want:   want{name: "SecurityGroup-my-sg-x"},
+		},
+		"TooLong": {
+			reason: "Session names should be truncated to 64 characters.",
+			tmpl:   strings.Repeat("a", 70),
+			want:   want{name: strings.Repeat("a", 64)},
+		},
+		"TooShort": {
+			reason: "Session names shorter than 2 characters should return an error.",
+			tmpl:   `{{printf "%.1s" .Name}}`,
+			want:   want{err: true},
+		},
+		"UnknownParameter": {
+			reason: "Templates that refer to unknown parameters should return an error.",
+			tmpl:   "{{.Namespace}}",
+			want:   want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := RenderSessionTemplate(tc.tmpl, p)
+			if (err != nil) != tc.want.err {
+				t.Fatalf("\n%s\nRenderSessionTemplate(...): unexpected error: %v", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("\n%s\nRenderSessionTemplate(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+// A kindedManaged is a fake managed resource that knows its kind.
+type kindedManaged struct {
+	fake.Managed
+	kind metav1.TypeMeta
+}
+
+func (m *kindedManaged) GetObjectKind() schema.ObjectKind { return &m.kind }
+
+func TestRenderSessionTemplates(t *testing.T) {
+	mg := &kindedManaged{Managed: fake.Managed{ObjectMeta: metav1.ObjectMeta{Name: "my-sg"}}}
+	mg.GetObjectKind().SetGroupVersionKind(schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1beta1", Kind: "SecurityGroup"})
+
+	tmpl := "{{.Kind}}-{{.Name}}"
+	pc := &v1beta1.ProviderConfig{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: v1beta1.ProviderConfigSpec{
+			AssumeRole:                &v1beta1.AssumeRoleOptions{RoleSessionName: &tmpl, SourceIdentity: &tmpl},
+			AssumeRoleChain:           []v1beta1.AssumeRoleOptions{{RoleSessionName: &tmpl}},
+			AssumeRoleWithWebIdentity: &v1beta1.AssumeRoleWithWebIdentityOptions{RoleSessionName: tmpl},
+		},
+	}
+
+	got, err := renderSessionTemplates(pc, mg)
+	if err != nil {
+		t.Fatalf("renderSessionTemplates(...): %s", err)
+	}
+	want := []string{"SecurityGroup-my-sg", "SecurityGroup-my-sg", "SecurityGroup-my-sg", "", "SecurityGroup-my-sg"}
+	if diff := cmp.Diff(want, sessionNames(got)); diff != "" {
+		t.Errorf("renderSessionTemplates(...): -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff(tmpl, *pc.Spec.AssumeRole.RoleSessionName); diff != "" {
+		t.Errorf("renderSessionTemplates(...): must not modify the supplied ProviderConfig: -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+// MockParameterClient is a type that implements all the methods for ParameterClient interface
+type MockParameterClient struct {
+	MockGetParameter           func(ctx context.Context, input *ssm.GetParameterInput, opts []func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	MockDescribeParameters     func(ctx context.Context, input *ssm.DescribeParametersInput, opts []func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
+	MockPutParameter           func(ctx context.Context, input *ssm.PutParameterInput, opts []func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+	MockDeleteParameter        func(ctx context.Context, input *ssm.DeleteParameterInput, opts []func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
+	MockListTagsForResource    func(ctx context.Context, input *ssm.ListTagsForResourceInput, opts []func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error)
+	MockAddTagsToResource      func(ctx context.Context, input *ssm.AddTagsToResourceInput, opts []func(*ssm.Options)) (*ssm.AddTagsToResourceOutput, error)
+	MockRemoveTagsFromResource func(ctx context.Context, input *ssm.RemoveTagsFromResourceInput, opts []func(*ssm.Options)) (*ssm.RemoveTagsFromResourceOutput, error)
+}
+
+// GetParameter mocks GetParameter method
+func (m *MockParameterClient) GetParameter(ctx context.Context, input *ssm.GetParameterInput, opts ...func(*ssm.Options)) (*ssm.GetParameterOutput, error) {
+	return m.MockGetParameter(ctx, input, opts)
+}
+
+// DescribeParameters mocks DescribeParameters method
+func (m *MockParameterClient) DescribeParameters(ctx context.Context, input *ssm.DescribeParametersInput, opts ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error) {
+	return m.MockDescribeParameters(ctx, input, opts)
+}
+
+// PutParameter mocks PutParameter method
+func (m *MockParameterClient) PutParameter(ctx context.Context, input *ssm.PutParameterInput, opts ...func(*ssm.Options)) (*ssm.PutParameterOutput, error) {
+	return m.MockPutParameter(ctx, input, opts)
+}
+
+// DeleteParameter mocks DeleteParameter method
+func (m *MockParameterClient) DeleteParameter(ctx context.Context, input *ssm.DeleteParameterInput, opts ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error) {
+	return m.MockDeleteParameter(ctx, input, opts)
+}
+
+// ListTagsForResource mocks ListTagsForResource method
+func (m *MockParameterClient) ListTagsForResource(ctx context.Context, input *ssm.ListTagsForResourceInput, opts ...func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error) {
+	return m.MockListTagsForResource(ctx, input, opts)
+}
+
+// AddTagsToResource mocks AddTagsToResource method
+func (m *MockParameterClient) AddTagsToResource(ctx context.Context, input *ssm.AddTagsToResourceInput, opts ...func(*ssm.Options)) (*ssm.AddTagsToResourceOutput, error) {
+	return m.MockAddTagsToResource(ctx, input, opts)
+}
+
+// RemoveTagsFromResource mocks RemoveTagsFromResource method
+func (m *MockParameterClient) RemoveTagsFromResource(ctx context.Context, input *ssm.RemoveTagsFromResourceInput, opts ...func(*ssm.Options)) (*ssm.RemoveTagsFromResourceOutput, error) {
+	return m.MockRemoveTagsFromResource(ctx, input, opts)
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// TypeValueSecret is the type of the condition that indicates whether the
+// Kubernetes secret that holds the value of the parameter could be read.
+const TypeValueSecret xpv1.ConditionType = "ValueSecret"
+
+// Reasons the value secret could or could not be read.
+const (
+	ReasonValueSecretAvailable   xpv1.ConditionReason = "ValueSecretAvailable"
+	ReasonValueSecretUnavailable xpv1.ConditionReason = "ValueSecretUnavailable"
+)
+
+// ValueSecretAvailable returns a condition that indicates that the value
+// secret was read.
+func ValueSecretAvailable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValueSecret,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValueSecretAvailable,
+	}
+}
+
+// ValueSecretUnavailable returns a condition that indicates that the value
+// secret could not be read because of the supplied error.
+func ValueSecretUnavailable(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeValueSecret,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonValueSecretUnavailable,
+		Message:            err.Error(),
+	}
+}
+
+// ParameterClient is the external client used for Parameter Custom Resource
+type ParameterClient interface {
+	GetParameter(ctx context.Context, input *ssm.GetParameterInput, opts ...func(*ssm.Options)) (*ssm.GetParameterOutput, error)
+	DescribeParameters(ctx context.Context, input *ssm.DescribeParametersInput, opts ...func(*ssm.Options)) (*ssm.DescribeParametersOutput, error)
+	PutParameter(ctx context.Context, input *ssm.PutParameterInput, opts ...func(*ssm.Options)) (*ssm.PutParameterOutput, error)
+	DeleteParameter(ctx context.Context, input *ssm.DeleteParameterInput, opts ...func(*ssm.Options)) (*ssm.DeleteParameterOutput, error)
+	ListTagsForResource(ctx context.Context, input *ssm.ListTagsForResourceInput, opts ...func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error)
+	AddTagsToResource(ctx context.Context, input *ssm.AddTagsToResourceInput, opts ...func(*ssm.Options)) (*ssm.AddTagsToResourceOutput, error)
+	RemoveTagsFromResource(ctx context.Context, input *ssm.RemoveTagsFromResourceInput, opts ...func(*ssm.Options)) (*ssm.RemoveTagsFromResourceOutput, error)
+}
+
+// NewParameterClient returns a new client using AWS credentials as JSON encoded data.
+func NewParameterClient(cfg aws.Config) ParameterClient {
+	return ssm.NewFromConfig(cfg)
+}
+
+// IsParameterNotFound returns true if the error code indicates that the
+// parameter was not found.
+func IsParameterNotFound(err error) bool {
+	var nfe *ssmtypes.ParameterNotFound
+	return errors.As(err, &nfe)
+}
+
+// GeneratePutParameterInput returns the input to create or overwrite the
+// parameter with the given name and value. Tags are only allowed when the
+// parameter is created.
+func GeneratePutParameterInput(name string, p *v1alpha1.ParameterParameters, value string) *ssm.PutParameterInput {
+	input := &ssm.PutParameterInput{
+		Name:           aws.String(name),
+		Value:          aws.String(value),
+		Type:           ssmtypes.ParameterType(p.Type),
+		Description:    p.Description,
+		Policies:       p.Policies,
+		AllowedPattern: p.AllowedPattern,
+		DataType:       p.DataType,
+	}
+	if p.Type == v1alpha1.ParameterTypeSecureString {
+		input.KeyId = p.KeyID
+	}
+	if p.Tier != nil {
+		input.Tier = ssmtypes.ParameterTier(aws.ToString(p.Tier))
+	}
+	if len(p.Tags) != 0 {
+		input.Tags = make([]ssmtypes.Tag, len(p.Tags))
+		for i, t := range p.Tags {
+			input.Tags[i] = ssmtypes.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+		}
+	}
+	return input
+}
+
+// LateInitialize fills the empty fields in *v1alpha1.ParameterParameters with
+// the values seen in ssmtypes.ParameterMetadata.
+func LateInitialize(in *v1alpha1.ParameterParameters, m ssmtypes.ParameterMetadata) {
+	in.Description = awsclients.LateInitializeStringPtr(in.Description, m.Description)
+	in.AllowedPattern = awsclients.LateInitializeStringPtr(in.AllowedPattern, m.AllowedPattern)
+	in.DataType = awsclients.LateInitializeStringPtr(in.DataType, m.DataType)
+	if m.Tier != "" {
+		in.Tier = awsclients.LateInitializeStringPtr(in.Tier, aws.String(string(m.Tier)))
+	}
+	if in.Type == v1alpha1.ParameterTypeSecureString {
+		in.KeyID = awsclients.LateInitializeStringPtr(in.KeyID, m.KeyId)
+	}
+}
+
+// GenerateObservation is used to produce ParameterObservation from the
+// parameter and its metadata.
+func GenerateObservation(p ssmtypes.Parameter, m ssmtypes.ParameterMetadata) v1alpha1.ParameterObservation {
+	o := v1alpha1.ParameterObservation{
+		ARN:     aws.ToString(p.ARN),
+		Version: p.Version,
+		Tier:    string(m.Tier),
+	}
+	if p.LastModifiedDate != nil {
+		o.LastModifiedDate = &metav1.Time{Time: *p.LastModifiedDate}
+	}
+	return o
+}
+
+// IsUpToDate checks whether the parameter in AWS matches the desired state
+// and value. Tags are compared separately with DiffTags.
+func IsUpToDate(in v1alpha1.ParameterParameters, p ssmtypes.Parameter, m ssmtypes.ParameterMetadata, value string) (bool, error) { // nolint:gocyclo
+	switch {
+	case aws.ToString(p.Value) != value,
+		string(p.Type) != in.Type,
+		aws.ToString(in.Description) != aws.ToString(m.Description),
+		aws.ToString(in.AllowedPattern) != aws.ToString(m.AllowedPattern),
+		in.DataType != nil && aws.ToString(in.DataType) != aws.ToString(m.DataType),
+		in.Type == v1alpha1.ParameterTypeSecureString && in.KeyID != nil && aws.ToString(in.KeyID) != aws.ToString(m.KeyId):
+		return false, nil
+	}
+	// NOTE: Intelligent-Tiering is resolved to either Standard or Advanced
+	// by AWS, so there is nothing to compare it with.
+	if in.Tier != nil && aws.ToString(in.Tier) != v1alpha1.ParameterTierIntelligentTiering && aws.ToString(in.Tier) != string(m.Tier) {
+		return false, nil
+	}
+	if in.Policies == nil {
+		return true, nil
+	}
+	return arePoliciesEqual(aws.ToString(in.Policies), m.Policies)
+}
+
+// arePoliciesEqual compares the JSON array of policies in the spec with the
+// policies attached to the parameter.
+func arePoliciesEqual(spec string, current []ssmtypes.ParameterInlinePolicy) (bool, error) {
+	var want []interface{}
+	if err := json.Unmarshal([]byte(spec), &want); err != nil {
+		return false, err
+	}
+	got := make([]interface{}, len(current))
+	for i, p := range current {
+		if err := json.Unmarshal([]byte(aws.ToString(p.PolicyText)), &got[i]); err != nil {
+			return false, err
+		}
+	}
+	return cmp.Equal(want, got, cmpopts.EquateEmpty()), nil
+}
+
+// DiffTags returns tags that should be added or removed.
+func DiffTags(spec []v1alpha1.Tag, current []ssmtypes.Tag) (add []ssmtypes.Tag, remove []string) {
+	addMap := make(map[string]string, len(spec))
+	for _, t := range spec {
+		addMap[t.Key] = t.Value
+	}
+	for _, t := range current {
+		if v, ok := addMap[aws.ToString(t.Key)]; ok && v == aws.ToString(t.Value) {
+			delete(addMap, aws.ToString(t.Key))
+			continue
+		}
+		if _, ok := addMap[aws.ToString(t.Key)]; !ok {
+			remove = append(remove, aws.ToString(t.Key))
+		}
+	}
+	for _, t := range spec {
+		if v, ok := addMap[t.Key]; ok {
+			add = append(add, ssmtypes.Tag{Key: aws.String(t.Key), Value: aws.String(v)})
+		}
+	}
+	return add, remove
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ssm
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+)
+
+func params(m ...func(*v1alpha1.ParameterParameters)) v1alpha1.ParameterParameters {
+	p := v1alpha1.ParameterParameters{
+		Type:        v1alpha1.ParameterTypeSecureString,
+		Description: aws.String("db password"),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func TestIsUpToDate(t *testing.T) {
+	policy := `{"Type":"Expiration","Version":"1.0","Attributes":{"Timestamp":"2030-01-01T00:00:00.000Z"}}`
+
+	type args struct {
+		spec  v1alpha1.ParameterParameters
+		p     ssmtypes.Parameter
+		m     ssmtypes.ParameterMetadata
+		value string
+	}
+	type want struct {
+		upToDate bool
+		err      bool
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"UpToDate": {
+			args: args{
+				spec:  params(func(p *v1alpha1.ParameterParameters) { p.Tier = aws.String(v1alpha1.ParameterTierStandard) }),
+				p:     ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m:     ssmtypes.ParameterMetadata{Description: aws.String("db password"), Tier: ssmtypes.ParameterTierStandard, KeyId: aws.String("alias/aws/ssm")},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: true},
+		},
+		"DifferentValue": {
+			args: args{
+				spec:  params(),
+				p:     ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("old")},
+				m:     ssmtypes.ParameterMetadata{Description: aws.String("db password")},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: false},
+		},
+		"DifferentKMSKey": {
+			args: args{
+				spec:  params(func(p *v1alpha1.ParameterParameters) { p.KeyID = aws.String("alias/my-key") }),
+				p:     ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m:     ssmtypes.ParameterMetadata{Description: aws.String("db password"), KeyId: aws.String("alias/aws/ssm")},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: false},
+		},
+		"IntelligentTiering": {
+			args: args{
+				spec:  params(func(p *v1alpha1.ParameterParameters) { p.Tier = aws.String(v1alpha1.ParameterTierIntelligentTiering) }),
+				p:     ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m:     ssmtypes.ParameterMetadata{Description: aws.String("db password"), Tier: ssmtypes.ParameterTierAdvanced},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: true},
+		},
+		"SamePolicies": {
+			args: args{
+				spec: params(func(p *v1alpha1.ParameterParameters) {
+					p.Policies = aws.String("[" + policy + "]")
+				}),
+				p: ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m: ssmtypes.ParameterMetadata{
+					Description: aws.String("db password"),
+					Policies: []ssmtypes.ParameterInlinePolicy{{
+						PolicyText: aws.String(`{"Version":"1.0","Type":"Expiration","Attributes":{"Timestamp":"2030-01-01T00:00:00.000Z"}}`),
+					}},
+				},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: true},
+		},
+		"PolicyRemoved": {
+			args: args{
+				spec: params(func(p *v1alpha1.ParameterParameters) { p.Policies = aws.String("[]") }),
+				p:    ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m: ssmtypes.ParameterMetadata{
+					Description: aws.String("db password"),
+					Policies:    []ssmtypes.ParameterInlinePolicy{{PolicyText: aws.String(policy)}},
+				},
+				value: "s3cr3t",
+			},
+			want: want{upToDate: false},
+		},
+		"InvalidPolicies": {
+			args: args{
+				spec:  params(func(p *v1alpha1.ParameterParameters) { p.Policies = aws.String("{") }),
+				p:     ssmtypes.Parameter{Type: ssmtypes.ParameterTypeSecureString, Value: aws.String("s3cr3t")},
+				m:     ssmtypes.ParameterMetadata{Description: aws.String("db password")},
+				value: "s3cr3t",
+			},
+			want: want{err: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := IsUpToDate(tc.args.spec, tc.args.p, tc.args.m, tc.args.value)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.upToDate, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	cases := map[string]struct {
+		spec v1alpha1.ParameterParameters
+		m    ssmtypes.ParameterMetadata
+		want v1alpha1.ParameterParameters
+	}{
+		"SecureString": {
+			spec: v1alpha1.ParameterParameters{Type: v1alpha1.ParameterTypeSecureString},
+			m: ssmtypes.ParameterMetadata{
+				DataType: aws.String("text"),
+				KeyId:    aws.String("alias/aws/ssm"),
+				Tier:     ssmtypes.ParameterTierStandard,
+			},
+			want: v1alpha1.ParameterParameters{
+				Type:     v1alpha1.ParameterTypeSecureString,
+				DataType: aws.String("text"),
+				KeyID:    aws.String("alias/aws/ssm"),
+				Tier:     aws.String(v1alpha1.ParameterTierStandard),
+			},
+		},
+		"KeepSpec": {
+			spec: v1alpha1.ParameterParameters{
+				Type: v1alpha1.ParameterTypeString,
+				Tier: aws.String(v1alpha1.ParameterTierIntelligentTiering),
+			},
+			m: ssmtypes.ParameterMetadata{
+				Description: aws.String("from aws"),
+				Tier:        ssmtypes.ParameterTierAdvanced,
+			},
+			want: v1alpha1.ParameterParameters{
+				Type:        v1alpha1.ParameterTypeString,
+				Description: aws.String("from aws"),
+				Tier:        aws.String(v1alpha1.ParameterTierIntelligentTiering),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			LateInitialize(&tc.spec, tc.m)
+			if diff := cmp.Diff(tc.want, tc.spec); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffTags(t *testing.T) {
+	type want struct {
+		add    []ssmtypes.Tag
+		remove []string
+	}
+
+	cases := map[string]struct {
+		spec    []v1alpha1.Tag
+		current []ssmtypes.Tag
+		want
+	}{
+		"NoChange": {
+			spec:    []v1alpha1.Tag{{Key: "team", Value: "a"}},
+			current: []ssmtypes.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+		},
+		"AddUpdateAndRemove": {
+			spec: []v1alpha1.Tag{{Key: "team", Value: "b"}, {Key: "env", Value: "prod"}},
+			current: []ssmtypes.Tag{
+				{Key: aws.String("team"), Value: aws.String("a")},
+				{Key: aws.String("old"), Value: aws.String("x")},
+			},
+			want: want{
+				add: []ssmtypes.Tag{
+					{Key: aws.String("team"), Value: aws.String("b")},
+					{Key: aws.String("env"), Value: aws.String("prod")},
+				},
+				remove: []string{"old"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := DiffTags(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(ssmtypes.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -29,7 +29,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -40,6 +39,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acm"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -69,12 +69,13 @@ func SetupCertificate(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.Certificate{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acm.NewClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{client: mgr.GetClient(), newClientFn: acm.NewClient})),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
@@ -29,7 +29,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -40,6 +39,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acmpca"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -69,12 +69,13 @@ func SetupCertificateAuthority(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.CertificateAuthority{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{client: mgr.GetClient(), newClientFn: acmpca.NewClient})),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -29,7 +29,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -40,6 +39,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/acmpca"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -61,12 +61,13 @@ func SetupCertificateAuthorityPermission(mgr ctrl.Manager, o controller.Options)
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.CertificateAuthorityPermission{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.CertificateAuthorityPermissionGroupVersionKind),
-			managed.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{client: mgr.GetClient(), newClientFn: acmpca.NewCAPermissionClient})),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
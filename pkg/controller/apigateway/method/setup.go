@@ -7,7 +7,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/errors"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
@@ -18,6 +17,7 @@ import (
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/apigateway/v1alpha1"
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	apigwclient "github.com/crossplane-contrib/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 // SetupMethod adds a controller that reconciles Method.
@@ -39,12 +39,13 @@ func SetupMethod(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Method{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.MethodGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler)))
@@ -22,7 +22,6 @@ import (
 
 	svcsdk "github.com/aws/aws-sdk-go/service/apigateway"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -35,6 +34,7 @@ import (
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/apigateway/v1alpha1"
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	apigwclient "github.com/crossplane-contrib/provider-aws/pkg/clients/apigateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 // SetupResource adds a controller that reconciles Resource.
@@ -58,12 +58,13 @@ func SetupResource(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Resource{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.ResourceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithInitializers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler)))
@@ -0,0 +1,313 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject   = "managed resource is not an AutoScalingGroup resource"
+	errCreateSession      = "cannot create a new session"
+	errDescribe           = "cannot describe Auto Scaling group"
+	errNotFound           = "cannot find Auto Scaling group"
+	errDescribeRefreshes  = "cannot describe instance refreshes of Auto Scaling group"
+	errCreate             = "cannot create Auto Scaling group"
+	errUpdate             = "cannot update Auto Scaling group"
+	errAttachTargetGroups = "cannot attach target groups to Auto Scaling group"
+	errDetachTargetGroups = "cannot detach target groups from Auto Scaling group"
+	errCreateTags         = "cannot create or update tags of Auto Scaling group"
+	errDeleteTags         = "cannot delete tags of Auto Scaling group"
+	errStartRefresh       = "cannot start instance refresh of Auto Scaling group"
+	errScaleToZero        = "cannot scale Auto Scaling group to zero"
+	errDelete             = "cannot delete Auto Scaling group"
+	errFmtRefreshEnded    = "instance refresh %s to the desired launch template ended with status %s: %s"
+)
+
+// SetupAutoScalingGroup adds a controller that reconciles AutoScalingGroup.
+func SetupAutoScalingGroup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.AutoScalingGroupGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&v1alpha1.AutoScalingGroup{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.AutoScalingGroupGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: autoscaling.NewClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) autoscaling.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client autoscaling.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	g, err := e.describe(ctx, name)
+	if err != nil || g == nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	autoscaling.LateInitializeAutoScalingGroup(&cr.Spec.ForProvider, g)
+
+	cr.Status.AtProvider = autoscaling.GenerateAutoScalingGroupObservation(g)
+	if cr.Spec.ForProvider.InstanceRefresh != nil {
+		r, err := e.latestInstanceRefresh(ctx, name)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.LatestInstanceRefresh = autoscaling.GenerateInstanceRefreshObservation(r)
+	}
+
+	// NOTE: Status is only set while the group is being deleted.
+	if g.Status != nil {
+		cr.SetConditions(xpv1.Deleting())
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	attach, detach := autoscaling.DiffTargetGroups(cr.Spec.ForProvider.TargetGroupARNs, g.TargetGroupARNs)
+	add, remove := autoscaling.DiffTags(name, cr.Spec.ForProvider.Tags, g.Tags)
+
+	return managed.ExternalObservation{
+		ResourceExists: true,
+		ResourceUpToDate: autoscaling.IsAutoScalingGroupUpToDate(cr.Spec.ForProvider, g) &&
+			len(attach) == 0 && len(detach) == 0 && len(add) == 0 && len(remove) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	_, err := e.client.CreateAutoScalingGroupWithContext(ctx, autoscaling.GenerateCreateAutoScalingGroupInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+
+	g, err := e.describe(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if g == nil {
+		return managed.ExternalUpdate{}, errors.New(errNotFound)
+	}
+
+	input := autoscaling.GenerateUpdateAutoScalingGroupInput(name, &cr.Spec.ForProvider)
+
+	// NOTE: When an instance refresh is configured, the launch template is
+	// changed by the instance refresh instead, which replaces the instances
+	// and updates the group once it succeeds.
+	var refreshErr error
+	startRefresh := false
+	if cr.Spec.ForProvider.InstanceRefresh != nil && !autoscaling.IsLaunchTemplateUpToDate(cr.Spec.ForProvider.LaunchTemplate, g.LaunchTemplate) {
+		input.LaunchTemplate = nil
+		r, err := e.latestInstanceRefresh(ctx, name)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		switch {
+		case autoscaling.IsInstanceRefreshActive(r):
+			// Wait for the running instance refresh to finish.
+		case autoscaling.IsInstanceRefreshEnded(r) && r.DesiredConfiguration != nil &&
+			autoscaling.IsLaunchTemplateUpToDate(cr.Spec.ForProvider.LaunchTemplate, r.DesiredConfiguration.LaunchTemplate):
+			// Do not retry a failed or cancelled instance refresh to the same
+			// launch template over and over again.
+			refreshErr = errors.Errorf(errFmtRefreshEnded, aws.StringValue(r.InstanceRefreshId), aws.StringValue(r.Status), aws.StringValue(r.StatusReason))
+		default:
+			startRefresh = true
+		}
+	}
+
+	if !autoscaling.IsAutoScalingGroupUpToDate(cr.Spec.ForProvider, g) {
+		if _, err := e.client.UpdateAutoScalingGroupWithContext(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	attach, detach := autoscaling.DiffTargetGroups(cr.Spec.ForProvider.TargetGroupARNs, g.TargetGroupARNs)
+	if len(detach) != 0 {
+		if _, err := e.client.DetachLoadBalancerTargetGroupsWithContext(ctx, &svcsdk.DetachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      detach,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDetachTargetGroups)
+		}
+	}
+	if len(attach) != 0 {
+		if _, err := e.client.AttachLoadBalancerTargetGroupsWithContext(ctx, &svcsdk.AttachLoadBalancerTargetGroupsInput{
+			AutoScalingGroupName: aws.String(name),
+			TargetGroupARNs:      attach,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAttachTargetGroups)
+		}
+	}
+
+	add, remove := autoscaling.DiffTags(name, cr.Spec.ForProvider.Tags, g.Tags)
+	if len(remove) != 0 {
+		if _, err := e.client.DeleteTagsWithContext(ctx, &svcsdk.DeleteTagsInput{Tags: remove}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.CreateOrUpdateTagsWithContext(ctx, &svcsdk.CreateOrUpdateTagsInput{Tags: add}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	if startRefresh {
+		if _, err := e.client.StartInstanceRefreshWithContext(ctx, autoscaling.GenerateStartInstanceRefreshInput(name, &cr.Spec.ForProvider)); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errStartRefresh)
+		}
+	}
+	return managed.ExternalUpdate{}, refreshErr
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.AutoScalingGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// NOTE: A deletion is already in progress.
+	if cr.Status.AtProvider.Status != "" {
+		return nil
+	}
+
+	name := meta.GetExternalName(cr)
+	force := aws.BoolValue(cr.Spec.ForProvider.ForceDelete)
+
+	// A group cannot be deleted while it has instances unless it is forced,
+	// so it is scaled to zero first and deleted once its instances are gone.
+	if !force && (len(cr.Status.AtProvider.Instances) != 0 || cr.Status.AtProvider.DesiredCapacity != 0) {
+		_, err := e.client.UpdateAutoScalingGroupWithContext(ctx, &svcsdk.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(name),
+			MinSize:              aws.Int64(0),
+			MaxSize:              aws.Int64(0),
+			DesiredCapacity:      aws.Int64(0),
+		})
+		return awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errScaleToZero)
+	}
+
+	_, err := e.client.DeleteAutoScalingGroupWithContext(ctx, &svcsdk.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		ForceDelete:          aws.Bool(force),
+	})
+	return awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errDelete)
+}
+
+// describe returns the group with the given name or nil if it does not exist.
+func (e *external) describe(ctx context.Context, name string) (*svcsdk.Group, error) {
+	resp, err := e.client.DescribeAutoScalingGroupsWithContext(ctx, &svcsdk.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []*string{aws.String(name)},
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribe)
+	}
+	if len(resp.AutoScalingGroups) == 0 {
+		return nil, nil
+	}
+	return resp.AutoScalingGroups[0], nil
+}
+
+// latestInstanceRefresh returns the most recent instance refresh of the group
+// with the given name, or nil if there is none.
+func (e *external) latestInstanceRefresh(ctx context.Context, name string) (*svcsdk.InstanceRefresh, error) {
+	// Instance refreshes are returned from the most recent to the oldest.
+	resp, err := e.client.DescribeInstanceRefreshesWithContext(ctx, &svcsdk.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int64(1),
+	})
+	if err != nil {
+		return nil, awsclient.Wrap(err, errDescribeRefreshes)
+	}
+	if len(resp.InstanceRefreshes) == 0 {
+		return nil, nil
+	}
+	return resp.InstanceRefreshes[0], nil
+}
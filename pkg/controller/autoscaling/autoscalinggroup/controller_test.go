@@ -0,0 +1,651 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autoscalinggroup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	groupName      = "web"
+	groupARN       = "arn:aws:autoscaling:us-east-1:123456789012:autoScalingGroup:1234:autoScalingGroupName/web"
+	tgARN          = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/web/1"
+	refreshID      = "08b91cf7-8fa6-48af-b6a6-d227f40f1b9b"
+	created        = time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	errBoom        = errors.New("boom")
+	notFound       = awserr.New("ValidationError", "AutoScalingGroup name not found - AutoScalingGroup web not found", nil)
+)
+
+type groupModifier func(*v1alpha1.AutoScalingGroup)
+
+func withExternalName(n string) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(f func(*v1alpha1.AutoScalingGroupParameters)) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { f(&r.Spec.ForProvider) }
+}
+
+func withObservation(o v1alpha1.AutoScalingGroupObservation) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) groupModifier {
+	return func(r *v1alpha1.AutoScalingGroup) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withRefresh(r *v1alpha1.AutoScalingGroupParameters) {
+	r.InstanceRefresh = &v1alpha1.InstanceRefresh{MinHealthyPercentage: aws.Int64(50)}
+}
+
+func withVersion(v string) func(*v1alpha1.AutoScalingGroupParameters) {
+	return func(r *v1alpha1.AutoScalingGroupParameters) { r.LaunchTemplate.Version = aws.String(v) }
+}
+
+func lateInitialized(r *v1alpha1.AutoScalingGroupParameters) {
+	r.HealthCheckType = aws.String("EC2")
+	r.DefaultCooldown = aws.Int64(300)
+	r.TerminationPolicies = []string{"Default"}
+}
+
+func asg(m ...groupModifier) *v1alpha1.AutoScalingGroup {
+	cr := &v1alpha1.AutoScalingGroup{
+		Spec: v1alpha1.AutoScalingGroupSpec{
+			ForProvider: v1alpha1.AutoScalingGroupParameters{
+				Region: "us-east-1",
+				LaunchTemplate: v1alpha1.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("web"),
+					Version:            aws.String("1"),
+				},
+				MinSize:         1,
+				MaxSize:         3,
+				SubnetIDs:       []string{"subnet-a", "subnet-b"},
+				TargetGroupARNs: []string{tgARN},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedGroup(m ...func(*svcsdk.Group)) *svcsdk.Group {
+	g := &svcsdk.Group{
+		AutoScalingGroupARN:  aws.String(groupARN),
+		AutoScalingGroupName: aws.String(groupName),
+		LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+			LaunchTemplateId:   aws.String("lt-0123456789"),
+			LaunchTemplateName: aws.String("web"),
+			Version:            aws.String("1"),
+		},
+		MinSize:             aws.Int64(1),
+		MaxSize:             aws.Int64(3),
+		DesiredCapacity:     aws.Int64(1),
+		VPCZoneIdentifier:   aws.String("subnet-a,subnet-b"),
+		TargetGroupARNs:     []*string{aws.String(tgARN)},
+		HealthCheckType:     aws.String("EC2"),
+		DefaultCooldown:     aws.Int64(300),
+		TerminationPolicies: []*string{aws.String("Default")},
+		CreatedTime:         &created,
+		Instances: []*svcsdk.Instance{{
+			InstanceId:           aws.String("i-0123456789"),
+			InstanceType:         aws.String("t3.micro"),
+			AvailabilityZone:     aws.String("us-east-1a"),
+			LifecycleState:       aws.String("InService"),
+			HealthStatus:         aws.String("Healthy"),
+			ProtectedFromScaleIn: aws.Bool(false),
+			LaunchTemplate:       &svcsdk.LaunchTemplateSpecification{Version: aws.String("1")},
+		}},
+	}
+	for _, f := range m {
+		f(g)
+	}
+	return g
+}
+
+func observation() v1alpha1.AutoScalingGroupObservation {
+	return v1alpha1.AutoScalingGroupObservation{
+		ARN:             groupARN,
+		DesiredCapacity: 1,
+		LaunchTemplate: &v1alpha1.LaunchTemplateObservation{
+			LaunchTemplateID:   "lt-0123456789",
+			LaunchTemplateName: "web",
+			Version:            "1",
+		},
+		Instances: []v1alpha1.InstanceObservation{{
+			InstanceID:            "i-0123456789",
+			InstanceType:          "t3.micro",
+			AvailabilityZone:      "us-east-1a",
+			LifecycleState:        "InService",
+			HealthStatus:          "Healthy",
+			LaunchTemplateVersion: "1",
+		}},
+		CreatedTime: &metav1.Time{Time: created},
+	}
+}
+
+func mockDescribe(g ...*svcsdk.Group) func(aws.Context, *svcsdk.DescribeAutoScalingGroupsInput, []request.Option) (*svcsdk.DescribeAutoScalingGroupsOutput, error) {
+	return func(aws.Context, *svcsdk.DescribeAutoScalingGroupsInput, []request.Option) (*svcsdk.DescribeAutoScalingGroupsOutput, error) {
+		return &svcsdk.DescribeAutoScalingGroupsOutput{AutoScalingGroups: g}, nil
+	}
+}
+
+func mockDescribeRefreshes(r ...*svcsdk.InstanceRefresh) func(aws.Context, *svcsdk.DescribeInstanceRefreshesInput, []request.Option) (*svcsdk.DescribeInstanceRefreshesOutput, error) {
+	return func(aws.Context, *svcsdk.DescribeInstanceRefreshesInput, []request.Option) (*svcsdk.DescribeInstanceRefreshesOutput, error) {
+		return &svcsdk.DescribeInstanceRefreshesOutput{InstanceRefreshes: r}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeAutoScalingGroups: mockDescribe()},
+			cr:     asg(withExternalName(groupName)),
+			want: want{
+				cr: asg(withExternalName(groupName)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeAutoScalingGroups: func(aws.Context, *svcsdk.DescribeAutoScalingGroupsInput, []request.Option) (*svcsdk.DescribeAutoScalingGroupsOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: asg(withExternalName(groupName)),
+			want: want{
+				cr:  asg(withExternalName(groupName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeAutoScalingGroups: mockDescribe(observedGroup())},
+			cr:     asg(withExternalName(groupName)),
+			want: want{
+				cr: asg(withExternalName(groupName), withSpec(lateInitialized),
+					withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"TargetGroupChanged": {
+			client: &fake.MockClient{
+				MockDescribeAutoScalingGroups: mockDescribe(observedGroup(func(g *svcsdk.Group) { g.TargetGroupARNs = nil })),
+			},
+			cr: asg(withExternalName(groupName), withSpec(lateInitialized)),
+			want: want{
+				cr: asg(withExternalName(groupName), withSpec(lateInitialized),
+					withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"InstanceRefreshObserved": {
+			client: &fake.MockClient{
+				MockDescribeAutoScalingGroups: mockDescribe(observedGroup()),
+				MockDescribeInstanceRefreshes: mockDescribeRefreshes(&svcsdk.InstanceRefresh{
+					InstanceRefreshId:  aws.String(refreshID),
+					Status:             aws.String(svcsdk.InstanceRefreshStatusInProgress),
+					PercentageComplete: aws.Int64(50),
+					StartTime:          &created,
+				}),
+			},
+			cr: asg(withExternalName(groupName), withSpec(lateInitialized), withSpec(withRefresh), withSpec(withVersion("2"))),
+			want: want{
+				cr: asg(withExternalName(groupName), withSpec(lateInitialized), withSpec(withRefresh), withSpec(withVersion("2")),
+					withObservation(func() v1alpha1.AutoScalingGroupObservation {
+						o := observation()
+						o.LatestInstanceRefresh = &v1alpha1.InstanceRefreshObservation{
+							InstanceRefreshID:  refreshID,
+							Status:             svcsdk.InstanceRefreshStatusInProgress,
+							PercentageComplete: 50,
+							StartTime:          &metav1.Time{Time: created},
+						}
+						return o
+					}()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleting": {
+			client: &fake.MockClient{
+				MockDescribeAutoScalingGroups: mockDescribe(observedGroup(func(g *svcsdk.Group) { g.Status = aws.String("Delete in progress") })),
+			},
+			cr: asg(withExternalName(groupName), withSpec(lateInitialized)),
+			want: want{
+				cr: asg(withExternalName(groupName), withSpec(lateInitialized),
+					withObservation(func() v1alpha1.AutoScalingGroupObservation {
+						o := observation()
+						o.Status = "Delete in progress"
+						return o
+					}()), withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		input *svcsdk.CreateAutoScalingGroupInput
+		err   error
+	}
+
+	var got *svcsdk.CreateAutoScalingGroupInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockClient{
+				MockCreateAutoScalingGroup: func(_ aws.Context, in *svcsdk.CreateAutoScalingGroupInput, _ []request.Option) (*svcsdk.CreateAutoScalingGroupOutput, error) {
+					got = in
+					return &svcsdk.CreateAutoScalingGroupOutput{}, nil
+				},
+			},
+			cr: asg(withExternalName(groupName), withSpec(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.Tags = []v1alpha1.Tag{{Key: "team", Value: "web", PropagateAtLaunch: aws.Bool(true)}}
+			})),
+			want: want{
+				input: &svcsdk.CreateAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String(groupName),
+					LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+						LaunchTemplateName: aws.String("web"),
+						Version:            aws.String("1"),
+					},
+					MinSize:           aws.Int64(1),
+					MaxSize:           aws.Int64(3),
+					VPCZoneIdentifier: aws.String("subnet-a,subnet-b"),
+					TargetGroupARNs:   []*string{aws.String(tgARN)},
+					Tags: []*svcsdk.Tag{{
+						Key:               aws.String("team"),
+						Value:             aws.String("web"),
+						PropagateAtLaunch: aws.Bool(true),
+					}},
+				},
+			},
+		},
+		"CreateFailed": {
+			client: &fake.MockClient{
+				MockCreateAutoScalingGroup: func(_ aws.Context, in *svcsdk.CreateAutoScalingGroupInput, _ []request.Option) (*svcsdk.CreateAutoScalingGroupOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: asg(withExternalName(groupName)),
+			want: want{
+				input: &svcsdk.CreateAutoScalingGroupInput{
+					AutoScalingGroupName: aws.String(groupName),
+					LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+						LaunchTemplateName: aws.String("web"),
+						Version:            aws.String("1"),
+					},
+					MinSize:           aws.Int64(1),
+					MaxSize:           aws.Int64(3),
+					VPCZoneIdentifier: aws.String("subnet-a,subnet-b"),
+					TargetGroupARNs:   []*string{aws.String(tgARN)},
+				},
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.EquateEmpty(), cmpopts.IgnoreUnexported(svcsdk.CreateAutoScalingGroupInput{}, svcsdk.LaunchTemplateSpecification{}, svcsdk.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// recorder records the calls made to the mock client.
+type recorder struct {
+	calls   []string
+	update  *svcsdk.UpdateAutoScalingGroupInput
+	refresh *svcsdk.StartInstanceRefreshInput
+}
+
+func (r *recorder) client(g *svcsdk.Group, refreshes ...*svcsdk.InstanceRefresh) *fake.MockClient {
+	return &fake.MockClient{
+		MockDescribeAutoScalingGroups: mockDescribe(g),
+		MockDescribeInstanceRefreshes: mockDescribeRefreshes(refreshes...),
+		MockUpdateAutoScalingGroup: func(_ aws.Context, in *svcsdk.UpdateAutoScalingGroupInput, _ []request.Option) (*svcsdk.UpdateAutoScalingGroupOutput, error) {
+			r.calls = append(r.calls, "UpdateAutoScalingGroup")
+			r.update = in
+			return &svcsdk.UpdateAutoScalingGroupOutput{}, nil
+		},
+		MockAttachLoadBalancerTargetGroups: func(aws.Context, *svcsdk.AttachLoadBalancerTargetGroupsInput, []request.Option) (*svcsdk.AttachLoadBalancerTargetGroupsOutput, error) {
+			r.calls = append(r.calls, "AttachLoadBalancerTargetGroups")
+			return &svcsdk.AttachLoadBalancerTargetGroupsOutput{}, nil
+		},
+		MockDetachLoadBalancerTargetGroups: func(aws.Context, *svcsdk.DetachLoadBalancerTargetGroupsInput, []request.Option) (*svcsdk.DetachLoadBalancerTargetGroupsOutput, error) {
+			r.calls = append(r.calls, "DetachLoadBalancerTargetGroups")
+			return &svcsdk.DetachLoadBalancerTargetGroupsOutput{}, nil
+		},
+		MockCreateOrUpdateTags: func(aws.Context, *svcsdk.CreateOrUpdateTagsInput, []request.Option) (*svcsdk.CreateOrUpdateTagsOutput, error) {
+			r.calls = append(r.calls, "CreateOrUpdateTags")
+			return &svcsdk.CreateOrUpdateTagsOutput{}, nil
+		},
+		MockDeleteTags: func(aws.Context, *svcsdk.DeleteTagsInput, []request.Option) (*svcsdk.DeleteTagsOutput, error) {
+			r.calls = append(r.calls, "DeleteTags")
+			return &svcsdk.DeleteTagsOutput{}, nil
+		},
+		MockStartInstanceRefresh: func(_ aws.Context, in *svcsdk.StartInstanceRefreshInput, _ []request.Option) (*svcsdk.StartInstanceRefreshOutput, error) {
+			r.calls = append(r.calls, "StartInstanceRefresh")
+			r.refresh = in
+			return &svcsdk.StartInstanceRefreshOutput{InstanceRefreshId: aws.String(refreshID)}, nil
+		},
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		calls          []string
+		launchTemplate *svcsdk.LaunchTemplateSpecification
+		refresh        *svcsdk.StartInstanceRefreshInput
+		err            error
+	}
+
+	failedRefresh := &svcsdk.InstanceRefresh{
+		InstanceRefreshId: aws.String(refreshID),
+		Status:            aws.String(svcsdk.InstanceRefreshStatusFailed),
+		StatusReason:      aws.String("instances failed to become healthy"),
+		DesiredConfiguration: &svcsdk.DesiredConfiguration{
+			LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+				LaunchTemplateName: aws.String("web"),
+				Version:            aws.String("2"),
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.AutoScalingGroup
+		g         *svcsdk.Group
+		refreshes []*svcsdk.InstanceRefresh
+		want      want
+	}{
+		"UpdateGroup": {
+			cr: asg(withExternalName(groupName), withSpec(withVersion("2"))),
+			g:  observedGroup(),
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup"},
+				launchTemplate: &svcsdk.LaunchTemplateSpecification{
+					LaunchTemplateName: aws.String("web"),
+					Version:            aws.String("2"),
+				},
+			},
+		},
+		"TargetGroupsAndTags": {
+			cr: asg(withExternalName(groupName), withSpec(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.Tags = []v1alpha1.Tag{{Key: "team", Value: "web"}}
+			})),
+			g: observedGroup(func(g *svcsdk.Group) {
+				g.TargetGroupARNs = []*string{aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/old/1")}
+				g.Tags = []*svcsdk.TagDescription{{Key: aws.String("old"), Value: aws.String("tag")}}
+			}),
+			want: want{
+				calls: []string{"DetachLoadBalancerTargetGroups", "AttachLoadBalancerTargetGroups", "DeleteTags", "CreateOrUpdateTags"},
+			},
+		},
+		"StartInstanceRefresh": {
+			cr: asg(withExternalName(groupName), withSpec(withRefresh), withSpec(withVersion("2"))),
+			g:  observedGroup(),
+			refreshes: []*svcsdk.InstanceRefresh{{
+				InstanceRefreshId: aws.String("previous"),
+				Status:            aws.String(svcsdk.InstanceRefreshStatusSuccessful),
+			}},
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup", "StartInstanceRefresh"},
+				refresh: &svcsdk.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String(groupName),
+					DesiredConfiguration: &svcsdk.DesiredConfiguration{
+						LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+							LaunchTemplateName: aws.String("web"),
+							Version:            aws.String("2"),
+						},
+					},
+					Preferences: &svcsdk.RefreshPreferences{
+						MinHealthyPercentage: aws.Int64(50),
+					},
+				},
+			},
+		},
+		"InstanceRefreshInProgress": {
+			cr: asg(withExternalName(groupName), withSpec(withRefresh), withSpec(withVersion("3"))),
+			g:  observedGroup(),
+			refreshes: []*svcsdk.InstanceRefresh{{
+				InstanceRefreshId: aws.String(refreshID),
+				Status:            aws.String(svcsdk.InstanceRefreshStatusInProgress),
+			}},
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup"},
+			},
+		},
+		"InstanceRefreshFailed": {
+			cr:        asg(withExternalName(groupName), withSpec(withRefresh), withSpec(withVersion("2"))),
+			g:         observedGroup(),
+			refreshes: []*svcsdk.InstanceRefresh{failedRefresh},
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup"},
+				err:   errors.Errorf(errFmtRefreshEnded, refreshID, svcsdk.InstanceRefreshStatusFailed, "instances failed to become healthy"),
+			},
+		},
+		"RetryAfterFailedRefreshToOtherVersion": {
+			cr:        asg(withExternalName(groupName), withSpec(withRefresh), withSpec(withVersion("3"))),
+			g:         observedGroup(),
+			refreshes: []*svcsdk.InstanceRefresh{failedRefresh},
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup", "StartInstanceRefresh"},
+				refresh: &svcsdk.StartInstanceRefreshInput{
+					AutoScalingGroupName: aws.String(groupName),
+					DesiredConfiguration: &svcsdk.DesiredConfiguration{
+						LaunchTemplate: &svcsdk.LaunchTemplateSpecification{
+							LaunchTemplateName: aws.String("web"),
+							Version:            aws.String("3"),
+						},
+					},
+					Preferences: &svcsdk.RefreshPreferences{
+						MinHealthyPercentage: aws.Int64(50),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &recorder{}
+			e := &external{client: r.client(tc.g, tc.refreshes...)}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, r.calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+			opts := []cmp.Option{cmpopts.IgnoreUnexported(svcsdk.LaunchTemplateSpecification{}, svcsdk.StartInstanceRefreshInput{},
+				svcsdk.DesiredConfiguration{}, svcsdk.RefreshPreferences{})}
+			if r.update != nil {
+				if diff := cmp.Diff(tc.want.launchTemplate, r.update.LaunchTemplate, opts...); diff != "" {
+					t.Errorf("launch template: -want, +got:\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want.refresh, r.refresh, opts...); diff != "" {
+				t.Errorf("refresh: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		calls []string
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        *v1alpha1.AutoScalingGroup
+		deleteErr error
+		want      want
+	}{
+		"ScaleToZero": {
+			cr: asg(withExternalName(groupName), withObservation(observation())),
+			want: want{
+				calls: []string{"UpdateAutoScalingGroup"},
+			},
+		},
+		"DeleteEmptyGroup": {
+			cr: asg(withExternalName(groupName), withObservation(v1alpha1.AutoScalingGroupObservation{ARN: groupARN})),
+			want: want{
+				calls: []string{"DeleteAutoScalingGroup"},
+			},
+		},
+		"ForceDelete": {
+			cr: asg(withExternalName(groupName), withObservation(observation()), withSpec(func(p *v1alpha1.AutoScalingGroupParameters) {
+				p.ForceDelete = aws.Bool(true)
+			})),
+			want: want{
+				calls: []string{"DeleteAutoScalingGroup"},
+			},
+		},
+		"AlreadyDeleting": {
+			cr:   asg(withExternalName(groupName), withObservation(v1alpha1.AutoScalingGroupObservation{Status: "Delete in progress"})),
+			want: want{},
+		},
+		"NotFound": {
+			cr:        asg(withExternalName(groupName)),
+			deleteErr: notFound,
+			want: want{
+				calls: []string{"DeleteAutoScalingGroup"},
+			},
+		},
+		"DeleteFailed": {
+			cr:        asg(withExternalName(groupName)),
+			deleteErr: errBoom,
+			want: want{
+				calls: []string{"DeleteAutoScalingGroup"},
+				err:   awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var calls []string
+			e := &external{client: &fake.MockClient{
+				MockUpdateAutoScalingGroup: func(_ aws.Context, in *svcsdk.UpdateAutoScalingGroupInput, _ []request.Option) (*svcsdk.UpdateAutoScalingGroupOutput, error) {
+					calls = append(calls, "UpdateAutoScalingGroup")
+					if aws.Int64Value(in.MaxSize) != 0 || aws.Int64Value(in.DesiredCapacity) != 0 {
+						return nil, errBoom
+					}
+					return &svcsdk.UpdateAutoScalingGroupOutput{}, nil
+				},
+				MockDeleteAutoScalingGroup: func(_ aws.Context, in *svcsdk.DeleteAutoScalingGroupInput, _ []request.Option) (*svcsdk.DeleteAutoScalingGroupOutput, error) {
+					calls = append(calls, "DeleteAutoScalingGroup")
+					return &svcsdk.DeleteAutoScalingGroupOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.calls, calls); diff != "" {
+				t.Errorf("calls: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,451 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lifecyclehook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	hookName       = "drain"
+	groupName      = "web"
+	launching      = "autoscaling:EC2_INSTANCE_LAUNCHING"
+	errBoom        = errors.New("boom")
+	notFound       = awserr.New("ValidationError", "No Lifecycle Hooks found", nil)
+)
+
+type hookModifier func(*v1alpha1.LifecycleHook)
+
+func withExternalName(n string) hookModifier {
+	return func(r *v1alpha1.LifecycleHook) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(f func(*v1alpha1.LifecycleHookParameters)) hookModifier {
+	return func(r *v1alpha1.LifecycleHook) { f(&r.Spec.ForProvider) }
+}
+
+func withObservation(o v1alpha1.LifecycleHookObservation) hookModifier {
+	return func(r *v1alpha1.LifecycleHook) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) hookModifier {
+	return func(r *v1alpha1.LifecycleHook) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func lateInitialized(p *v1alpha1.LifecycleHookParameters) {
+	p.DefaultResult = aws.String("ABANDON")
+	p.HeartbeatTimeout = aws.Int64(3600)
+}
+
+func hook(m ...hookModifier) *v1alpha1.LifecycleHook {
+	cr := &v1alpha1.LifecycleHook{
+		Spec: v1alpha1.LifecycleHookSpec{
+			ForProvider: v1alpha1.LifecycleHookParameters{
+				Region:               "us-east-1",
+				AutoScalingGroupName: aws.String(groupName),
+				LifecycleTransition:  launching,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedHook(m ...func(*svcsdk.LifecycleHook)) *svcsdk.LifecycleHook {
+	h := &svcsdk.LifecycleHook{
+		AutoScalingGroupName: aws.String(groupName),
+		LifecycleHookName:    aws.String(hookName),
+		LifecycleTransition:  aws.String(launching),
+		DefaultResult:        aws.String("ABANDON"),
+		HeartbeatTimeout:     aws.Int64(3600),
+		GlobalTimeout:        aws.Int64(172800),
+	}
+	for _, f := range m {
+		f(h)
+	}
+	return h
+}
+
+func mockDescribe(h ...*svcsdk.LifecycleHook) func(aws.Context, *svcsdk.DescribeLifecycleHooksInput, []request.Option) (*svcsdk.DescribeLifecycleHooksOutput, error) {
+	return func(_ aws.Context, in *svcsdk.DescribeLifecycleHooksInput, _ []request.Option) (*svcsdk.DescribeLifecycleHooksOutput, error) {
+		if aws.StringValue(in.AutoScalingGroupName) != groupName || len(in.LifecycleHookNames) != 1 || aws.StringValue(in.LifecycleHookNames[0]) != hookName {
+			return nil, errBoom
+		}
+		return &svcsdk.DescribeLifecycleHooksOutput{LifecycleHooks: h}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockClient{},
+			cr:     hook(),
+			want: want{
+				cr: hook(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeLifecycleHooks: mockDescribe()},
+			cr:     hook(withExternalName(hookName)),
+			want: want{
+				cr: hook(withExternalName(hookName)),
+			},
+		},
+		"GroupNotFound": {
+			client: &fake.MockClient{
+				MockDescribeLifecycleHooks: func(aws.Context, *svcsdk.DescribeLifecycleHooksInput, []request.Option) (*svcsdk.DescribeLifecycleHooksOutput, error) {
+					return nil, notFound
+				},
+			},
+			cr: hook(withExternalName(hookName)),
+			want: want{
+				cr: hook(withExternalName(hookName)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeLifecycleHooks: func(aws.Context, *svcsdk.DescribeLifecycleHooksInput, []request.Option) (*svcsdk.DescribeLifecycleHooksOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: hook(withExternalName(hookName)),
+			want: want{
+				cr:  hook(withExternalName(hookName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribeLifecycleHooks: mockDescribe(observedHook())},
+			cr:     hook(withExternalName(hookName)),
+			want: want{
+				cr: hook(withExternalName(hookName), withSpec(lateInitialized),
+					withObservation(v1alpha1.LifecycleHookObservation{GlobalTimeout: 172800}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"HeartbeatTimeoutChanged": {
+			client: &fake.MockClient{MockDescribeLifecycleHooks: mockDescribe(observedHook())},
+			cr: hook(withExternalName(hookName), withSpec(lateInitialized),
+				withSpec(func(p *v1alpha1.LifecycleHookParameters) { p.HeartbeatTimeout = aws.Int64(300) })),
+			want: want{
+				cr: hook(withExternalName(hookName), withSpec(lateInitialized),
+					withSpec(func(p *v1alpha1.LifecycleHookParameters) { p.HeartbeatTimeout = aws.Int64(300) }),
+					withObservation(v1alpha1.LifecycleHookObservation{GlobalTimeout: 172800}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *svcsdk.PutLifecycleHookInput
+		err   error
+	}
+
+	var got *svcsdk.PutLifecycleHookInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutLifecycleHook: func(_ aws.Context, in *svcsdk.PutLifecycleHookInput, _ []request.Option) (*svcsdk.PutLifecycleHookOutput, error) {
+					got = in
+					return &svcsdk.PutLifecycleHookOutput{}, nil
+				},
+			},
+			cr: hook(withExternalName(hookName), withSpec(func(p *v1alpha1.LifecycleHookParameters) {
+				p.HeartbeatTimeout = aws.Int64(300)
+			})),
+			want: want{
+				cr: hook(withExternalName(hookName), withSpec(func(p *v1alpha1.LifecycleHookParameters) {
+					p.HeartbeatTimeout = aws.Int64(300)
+				}), withConditions(xpv1.Creating())),
+				input: &svcsdk.PutLifecycleHookInput{
+					LifecycleHookName:    aws.String(hookName),
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleTransition:  aws.String(launching),
+					HeartbeatTimeout:     aws.Int64(300),
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutLifecycleHook: func(_ aws.Context, in *svcsdk.PutLifecycleHookInput, _ []request.Option) (*svcsdk.PutLifecycleHookOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: hook(withExternalName(hookName)),
+			want: want{
+				cr: hook(withExternalName(hookName), withConditions(xpv1.Creating())),
+				input: &svcsdk.PutLifecycleHookInput{
+					LifecycleHookName:    aws.String(hookName),
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleTransition:  aws.String(launching),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutLifecycleHookInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *svcsdk.PutLifecycleHookInput
+		err   error
+	}
+
+	var got *svcsdk.PutLifecycleHookInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutLifecycleHook: func(_ aws.Context, in *svcsdk.PutLifecycleHookInput, _ []request.Option) (*svcsdk.PutLifecycleHookOutput, error) {
+					got = in
+					return &svcsdk.PutLifecycleHookOutput{}, nil
+				},
+			},
+			cr: hook(withExternalName(hookName), withSpec(lateInitialized)),
+			want: want{
+				input: &svcsdk.PutLifecycleHookInput{
+					LifecycleHookName:    aws.String(hookName),
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleTransition:  aws.String(launching),
+					DefaultResult:        aws.String("ABANDON"),
+					HeartbeatTimeout:     aws.Int64(3600),
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutLifecycleHook: func(_ aws.Context, in *svcsdk.PutLifecycleHookInput, _ []request.Option) (*svcsdk.PutLifecycleHookOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: hook(withExternalName(hookName)),
+			want: want{
+				input: &svcsdk.PutLifecycleHookInput{
+					LifecycleHookName:    aws.String(hookName),
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleTransition:  aws.String(launching),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutLifecycleHookInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *svcsdk.DeleteLifecycleHookInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        resource.Managed
+		deleteErr error
+		want      want
+	}{
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			cr: hook(withExternalName(hookName)),
+			want: want{
+				cr: hook(withExternalName(hookName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeleteLifecycleHookInput{
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleHookName:    aws.String(hookName),
+				},
+			},
+		},
+		"NotFound": {
+			cr:        hook(withExternalName(hookName)),
+			deleteErr: notFound,
+			want: want{
+				cr: hook(withExternalName(hookName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeleteLifecycleHookInput{
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleHookName:    aws.String(hookName),
+				},
+			},
+		},
+		"DeleteFailed": {
+			cr:        hook(withExternalName(hookName)),
+			deleteErr: errBoom,
+			want: want{
+				cr: hook(withExternalName(hookName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeleteLifecycleHookInput{
+					AutoScalingGroupName: aws.String(groupName),
+					LifecycleHookName:    aws.String(hookName),
+				},
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *svcsdk.DeleteLifecycleHookInput
+			e := &external{client: &fake.MockClient{
+				MockDeleteLifecycleHook: func(_ aws.Context, in *svcsdk.DeleteLifecycleHookInput, _ []request.Option) (*svcsdk.DeleteLifecycleHookOutput, error) {
+					got = in
+					return &svcsdk.DeleteLifecycleHookOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.DeleteLifecycleHookInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
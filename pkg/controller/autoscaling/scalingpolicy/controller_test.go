@@ -0,0 +1,473 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingpolicy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	policyName     = "scale-out"
+	groupName      = "web"
+	policyARN      = "arn:aws:autoscaling:us-east-1:123456789012:scalingPolicy:1234:autoScalingGroupName/web:policyName/scale-out"
+	alarmARN       = "arn:aws:cloudwatch:us-east-1:123456789012:alarm:high-cpu"
+	errBoom        = errors.New("boom")
+	notFound       = awserr.New("ValidationError", "AutoScalingGroup name not found - AutoScalingGroup web not found", nil)
+)
+
+type policyModifier func(*v1alpha1.ScalingPolicy)
+
+func withExternalName(n string) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(f func(*v1alpha1.ScalingPolicyParameters)) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { f(&r.Spec.ForProvider) }
+}
+
+func withObservation(o v1alpha1.ScalingPolicyObservation) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) policyModifier {
+	return func(r *v1alpha1.ScalingPolicy) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func lateInitialized(p *v1alpha1.ScalingPolicyParameters) {
+	p.Enabled = aws.Bool(true)
+}
+
+func policy(m ...policyModifier) *v1alpha1.ScalingPolicy {
+	cr := &v1alpha1.ScalingPolicy{
+		Spec: v1alpha1.ScalingPolicySpec{
+			ForProvider: v1alpha1.ScalingPolicyParameters{
+				Region:               "us-east-1",
+				AutoScalingGroupName: aws.String(groupName),
+				PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+				AdjustmentType:       aws.String("ChangeInCapacity"),
+				ScalingAdjustment:    aws.Int64(1),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedPolicy(m ...func(*svcsdk.ScalingPolicy)) *svcsdk.ScalingPolicy {
+	p := &svcsdk.ScalingPolicy{
+		AutoScalingGroupName: aws.String(groupName),
+		PolicyName:           aws.String(policyName),
+		PolicyARN:            aws.String(policyARN),
+		PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+		AdjustmentType:       aws.String("ChangeInCapacity"),
+		ScalingAdjustment:    aws.Int64(1),
+		Enabled:              aws.Bool(true),
+		Alarms: []*svcsdk.Alarm{{
+			AlarmName: aws.String("high-cpu"),
+			AlarmARN:  aws.String(alarmARN),
+		}},
+	}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func observation() v1alpha1.ScalingPolicyObservation {
+	return v1alpha1.ScalingPolicyObservation{
+		PolicyARN: policyARN,
+		Alarms:    []v1alpha1.Alarm{{AlarmName: "high-cpu", AlarmARN: alarmARN}},
+	}
+}
+
+func mockDescribe(p ...*svcsdk.ScalingPolicy) func(aws.Context, *svcsdk.DescribePoliciesInput, []request.Option) (*svcsdk.DescribePoliciesOutput, error) {
+	return func(_ aws.Context, in *svcsdk.DescribePoliciesInput, _ []request.Option) (*svcsdk.DescribePoliciesOutput, error) {
+		if aws.StringValue(in.AutoScalingGroupName) != groupName || len(in.PolicyNames) != 1 || aws.StringValue(in.PolicyNames[0]) != policyName {
+			return nil, errBoom
+		}
+		return &svcsdk.DescribePoliciesOutput{ScalingPolicies: p}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockClient{},
+			cr:     policy(),
+			want: want{
+				cr: policy(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribePolicies: mockDescribe()},
+			cr:     policy(withExternalName(policyName)),
+			want: want{
+				cr: policy(withExternalName(policyName)),
+			},
+		},
+		"GroupNotFound": {
+			client: &fake.MockClient{
+				MockDescribePolicies: func(aws.Context, *svcsdk.DescribePoliciesInput, []request.Option) (*svcsdk.DescribePoliciesOutput, error) {
+					return nil, notFound
+				},
+			},
+			cr: policy(withExternalName(policyName)),
+			want: want{
+				cr: policy(withExternalName(policyName)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribePolicies: func(aws.Context, *svcsdk.DescribePoliciesInput, []request.Option) (*svcsdk.DescribePoliciesOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: policy(withExternalName(policyName)),
+			want: want{
+				cr:  policy(withExternalName(policyName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{MockDescribePolicies: mockDescribe(observedPolicy())},
+			cr:     policy(withExternalName(policyName)),
+			want: want{
+				cr: policy(withExternalName(policyName), withSpec(lateInitialized),
+					withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"ScalingAdjustmentChanged": {
+			client: &fake.MockClient{MockDescribePolicies: mockDescribe(observedPolicy())},
+			cr: policy(withExternalName(policyName), withSpec(lateInitialized),
+				withSpec(func(p *v1alpha1.ScalingPolicyParameters) { p.ScalingAdjustment = aws.Int64(2) })),
+			want: want{
+				cr: policy(withExternalName(policyName), withSpec(lateInitialized),
+					withSpec(func(p *v1alpha1.ScalingPolicyParameters) { p.ScalingAdjustment = aws.Int64(2) }),
+					withObservation(observation()), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *svcsdk.PutScalingPolicyInput
+		err   error
+	}
+
+	var got *svcsdk.PutScalingPolicyInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutScalingPolicy: func(_ aws.Context, in *svcsdk.PutScalingPolicyInput, _ []request.Option) (*svcsdk.PutScalingPolicyOutput, error) {
+					got = in
+					return &svcsdk.PutScalingPolicyOutput{PolicyARN: aws.String(policyARN)}, nil
+				},
+			},
+			cr: policy(withExternalName(policyName), withSpec(func(p *v1alpha1.ScalingPolicyParameters) {
+				p.Cooldown = aws.Int64(300)
+			})),
+			want: want{
+				cr: policy(withExternalName(policyName), withSpec(func(p *v1alpha1.ScalingPolicyParameters) {
+					p.Cooldown = aws.Int64(300)
+				}), withConditions(xpv1.Creating())),
+				input: &svcsdk.PutScalingPolicyInput{
+					PolicyName:           aws.String(policyName),
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+					AdjustmentType:       aws.String("ChangeInCapacity"),
+					ScalingAdjustment:    aws.Int64(1),
+					Cooldown:             aws.Int64(300),
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutScalingPolicy: func(_ aws.Context, in *svcsdk.PutScalingPolicyInput, _ []request.Option) (*svcsdk.PutScalingPolicyOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: policy(withExternalName(policyName)),
+			want: want{
+				cr: policy(withExternalName(policyName), withConditions(xpv1.Creating())),
+				input: &svcsdk.PutScalingPolicyInput{
+					PolicyName:           aws.String(policyName),
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+					AdjustmentType:       aws.String("ChangeInCapacity"),
+					ScalingAdjustment:    aws.Int64(1),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutScalingPolicyInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *svcsdk.PutScalingPolicyInput
+		err   error
+	}
+
+	var got *svcsdk.PutScalingPolicyInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutScalingPolicy: func(_ aws.Context, in *svcsdk.PutScalingPolicyInput, _ []request.Option) (*svcsdk.PutScalingPolicyOutput, error) {
+					got = in
+					return &svcsdk.PutScalingPolicyOutput{PolicyARN: aws.String(policyARN)}, nil
+				},
+			},
+			cr: policy(withExternalName(policyName), withSpec(lateInitialized),
+				withSpec(func(p *v1alpha1.ScalingPolicyParameters) { p.ScalingAdjustment = aws.Int64(2) })),
+			want: want{
+				input: &svcsdk.PutScalingPolicyInput{
+					PolicyName:           aws.String(policyName),
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+					AdjustmentType:       aws.String("ChangeInCapacity"),
+					ScalingAdjustment:    aws.Int64(2),
+					Enabled:              aws.Bool(true),
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutScalingPolicy: func(_ aws.Context, in *svcsdk.PutScalingPolicyInput, _ []request.Option) (*svcsdk.PutScalingPolicyOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: policy(withExternalName(policyName)),
+			want: want{
+				input: &svcsdk.PutScalingPolicyInput{
+					PolicyName:           aws.String(policyName),
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyType:           aws.String(v1alpha1.PolicyTypeSimpleScaling),
+					AdjustmentType:       aws.String("ChangeInCapacity"),
+					ScalingAdjustment:    aws.Int64(1),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutScalingPolicyInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *svcsdk.DeletePolicyInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        resource.Managed
+		deleteErr error
+		want      want
+	}{
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			cr: policy(withExternalName(policyName)),
+			want: want{
+				cr: policy(withExternalName(policyName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeletePolicyInput{
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyName:           aws.String(policyName),
+				},
+			},
+		},
+		"NotFound": {
+			cr:        policy(withExternalName(policyName)),
+			deleteErr: notFound,
+			want: want{
+				cr: policy(withExternalName(policyName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeletePolicyInput{
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyName:           aws.String(policyName),
+				},
+			},
+		},
+		"DeleteFailed": {
+			cr:        policy(withExternalName(policyName)),
+			deleteErr: errBoom,
+			want: want{
+				cr: policy(withExternalName(policyName), withConditions(xpv1.Deleting())),
+				input: &svcsdk.DeletePolicyInput{
+					AutoScalingGroupName: aws.String(groupName),
+					PolicyName:           aws.String(policyName),
+				},
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *svcsdk.DeletePolicyInput
+			e := &external{client: &fake.MockClient{
+				MockDeletePolicy: func(_ aws.Context, in *svcsdk.DeletePolicyInput, _ []request.Option) (*svcsdk.DeletePolicyOutput, error) {
+					got = in
+					return &svcsdk.DeletePolicyOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.DeletePolicyInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmpool
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a WarmPool resource"
+	errCreateSession    = "cannot create a new session"
+	errDescribe         = "cannot describe warm pool"
+	errPut              = "cannot put warm pool"
+	errDelete           = "cannot delete warm pool"
+)
+
+// SetupWarmPool adds a controller that reconciles WarmPool.
+func SetupWarmPool(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WarmPoolGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&v1alpha1.WarmPool{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.WarmPoolGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: autoscaling.NewClient})),
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) autoscaling.Client
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WarmPool)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client autoscaling.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WarmPool)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.DescribeWarmPoolWithContext(ctx, &svcsdk.DescribeWarmPoolInput{
+		AutoScalingGroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errDescribe)
+	}
+	if resp.WarmPoolConfiguration == nil {
+		return managed.ExternalObservation{}, nil
+	}
+	c := resp.WarmPoolConfiguration
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	autoscaling.LateInitializeWarmPool(&cr.Spec.ForProvider, c)
+
+	cr.Status.AtProvider = v1alpha1.WarmPoolObservation{
+		Status:    aws.StringValue(c.Status),
+		Instances: int64(len(resp.Instances)),
+	}
+	// NOTE: Status is only set while the warm pool is being deleted.
+	if c.Status != nil {
+		cr.SetConditions(xpv1.Deleting())
+	} else {
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        autoscaling.IsWarmPoolUpToDate(cr.Spec.ForProvider, c),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WarmPool)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	// A group has at most one warm pool, so it is identified by the name of
+	// its group.
+	name := aws.StringValue(cr.Spec.ForProvider.AutoScalingGroupName)
+	if _, err := e.client.PutWarmPoolWithContext(ctx, autoscaling.GeneratePutWarmPoolInput(name, &cr.Spec.ForProvider)); err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errPut)
+	}
+	meta.SetExternalName(cr, name)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.WarmPool)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	_, err := e.client.PutWarmPoolWithContext(ctx, autoscaling.GeneratePutWarmPoolInput(meta.GetExternalName(cr), &cr.Spec.ForProvider))
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errPut)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WarmPool)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// NOTE: A deletion is already in progress.
+	if cr.Status.AtProvider.Status != "" {
+		return nil
+	}
+
+	_, err := e.client.DeleteWarmPoolWithContext(ctx, &svcsdk.DeleteWarmPoolInput{
+		AutoScalingGroupName: aws.String(meta.GetExternalName(cr)),
+		ForceDelete:          cr.Spec.ForProvider.ForceDelete,
+	})
+	return awsclient.Wrap(resource.Ignore(autoscaling.IsNotFound, err), errDelete)
+}
@@ -0,0 +1,464 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package warmpool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/autoscaling/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/autoscaling/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	groupName      = "web"
+	errBoom        = errors.New("boom")
+	notFound       = awserr.New("ValidationError", "No warm pool found", nil)
+)
+
+type poolModifier func(*v1alpha1.WarmPool)
+
+func withExternalName(n string) poolModifier {
+	return func(r *v1alpha1.WarmPool) { meta.SetExternalName(r, n) }
+}
+
+func withSpec(f func(*v1alpha1.WarmPoolParameters)) poolModifier {
+	return func(r *v1alpha1.WarmPool) { f(&r.Spec.ForProvider) }
+}
+
+func withObservation(o v1alpha1.WarmPoolObservation) poolModifier {
+	return func(r *v1alpha1.WarmPool) { r.Status.AtProvider = o }
+}
+
+func withConditions(c ...xpv1.Condition) poolModifier {
+	return func(r *v1alpha1.WarmPool) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func lateInitialized(p *v1alpha1.WarmPoolParameters) {
+	p.MinSize = aws.Int64(0)
+	p.PoolState = aws.String("Stopped")
+}
+
+func pool(m ...poolModifier) *v1alpha1.WarmPool {
+	cr := &v1alpha1.WarmPool{
+		Spec: v1alpha1.WarmPoolSpec{
+			ForProvider: v1alpha1.WarmPoolParameters{
+				Region:                   "us-east-1",
+				AutoScalingGroupName:     aws.String(groupName),
+				MaxGroupPreparedCapacity: aws.Int64(4),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func observedPool(m ...func(*svcsdk.WarmPoolConfiguration)) *svcsdk.WarmPoolConfiguration {
+	c := &svcsdk.WarmPoolConfiguration{
+		MaxGroupPreparedCapacity: aws.Int64(4),
+		MinSize:                  aws.Int64(0),
+		PoolState:                aws.String("Stopped"),
+	}
+	for _, f := range m {
+		f(c)
+	}
+	return c
+}
+
+func mockDescribe(c *svcsdk.WarmPoolConfiguration, instances ...*svcsdk.Instance) func(aws.Context, *svcsdk.DescribeWarmPoolInput, []request.Option) (*svcsdk.DescribeWarmPoolOutput, error) {
+	return func(_ aws.Context, in *svcsdk.DescribeWarmPoolInput, _ []request.Option) (*svcsdk.DescribeWarmPoolOutput, error) {
+		if aws.StringValue(in.AutoScalingGroupName) != groupName {
+			return nil, errBoom
+		}
+		return &svcsdk.DescribeWarmPoolOutput{WarmPoolConfiguration: c, Instances: instances}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NoExternalName": {
+			client: &fake.MockClient{},
+			cr:     pool(),
+			want: want{
+				cr: pool(),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockClient{MockDescribeWarmPool: mockDescribe(nil)},
+			cr:     pool(withExternalName(groupName)),
+			want: want{
+				cr: pool(withExternalName(groupName)),
+			},
+		},
+		"NoWarmPool": {
+			client: &fake.MockClient{
+				MockDescribeWarmPool: func(aws.Context, *svcsdk.DescribeWarmPoolInput, []request.Option) (*svcsdk.DescribeWarmPoolOutput, error) {
+					return nil, notFound
+				},
+			},
+			cr: pool(withExternalName(groupName)),
+			want: want{
+				cr: pool(withExternalName(groupName)),
+			},
+		},
+		"DescribeFailed": {
+			client: &fake.MockClient{
+				MockDescribeWarmPool: func(aws.Context, *svcsdk.DescribeWarmPoolInput, []request.Option) (*svcsdk.DescribeWarmPoolOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: pool(withExternalName(groupName)),
+			want: want{
+				cr:  pool(withExternalName(groupName)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+		"UpToDate": {
+			client: &fake.MockClient{
+				MockDescribeWarmPool: mockDescribe(observedPool(), &svcsdk.Instance{InstanceId: aws.String("i-0123456789")}),
+			},
+			cr: pool(withExternalName(groupName)),
+			want: want{
+				cr: pool(withExternalName(groupName), withSpec(lateInitialized),
+					withObservation(v1alpha1.WarmPoolObservation{Instances: 1}), withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"PoolStateChanged": {
+			client: &fake.MockClient{MockDescribeWarmPool: mockDescribe(observedPool())},
+			cr: pool(withExternalName(groupName), withSpec(lateInitialized),
+				withSpec(func(p *v1alpha1.WarmPoolParameters) { p.PoolState = aws.String("Running") })),
+			want: want{
+				cr: pool(withExternalName(groupName), withSpec(lateInitialized),
+					withSpec(func(p *v1alpha1.WarmPoolParameters) { p.PoolState = aws.String("Running") }),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"Deleting": {
+			client: &fake.MockClient{
+				MockDescribeWarmPool: mockDescribe(observedPool(func(c *svcsdk.WarmPoolConfiguration) {
+					c.Status = aws.String(svcsdk.WarmPoolStatusPendingDelete)
+				})),
+			},
+			cr: pool(withExternalName(groupName), withSpec(lateInitialized)),
+			want: want{
+				cr: pool(withExternalName(groupName), withSpec(lateInitialized),
+					withObservation(v1alpha1.WarmPoolObservation{Status: svcsdk.WarmPoolStatusPendingDelete}),
+					withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr    resource.Managed
+		input *svcsdk.PutWarmPoolInput
+		err   error
+	}
+
+	var got *svcsdk.PutWarmPoolInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutWarmPool: func(_ aws.Context, in *svcsdk.PutWarmPoolInput, _ []request.Option) (*svcsdk.PutWarmPoolOutput, error) {
+					got = in
+					return &svcsdk.PutWarmPoolOutput{}, nil
+				},
+			},
+			cr: pool(withSpec(func(p *v1alpha1.WarmPoolParameters) {
+				p.InstanceReusePolicy = &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)}
+			})),
+			want: want{
+				cr: pool(withExternalName(groupName), withSpec(func(p *v1alpha1.WarmPoolParameters) {
+					p.InstanceReusePolicy = &v1alpha1.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)}
+				}), withConditions(xpv1.Creating())),
+				input: &svcsdk.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String(groupName),
+					MaxGroupPreparedCapacity: aws.Int64(4),
+					InstanceReusePolicy:      &svcsdk.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutWarmPool: func(_ aws.Context, in *svcsdk.PutWarmPoolInput, _ []request.Option) (*svcsdk.PutWarmPoolOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: pool(),
+			want: want{
+				cr: pool(withConditions(xpv1.Creating())),
+				input: &svcsdk.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String(groupName),
+					MaxGroupPreparedCapacity: aws.Int64(4),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutWarmPoolInput{}, svcsdk.InstanceReusePolicy{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		input *svcsdk.PutWarmPoolInput
+		err   error
+	}
+
+	var got *svcsdk.PutWarmPoolInput
+
+	cases := map[string]struct {
+		client *fake.MockClient
+		cr     resource.Managed
+		want   want
+	}{
+		"InValidInput": {
+			client: &fake.MockClient{},
+			cr:     unexpectedItem,
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			client: &fake.MockClient{
+				MockPutWarmPool: func(_ aws.Context, in *svcsdk.PutWarmPoolInput, _ []request.Option) (*svcsdk.PutWarmPoolOutput, error) {
+					got = in
+					return &svcsdk.PutWarmPoolOutput{}, nil
+				},
+			},
+			cr: pool(withExternalName(groupName), withSpec(lateInitialized),
+				withSpec(func(p *v1alpha1.WarmPoolParameters) { p.PoolState = aws.String("Running") })),
+			want: want{
+				input: &svcsdk.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String(groupName),
+					MaxGroupPreparedCapacity: aws.Int64(4),
+					MinSize:                  aws.Int64(0),
+					PoolState:                aws.String("Running"),
+				},
+			},
+		},
+		"PutFailed": {
+			client: &fake.MockClient{
+				MockPutWarmPool: func(_ aws.Context, in *svcsdk.PutWarmPoolInput, _ []request.Option) (*svcsdk.PutWarmPoolOutput, error) {
+					got = in
+					return nil, errBoom
+				},
+			},
+			cr: pool(withExternalName(groupName)),
+			want: want{
+				input: &svcsdk.PutWarmPoolInput{
+					AutoScalingGroupName:     aws.String(groupName),
+					MaxGroupPreparedCapacity: aws.Int64(4),
+				},
+				err: awsclient.Wrap(errBoom, errPut),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.client}
+			_, err := e.Update(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.PutWarmPoolInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		input *svcsdk.DeleteWarmPoolInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		cr        resource.Managed
+		deleteErr error
+		want      want
+	}{
+		"InValidInput": {
+			cr: unexpectedItem,
+			want: want{
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"Successful": {
+			cr: pool(withExternalName(groupName)),
+			want: want{
+				input: &svcsdk.DeleteWarmPoolInput{
+					AutoScalingGroupName: aws.String(groupName),
+				},
+			},
+		},
+		"ForceDelete": {
+			cr: pool(withExternalName(groupName), withSpec(func(p *v1alpha1.WarmPoolParameters) { p.ForceDelete = aws.Bool(true) })),
+			want: want{
+				input: &svcsdk.DeleteWarmPoolInput{
+					AutoScalingGroupName: aws.String(groupName),
+					ForceDelete:          aws.Bool(true),
+				},
+			},
+		},
+		"AlreadyDeleting": {
+			cr:   pool(withExternalName(groupName), withObservation(v1alpha1.WarmPoolObservation{Status: svcsdk.WarmPoolStatusPendingDelete})),
+			want: want{},
+		},
+		"NotFound": {
+			cr:        pool(withExternalName(groupName)),
+			deleteErr: notFound,
+			want: want{
+				input: &svcsdk.DeleteWarmPoolInput{
+					AutoScalingGroupName: aws.String(groupName),
+				},
+			},
+		},
+		"DeleteFailed": {
+			cr:        pool(withExternalName(groupName)),
+			deleteErr: errBoom,
+			want: want{
+				input: &svcsdk.DeleteWarmPoolInput{
+					AutoScalingGroupName: aws.String(groupName),
+				},
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *svcsdk.DeleteWarmPoolInput
+			e := &external{client: &fake.MockClient{
+				MockDeleteWarmPool: func(_ aws.Context, in *svcsdk.DeleteWarmPoolInput, _ []request.Option) (*svcsdk.DeleteWarmPoolOutput, error) {
+					got = in
+					return &svcsdk.DeleteWarmPoolOutput{}, tc.deleteErr
+				},
+			}}
+			err := e.Delete(context.Background(), tc.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(svcsdk.DeleteWarmPoolInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
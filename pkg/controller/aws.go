@@ -19,8 +19,6 @@ package controller
 import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
-
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/acm"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/acmpca/certificateauthority"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/acmpca/certificateauthoritypermission"
@@ -40,6 +38,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/apigatewayv2/stage"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/apigatewayv2/vpclink"
 	athenaworkgroup "github.com/crossplane-contrib/provider-aws/pkg/controller/athena/workgroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/autoscaling/autoscalinggroup"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/autoscaling/lifecyclehook"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/autoscaling/scalingpolicy"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/autoscaling/warmpool"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cache"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cache/cachesubnetgroup"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/cache/cluster"
@@ -74,8 +76,13 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/flowlog"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/instance"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/internetgateway"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipam"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipampool"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipampoolcidr"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/ipamscope"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplate"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/launchtemplateversion"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/managedprefixlist"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/natgateway"
 	ec2route "github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/route"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/ec2/routetable"
@@ -137,6 +144,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kafka/scramsecretassociation"
 	kinesisstream "github.com/crossplane-contrib/provider-aws/pkg/controller/kinesis/stream"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/alias"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/grant"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/kms/key"
 	lambdafunction "github.com/crossplane-contrib/provider-aws/pkg/controller/lambda/function"
 	lambdapermission "github.com/crossplane-contrib/provider-aws/pkg/controller/lambda/permission"
@@ -174,8 +182,10 @@ import (
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sns/subscription"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sns/topic"
 	"github.com/crossplane-contrib/provider-aws/pkg/controller/sqs/queue"
+	ssmparameter "github.com/crossplane-contrib/provider-aws/pkg/controller/ssm/parameter"
 	transferserver "github.com/crossplane-contrib/provider-aws/pkg/controller/transfer/server"
 	transferuser "github.com/crossplane-contrib/provider-aws/pkg/controller/transfer/user"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 // Setup creates all AWS controllers with the supplied logger and adds them to
@@ -221,8 +231,13 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		securitygroup.SetupSecurityGroup,
 		securitygrouprule.SetupSecurityGroupRule,
 		internetgateway.SetupInternetGateway,
+		ipam.SetupIPAM,
+		ipamscope.SetupIPAMScope,
+		ipampool.SetupIPAMPool,
+		ipampoolcidr.SetupIPAMPoolCIDR,
 		launchtemplate.SetupLaunchTemplate,
 		launchtemplateversion.SetupLaunchTemplateVersion,
+		managedprefixlist.SetupManagedPrefixList,
 		natgateway.SetupNatGateway,
 		routetable.SetupRouteTable,
 		dbsubnetgroup.SetupDBSubnetGroup,
@@ -260,6 +275,7 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		globaltable.SetupGlobalTable,
 		key.SetupKey,
 		alias.SetupAlias,
+		grant.SetupGrant,
 		accesspoint.SetupAccessPoint,
 		filesystem.SetupFileSystem,
 		dbcluster.SetupDBCluster,
@@ -338,6 +354,11 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cognitoidentitypool.SetupIdentityPool,
 		flowlog.SetupFlowLog,
 		opensearchdomain.SetupDomain,
+		ssmparameter.SetupParameter,
+		autoscalinggroup.SetupAutoScalingGroup,
+		lifecyclehook.SetupLifecycleHook,
+		scalingpolicy.SetupScalingPolicy,
+		warmpool.SetupWarmPool,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -36,6 +36,7 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/test"
 
 	"github.com/crossplane-contrib/provider-aws/apis/cache/v1beta1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/elasticache/fake"
 )
@@ -80,6 +81,14 @@ func withConditions(c ...xpv1.Condition) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.ConditionedStatus.Conditions = c }
 }
 
+func withDrift(paths ...string) replicationGroupModifier {
+	return func(r *v1beta1.ReplicationGroup) {
+		for _, p := range paths {
+			r.Status.Drift = append(r.Status.Drift, awsv1alpha1.FieldDrift{Path: p})
+		}
+	}
+}
+
 func withProviderStatus(s string) replicationGroupModifier {
 	return func(r *v1beta1.ReplicationGroup) { r.Status.AtProvider.Status = s }
 }
@@ -217,6 +226,7 @@ func TestObserve(t *testing.T) {
 			}},
 			r: replicationGroup(withReplicationGroupID(name)),
 			want: replicationGroup(
+				withDrift("AutomaticFailover"),
 				withProviderStatus(v1beta1.StatusCreating),
 				withReplicationGroupID(name),
 				withConditions(xpv1.Creating()),
@@ -235,6 +245,7 @@ func TestObserve(t *testing.T) {
 				withReplicationGroupID(name),
 			),
 			want: replicationGroup(
+				withDrift("AutomaticFailover"),
 				withReplicationGroupID(name),
 				withProviderStatus(v1beta1.StatusDeleting),
 				withConditions(xpv1.Deleting()),
@@ -253,6 +264,7 @@ func TestObserve(t *testing.T) {
 				withReplicationGroupID(name),
 			),
 			want: replicationGroup(
+				withDrift("AutomaticFailover"),
 				withProviderStatus(v1beta1.StatusModifying),
 				withReplicationGroupID(name),
 				withConditions(xpv1.Available()),
@@ -285,6 +297,7 @@ func TestObserve(t *testing.T) {
 				withClusterEnabled(true),
 			),
 			want: replicationGroup(
+				withDrift("AutomaticFailover", "Tags"),
 				withReplicationGroupID(name),
 				withProviderStatus(v1beta1.StatusAvailable),
 				withConditions(xpv1.Available()),
@@ -315,6 +328,7 @@ func TestObserve(t *testing.T) {
 			},
 			r: replicationGroup(withReplicationGroupID(name)),
 			want: replicationGroup(
+				withDrift("AutomaticFailover"),
 				withProviderStatus(v1beta1.StatusCreating),
 				withReplicationGroupID(name),
 				withAuthEnabled(true),
@@ -28,12 +28,12 @@ import (
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	awsrdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
@@ -44,8 +44,8 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/rds"
-	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -64,6 +64,7 @@ const (
 	errPatchCreationFailed                = "cannot create a patch object"
 	errUpToDateFailed                     = "cannot check whether object is up-to-date"
 	errGetPasswordSecretFailed            = "cannot get password secret"
+	errRotatePasswordFailed               = "cannot rotate master password"
 )
 
 // SetupRDSInstance adds a controller that reconciles RDSInstances.
@@ -78,14 +79,14 @@ func SetupRDSInstance(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(filter.Filter).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.RDSInstance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.RDSInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: rds.NewClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: rds.NewClient})),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -106,12 +107,13 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{c.newClientFn(cfg), c.kube}, nil
+	return &external{client: c.newClientFn(cfg), kube: c.kube, secrets: rds.NewSecretsManagerClient(cfg)}, nil
 }
 
 type external struct {
-	client rds.Client
-	kube   client.Client
+	client  rds.Client
+	kube    client.Client
+	secrets rds.SecretsManagerClient
 }
 
 func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -119,6 +121,11 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	if !ok {
 		return managed.ExternalObservation{}, errors.New(errNotRDSInstance)
 	}
+	if !meta.WasDeleted(cr) {
+		if err := rds.ValidatePasswordRotation(cr.Spec.ForProvider.PasswordRotation.GetInterval(), aws.ToBool(cr.Spec.ForProvider.ManageMasterUserPassword), cr.Spec.ForProvider.MasterPasswordSecretRef); err != nil {
+			return managed.ExternalObservation{}, err
+		}
+	}
 
 	// TODO(muvaf): There are some parameters that require a specific call
 	// for retrieval. For example, DescribeDBInstancesOutput does not expose
@@ -134,7 +141,9 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	instance := rsp.DBInstances[0]
 	current := cr.Spec.ForProvider.DeepCopy()
 	rds.LateInitialize(&cr.Spec.ForProvider, &instance)
+	rotatedAt := cr.Status.AtProvider.PasswordRotatedAt
 	cr.Status.AtProvider = rds.GenerateObservation(instance)
+	cr.Status.AtProvider.PasswordRotatedAt = rotatedAt
 
 	switch cr.Status.AtProvider.DBInstanceStatus {
 	case v1beta1.RDSInstanceStateAvailable, v1beta1.RDSInstanceStateModifying, v1beta1.RDSInstanceStateBackingUp, v1beta1.RDSInstanceStateConfiguringEnhancedMonitoring, v1beta1.RDSInstanceStateStorageOptimization, v1beta1.RDSInstanceStateConfiguringLogExports:
@@ -151,11 +160,19 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errUpToDateFailed)
 	}
 
+	conn := rds.GetConnectionDetails(*cr)
+	if s := cr.Status.AtProvider.MasterUserSecret; s != nil {
+		if conn == nil {
+			conn = managed.ConnectionDetails{}
+		}
+		rds.AddMasterUserSecret(ctx, e.secrets, cr, s.SecretARN, conn)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:          true,
 		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !reflect.DeepEqual(current, &cr.Spec.ForProvider),
-		ConnectionDetails:       rds.GetConnectionDetails(*cr),
+		ConnectionDetails:       conn,
 	}, nil
 }
 
@@ -168,24 +185,30 @@ func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Status.AtProvider.DBInstanceStatus == v1beta1.RDSInstanceStateCreating {
 		return managed.ExternalCreation{}, nil
 	}
-	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if err != nil {
-		return managed.ExternalCreation{}, err
-	}
-	if pw == "" {
-		pw, err = password.Generate()
+	// The password of the master user is generated by RDS if it is managed
+	// in Secrets Manager.
+	var pw string
+	if !aws.ToBool(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		var err error
+		pw, _, err = rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 		if err != nil {
 			return managed.ExternalCreation{}, err
 		}
+		if pw == "" {
+			pw, err = password.Generate()
+			if err != nil {
+				return managed.ExternalCreation{}, err
+			}
+		}
 	}
 
-	err = e.RestoreOrCreate(ctx, cr, pw)
-	if err != nil {
+	if err := e.RestoreOrCreate(ctx, cr, pw); err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
-	conn := managed.ConnectionDetails{
-		xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+	conn := managed.ConnectionDetails{}
+	if pw != "" {
+		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
 	}
 	if cr.Spec.ForProvider.MasterUsername != nil {
 		conn[xpv1.ResourceCredentialsSecretUserKey] = []byte(aws.ToString(cr.Spec.ForProvider.MasterUsername))
@@ -267,21 +290,56 @@ func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	json.NewEncoder(os.Stdout).Encode(modify)
 	var conn managed.ConnectionDetails
 
-	pwd, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if err != nil {
-		return managed.ExternalUpdate{}, err
-	}
-	if changed {
-		log.Println(cr.Name, "password changed")
-		conn = managed.ConnectionDetails{
-			xpv1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+	rotate := rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), cr.Status.AtProvider.PasswordRotatedAt)
+	if aws.ToBool(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		// RDS generates the new password of a master user secret that it
+		// manages, but only if the modification is applied immediately.
+		if rotate {
+			modify.RotateMasterUserPassword = aws.Bool(true)
+			modify.ApplyImmediately = true
+		}
+	} else {
+		pwd, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		if rotate {
+			if pwd, err = rds.StagePassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePasswordFailed)
+			}
+			changed = true
+		}
+		// RDS requires a password if it stops managing the password of the
+		// master user in Secrets Manager.
+		if modify.ManageMasterUserPassword != nil {
+			if pwd == "" {
+				if pwd, err = password.Generate(); err != nil {
+					return managed.ExternalUpdate{}, err
+				}
+			}
+			changed = true
+		}
+		if changed {
+			log.Println(cr.Name, "password changed")
+			conn = managed.ConnectionDetails{
+				xpv1.ResourceCredentialsSecretPasswordKey: []byte(pwd),
+			}
+			modify.MasterUserPassword = aws.String(pwd)
 		}
-		modify.MasterUserPassword = aws.String(pwd)
 	}
 
 	if _, err = e.client.ModifyDBInstance(ctx, modify); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyFailed)
 	}
+	if rotate {
+		if !aws.ToBool(cr.Spec.ForProvider.ManageMasterUserPassword) {
+			if err := rds.CommitPassword(ctx, e.kube, cr.Spec.ForProvider.MasterPasswordSecretRef); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePasswordFailed)
+			}
+		}
+		now := metav1.Now()
+		cr.Status.AtProvider.PasswordRotatedAt = &now
+	}
 	if len(patch.Tags) > 0 {
 		tags := make([]awsrdstypes.Tag, len(patch.Tags))
 		for i, t := range patch.Tags {
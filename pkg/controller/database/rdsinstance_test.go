@@ -30,6 +30,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	awsrdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -61,6 +62,7 @@ var (
 	snapshotIdentifier              = "my-snapshot"
 	pointInTimeDBInstanceIdentifier = "my-instance"
 	awsBackupRecoveryPointARN       = "arn:aws:backup:us-east-1:123456789012:recovery-point:1EB3B5E7-9EB-A80B-108B488B0D45"
+	masterUserSecretARN             = "arn:aws:secretsmanager:us-east-1:123456789012:secret:rds!db-1234"
 	s3Backup                        = v1beta1.RestoreBackupConfiguration{
 		Source: &s3SourceType,
 		S3: &v1beta1.S3RestoreBackupConfiguration{
@@ -103,9 +105,10 @@ func FromTimePtr(t *time.Time) *metav1.Time {
 }
 
 type args struct {
-	rds  rds.Client
-	kube client.Client
-	cr   *v1beta1.RDSInstance
+	rds     rds.Client
+	secrets rds.SecretsManagerClient
+	kube    client.Client
+	cr      *v1beta1.RDSInstance
 }
 
 type rdsModifier func(*v1beta1.RDSInstance)
@@ -156,6 +159,23 @@ func withPasswordSecretRef(s xpv1.SecretKeySelector) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.MasterPasswordSecretRef = &s }
 }
 
+func withManageMasterUserPassword(b bool) rdsModifier {
+	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.ManageMasterUserPassword = &b }
+}
+
+func withStatusMasterUserSecret(arn string) rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		r.Status.AtProvider.MasterUserSecret = &v1beta1.MasterUserSecret{SecretARN: arn, SecretStatus: "active"}
+	}
+}
+
+func withDeletionTimestamp() rdsModifier {
+	return func(r *v1beta1.RDSInstance) {
+		t := metav1.NewTime(time.Unix(0, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
 func withDeleteAutomatedBackups(b bool) rdsModifier {
 	return func(r *v1beta1.RDSInstance) { r.Spec.ForProvider.DeleteAutomatedBackups = &b }
 }
@@ -254,6 +274,153 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ManagedMasterUserSecret": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(ctx context.Context, input *awsrds.DescribeDBInstancesInput, opts []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{
+							DBInstances: []awsrdstypes.DBInstance{
+								{
+									DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									MasterUserSecret: &awsrdstypes.MasterUserSecret{
+										SecretArn:    aws.String(masterUserSecretARN),
+										SecretStatus: aws.String("active"),
+									},
+								},
+							},
+						}, nil
+					},
+				},
+				secrets: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, input *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						if aws.ToString(input.SecretId) != masterUserSecretARN {
+							return nil, errBoom
+						}
+						return &secretsmanager.GetSecretValueOutput{
+							SecretString: aws.String(`{"username":"root","password":"` + credData + `"}`),
+						}, nil
+					},
+				},
+				cr: instance(withManageMasterUserPassword(true)),
+			},
+			want: want{
+				cr: instance(
+					withManageMasterUserPassword(true),
+					withConditions(xpv1.Available(), rds.MasterUserSecretAvailable()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withStatusMasterUserSecret(masterUserSecretARN)),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey:     []byte(masterUsername),
+						xpv1.ResourceCredentialsSecretPasswordKey: []byte(credData),
+					},
+				},
+			},
+		},
+		"ManagedMasterUserSecretNotEnabled": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(ctx context.Context, input *awsrds.DescribeDBInstancesInput, opts []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{
+							DBInstances: []awsrdstypes.DBInstance{
+								{
+									DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+								},
+							},
+						}, nil
+					},
+				},
+				cr: instance(withManageMasterUserPassword(true)),
+			},
+			want: want{
+				cr: instance(
+					withManageMasterUserPassword(true),
+					withConditions(xpv1.Available()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable))),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  false,
+					ConnectionDetails: rds.GetConnectionDetails(v1beta1.RDSInstance{}),
+				},
+			},
+		},
+		"FailedGetMasterUserSecret": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(ctx context.Context, input *awsrds.DescribeDBInstancesInput, opts []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{
+							DBInstances: []awsrdstypes.DBInstance{
+								{
+									DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateAvailable)),
+									MasterUserSecret: &awsrdstypes.MasterUserSecret{
+										SecretArn:    aws.String(masterUserSecretARN),
+										SecretStatus: aws.String("active"),
+									},
+								},
+							},
+						}, nil
+					},
+				},
+				secrets: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, _ *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withManageMasterUserPassword(true)),
+			},
+			want: want{
+				cr: instance(
+					withManageMasterUserPassword(true),
+					withConditions(xpv1.Available(), rds.MasterUserSecretUnavailable(errors.Wrap(errBoom, "cannot get master user secret value"))),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateAvailable)),
+					withStatusMasterUserSecret(masterUserSecretARN)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
+		"DeletedSkipsMasterUserSecret": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockDescribe: func(ctx context.Context, input *awsrds.DescribeDBInstancesInput, opts []func(*awsrds.Options)) (*awsrds.DescribeDBInstancesOutput, error) {
+						return &awsrds.DescribeDBInstancesOutput{
+							DBInstances: []awsrdstypes.DBInstance{
+								{
+									DBInstanceStatus: aws.String(string(v1beta1.RDSInstanceStateDeleting)),
+									MasterUserSecret: &awsrdstypes.MasterUserSecret{
+										SecretArn:    aws.String(masterUserSecretARN),
+										SecretStatus: aws.String("active"),
+									},
+								},
+							},
+						}, nil
+					},
+				},
+				secrets: &fake.MockSecretsManagerClient{
+					MockGetSecretValue: func(_ context.Context, _ *secretsmanager.GetSecretValueInput, _ []func(*secretsmanager.Options)) (*secretsmanager.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withManageMasterUserPassword(true), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: instance(
+					withManageMasterUserPassword(true),
+					withDeletionTimestamp(),
+					withConditions(xpv1.Deleting()),
+					withDBInstanceStatus(string(v1beta1.RDSInstanceStateDeleting)),
+					withStatusMasterUserSecret(masterUserSecretARN)),
+				result: managed.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: managed.ConnectionDetails{},
+				},
+			},
+		},
 		"AutoscaledStorageIsUpToDate": { // if aws scales storage up, we should still consider it up to date, even if initial storage size was provided
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -486,7 +653,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, secrets: tc.secrets}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -534,6 +701,30 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulCreateManagedMasterUserPassword": {
+			args: args{
+				rds: &fake.MockRDSClient{
+					MockCreate: func(ctx context.Context, input *awsrds.CreateDBInstanceInput, opts []func(*awsrds.Options)) (*awsrds.CreateDBInstanceOutput, error) {
+						if !aws.ToBool(input.ManageMasterUserPassword) || input.MasterUserPassword != nil {
+							return nil, errBoom
+						}
+						return &awsrds.CreateDBInstanceOutput{}, nil
+					},
+				},
+				cr: instance(withMasterUsername(&masterUsername), withManageMasterUserPassword(true)),
+			},
+			want: want{
+				cr: instance(
+					withMasterUsername(&masterUsername),
+					withManageMasterUserPassword(true),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						xpv1.ResourceCredentialsSecretUserKey: []byte(masterUsername),
+					},
+				},
+			},
+		},
 		"SuccessfulS3Restore": {
 			args: args{
 				rds: &fake.MockRDSClient{
@@ -725,7 +916,7 @@ func TestCreate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, secrets: tc.secrets}
 			o, err := e.Create(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -982,7 +1173,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, secrets: tc.secrets}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -1101,7 +1292,7 @@ func TestDelete(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.rds}
+			e := &external{kube: tc.kube, client: tc.rds, secrets: tc.secrets}
 			err := e.Delete(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
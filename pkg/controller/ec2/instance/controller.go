@@ -18,6 +18,7 @@ package instance
 
 import (
 	"context"
+	"encoding/base64"
 	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -30,7 +31,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -41,6 +41,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -54,6 +55,8 @@ const (
 	errModifyInstanceAttributes = "failed to modify the Instance resource attributes"
 	errCreateTags               = "failed to create tags for the Instance resource"
 	errDelete                   = "failed to delete the Instance resource"
+	errStart                    = "failed to start the Instance resource"
+	errStop                     = "failed to stop the Instance resource"
 )
 
 // SetupInstance adds a controller that reconciles Instances.
@@ -68,14 +71,15 @@ func SetupInstance(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Instance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.InstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewInstanceClient})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithConnectionPublishers(),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -102,6 +106,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 type external struct {
 	kube   client.Client
 	client ec2.InstanceClient
+
+	// userData is the user data attribute of the instance as it was last
+	// observed. The same external client observes and then updates an
+	// instance, so Update does not need to describe it again.
+	userData *types.AttributeValue
 }
 
 func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
@@ -191,6 +200,8 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		}
 	}
 
+	e.userData = o.UserData
+
 	ec2.LateInitializeInstance(&cr.Spec.ForProvider, &observed, &o)
 
 	if !cmp.Equal(current, &cr.Spec.ForProvider) {
@@ -207,6 +218,8 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		cr.SetConditions(xpv1.Creating())
 	case ec2.Available:
 		cr.SetConditions(xpv1.Available())
+	case ec2.Unavailable:
+		cr.SetConditions(xpv1.Unavailable())
 	case ec2.Deleting:
 		cr.SetConditions(xpv1.Deleting())
 	case ec2.Deleted:
@@ -261,6 +274,30 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
 	}
 
+	// Wait for a pending start or stop to finish before making any further
+	// calls, the next reconcile picks up from the new state.
+	state := cr.Status.AtProvider.State
+	if ec2.IsInstanceStateTransitional(state) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	// Some attributes can only be modified while the instance is stopped.
+	// The instance is stopped first and modified once it reached the
+	// stopped state, after which the desired state is restored below.
+	if e.needsStop(cr) {
+		switch state {
+		case string(types.InstanceStateNameRunning):
+			_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+				InstanceIds: []string{meta.GetExternalName(cr)},
+			})
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errStop)
+		case string(types.InstanceStateNameStopped):
+			if err := e.modifyStoppedAttributes(ctx, cr); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+	}
+
 	if cr.Spec.ForProvider.DisableAPITermination != nil {
 		modifyInput := &awsec2.ModifyInstanceAttributeInput{
 			InstanceId: aws.String(meta.GetExternalName(cr)),
@@ -317,26 +354,90 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 		}
 	}
 
-	if cr.Spec.ForProvider.UserData != nil {
-		modifyInput := &awsec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(meta.GetExternalName(cr)),
-			UserData: &types.BlobAttributeValue{
-				Value: []byte(*cr.Spec.ForProvider.UserData),
-			},
-		}
-		_, err := e.client.ModifyInstanceAttribute(ctx, modifyInput)
+	if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+		Resources: []string{meta.GetExternalName(cr)},
+		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
+	}); err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+	}
 
+	return managed.ExternalUpdate{}, e.updatePowerState(ctx, cr)
+}
+
+// needsStop returns true if any of the attributes that can only be modified
+// while the instance is stopped differ from the spec.
+func (e *external) needsStop(cr *svcapitypes.Instance) bool {
+	p := cr.Spec.ForProvider
+	if p.InstanceType != "" && p.InstanceType != cr.Status.AtProvider.InstanceType {
+		return true
+	}
+	if p.EBSOptimized != nil && awsclient.BoolValue(p.EBSOptimized) != awsclient.BoolValue(cr.Status.AtProvider.EBSOptimized) {
+		return true
+	}
+	if p.UserData == nil {
+		return false
+	}
+	return e.userData == nil || awsclient.StringValue(p.UserData) != awsclient.StringValue(e.userData.Value)
+}
+
+func (e *external) modifyStoppedAttributes(ctx context.Context, cr *svcapitypes.Instance) error {
+	p := cr.Spec.ForProvider
+	inputs := []*awsec2.ModifyInstanceAttributeInput{}
+	if p.InstanceType != "" && p.InstanceType != cr.Status.AtProvider.InstanceType {
+		inputs = append(inputs, &awsec2.ModifyInstanceAttributeInput{
+			InstanceType: &types.AttributeValue{Value: aws.String(p.InstanceType)},
+		})
+	}
+	if p.EBSOptimized != nil && awsclient.BoolValue(p.EBSOptimized) != awsclient.BoolValue(cr.Status.AtProvider.EBSOptimized) {
+		inputs = append(inputs, &awsec2.ModifyInstanceAttributeInput{
+			EbsOptimized: &types.AttributeBooleanValue{Value: p.EBSOptimized},
+		})
+	}
+	if p.UserData != nil {
+		// userData is base64 encoded in the spec, while the SDK encodes the
+		// given value itself.
+		data, err := base64.StdEncoding.DecodeString(*p.UserData)
 		if err != nil {
-			return managed.ExternalUpdate{}, awsclient.Wrap(err, errModifyInstanceAttributes)
+			data = []byte(*p.UserData)
 		}
+		inputs = append(inputs, &awsec2.ModifyInstanceAttributeInput{
+			UserData: &types.BlobAttributeValue{Value: data},
+		})
 	}
+	for _, in := range inputs {
+		in.InstanceId = aws.String(meta.GetExternalName(cr))
+		if _, err := e.client.ModifyInstanceAttribute(ctx, in); err != nil {
+			return awsclient.Wrap(err, errModifyInstanceAttributes)
+		}
+	}
+	return nil
+}
 
-	_, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
-		Resources: []string{meta.GetExternalName(cr)},
-		Tags:      svcapitypes.GenerateEC2Tags(cr.Spec.ForProvider.Tags),
-	})
-
-	return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+func (e *external) updatePowerState(ctx context.Context, cr *svcapitypes.Instance) error {
+	state := cr.Status.AtProvider.State
+	if ec2.IsInstancePowerStateUpToDate(cr.Spec.ForProvider.DesiredState, state) {
+		return nil
+	}
+	switch awsclient.StringValue(cr.Spec.ForProvider.DesiredState) {
+	case svcapitypes.InstanceDesiredStateRunning:
+		if state != string(types.InstanceStateNameStopped) {
+			return nil
+		}
+		_, err := e.client.StartInstances(ctx, &awsec2.StartInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+		})
+		return awsclient.Wrap(err, errStart)
+	case svcapitypes.InstanceDesiredStateStopped, svcapitypes.InstanceDesiredStateHibernated:
+		if state != string(types.InstanceStateNameRunning) {
+			return nil
+		}
+		_, err := e.client.StopInstances(ctx, &awsec2.StopInstancesInput{
+			InstanceIds: []string{meta.GetExternalName(cr)},
+			Hibernate:   aws.Bool(awsclient.StringValue(cr.Spec.ForProvider.DesiredState) == svcapitypes.InstanceDesiredStateHibernated),
+		})
+		return awsclient.Wrap(err, errStop)
+	}
+	return nil
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
@@ -51,6 +51,7 @@ type args struct {
 	instance ec2.InstanceClient
 	kube     client.Client
 	cr       *manualv1alpha1.Instance
+	userData *types.AttributeValue
 }
 
 type instanceModifier func(*manualv1alpha1.Instance)
@@ -135,11 +136,13 @@ func TestObserve(t *testing.T) {
 				},
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
 					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
 				}), withExternalName(instanceID)),
 			},
 			want: want{
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
 					InstanceType: string(types.InstanceTypeM1Small),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
 				}), withStatus(manualv1alpha1.InstanceObservation{
 					InstanceID:   &instanceID,
 					InstanceType: string(types.InstanceTypeM1Small),
@@ -384,6 +387,183 @@ func TestUpdate(t *testing.T) {
 				cr: instance(withSpec(manualv1alpha1.InstanceParameters{})),
 			},
 		},
+		"TransitionalState": {
+			args: args{
+				instance: &fake.MockInstanceClient{},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameStopping),
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameStopping),
+				})),
+			},
+		},
+		"StopToChangeInstanceType": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if aws.ToBool(input.Hibernate) {
+							return nil, errBoom
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameRunning),
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameRunning),
+				})),
+			},
+		},
+		"ModifyStoppedAndStart": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockModifyInstanceAttribute: func(ctx context.Context, input *awsec2.ModifyInstanceAttributeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyInstanceAttributeOutput, error) {
+						if input.InstanceType == nil || aws.ToString(input.InstanceType.Value) != string(types.InstanceTypeM5Large) {
+							return nil, errBoom
+						}
+						return &awsec2.ModifyInstanceAttributeOutput{}, nil
+					},
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return &awsec2.StartInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameStopped),
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					InstanceType: string(types.InstanceTypeM5Large),
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					InstanceType: string(types.InstanceTypeM1Small),
+					State:        string(types.InstanceStateNameStopped),
+				})),
+			},
+		},
+		"StopToChangeUserData": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserData: aws.String("bmV3"),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+				userData: &types.AttributeValue{Value: aws.String("b2xk")},
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserData: aws.String("bmV3"),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+			},
+		},
+		"UserDataUpToDate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserData: aws.String("b2xk"),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+				userData: &types.AttributeValue{Value: aws.String("b2xk")},
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					UserData: aws.String("b2xk"),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+			},
+		},
+		"Hibernate": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStopInstances: func(ctx context.Context, input *awsec2.StopInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StopInstancesOutput, error) {
+						if !aws.ToBool(input.Hibernate) {
+							return nil, errBoom
+						}
+						return &awsec2.StopInstancesOutput{}, nil
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateHibernated),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameRunning),
+				})),
+			},
+		},
+		"StartFailed": {
+			args: args{
+				instance: &fake.MockInstanceClient{
+					MockCreateTags: func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+						return &awsec2.CreateTagsOutput{}, nil
+					},
+					MockStartInstances: func(ctx context.Context, input *awsec2.StartInstancesInput, opts []func(*awsec2.Options)) (*awsec2.StartInstancesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				})),
+			},
+			want: want{
+				cr: instance(withSpec(manualv1alpha1.InstanceParameters{
+					DesiredState: aws.String(manualv1alpha1.InstanceDesiredStateRunning),
+				}), withStatus(manualv1alpha1.InstanceObservation{
+					State: string(types.InstanceStateNameStopped),
+				})),
+				err: awsclient.Wrap(errBoom, errStart),
+			},
+		},
 		"ModifyFailed": {
 			args: args{
 				instance: &fake.MockInstanceClient{
@@ -405,7 +585,7 @@ func TestUpdate(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.instance}
+			e := &external{kube: tc.kube, client: tc.instance, userData: tc.userData}
 			u, err := e.Update(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IPAM resource"
+	errDescribe         = "failed to describe IPAM"
+	errMultipleItems    = "retrieved multiple IPAMs for the given ipamId"
+	errCreate           = "failed to create the IPAM resource"
+	errModify           = "failed to modify the IPAM resource"
+	errCreateTags       = "failed to create tags for the IPAM resource"
+	errDeleteTags       = "failed to delete tags for the IPAM resource"
+	errDelete           = "failed to delete the IPAM resource"
+)
+
+// SetupIPAM adds a controller that reconciles IPAMs.
+func SetupIPAM(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(networkv1alpha1.IPAMGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&networkv1alpha1.IPAM{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(networkv1alpha1.IPAMGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewIPAMClient})),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.IPAMClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*networkv1alpha1.IPAM)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, aws.ToString(cr.Spec.ForProvider.Region))
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.IPAMClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*networkv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsIPAMNotFoundErr, err), errDescribe)
+	}
+	if observed.State == awsec2types.IpamStateDeleteComplete {
+		return managed.ExternalObservation{}, nil
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeIPAM(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = ec2.GenerateIPAMObservation(*observed)
+
+	switch observed.State { // nolint:exhaustive
+	case awsec2types.IpamStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.IpamStateDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.IpamStateCreateFailed, awsec2types.IpamStateModifyFailed, awsec2types.IpamStateDeleteFailed:
+		cr.SetConditions(xpv1.Unavailable())
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        ec2.IsIPAMStateInProgress(string(observed.State)) || ec2.IsIPAMUpToDate(cr.Spec.ForProvider, *observed),
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*networkv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	result, err := e.client.CreateIpam(ctx, ec2.GenerateCreateIPAMInput(string(cr.UID), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if result.Ipam != nil {
+		meta.SetExternalName(cr, aws.ToString(result.Ipam.IpamId))
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mgd.(*networkv1alpha1.IPAM)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if ec2.IsIPAMStateInProgress(string(observed.State)) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	addTags, removeTags := awsclient.DiffEC2Tags(networkv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	add, remove := ec2.DiffIPAMOperatingRegions(cr.Spec.ForProvider, *observed)
+	if len(add) == 0 && len(remove) == 0 && aws.ToString(cr.Spec.ForProvider.Description) == aws.ToString(observed.Description) {
+		return managed.ExternalUpdate{}, nil
+	}
+	_, err = e.client.ModifyIpam(ctx, &awsec2.ModifyIpamInput{
+		IpamId:                 aws.String(meta.GetExternalName(cr)),
+		Description:            aws.String(aws.ToString(cr.Spec.ForProvider.Description)),
+		AddOperatingRegions:    add,
+		RemoveOperatingRegions: remove,
+	})
+	return managed.ExternalUpdate{}, awsclient.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*networkv1alpha1.IPAM)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == string(awsec2types.IpamStateDeleteInProgress) {
+		return nil
+	}
+
+	_, err := e.client.DeleteIpam(ctx, &awsec2.DeleteIpamInput{
+		IpamId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsIPAMNotFoundErr, err), errDelete)
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.Ipam, error) {
+	response, err := e.client.DescribeIpams(ctx, &awsec2.DescribeIpamsInput{
+		IpamIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(response.Ipams) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.Ipams[0], nil
+}
@@ -0,0 +1,567 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipam
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	ipamID = "ipam-1234"
+	region = "us-east-1"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMClient
+	cr     *networkv1alpha1.IPAM
+}
+
+type ipamModifier func(*networkv1alpha1.IPAM)
+
+func withExternalName(name string) ipamModifier {
+	return func(r *networkv1alpha1.IPAM) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) ipamModifier {
+	return func(r *networkv1alpha1.IPAM) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s networkv1alpha1.IPAMObservation) ipamModifier {
+	return func(r *networkv1alpha1.IPAM) { r.Status.AtProvider = s }
+}
+
+func withDescription(d string) ipamModifier {
+	return func(r *networkv1alpha1.IPAM) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withTags(t ...networkv1alpha1.Tag) ipamModifier {
+	return func(r *networkv1alpha1.IPAM) { r.Spec.ForProvider.Tags = t }
+}
+
+func ipam(m ...ipamModifier) *networkv1alpha1.IPAM {
+	cr := &networkv1alpha1.IPAM{
+		Spec: networkv1alpha1.IPAMSpec{
+			ForProvider: networkv1alpha1.IPAMParameters{
+				Region: aws.String(region),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeIPAMs(i ...types.Ipam) func(context.Context, *awsec2.DescribeIpamsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+		return &awsec2.DescribeIpamsOutput{Ipams: i}, nil
+	}
+}
+
+func observedIPAM(state types.IpamState) types.Ipam {
+	return types.Ipam{
+		IpamId:           aws.String(ipamID),
+		Description:      aws.String("desc"),
+		State:            state,
+		OperatingRegions: []types.IpamOperatingRegion{{RegionName: aws.String(region)}},
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.IPAM
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockIPAMClient{},
+				cr:     ipam(),
+			},
+			want: want{
+				cr: ipam(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateCreateComplete)),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withDescription("desc"),
+					withStatus(networkv1alpha1.IPAMObservation{IPAMID: ipamID, State: string(types.IpamStateCreateComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateCreateComplete)),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withDescription("desc"),
+					withStatus(networkv1alpha1.IPAMObservation{IPAMID: ipamID, State: string(types.IpamStateCreateComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateModifyComplete)),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("other")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withDescription("other"),
+					withStatus(networkv1alpha1.IPAMObservation{IPAMID: ipamID, State: string(types.IpamStateModifyComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"CreateInProgress": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateCreateInProgress)),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("other")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withDescription("other"),
+					withStatus(networkv1alpha1.IPAMObservation{IPAMID: ipamID, State: string(types.IpamStateCreateInProgress)}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateCreateFailed)),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc")),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withDescription("desc"),
+					withStatus(networkv1alpha1.IPAMObservation{IPAMID: ipamID, State: string(types.IpamStateCreateFailed)}),
+					withConditions(xpv1.Unavailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateDeleteComplete)),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound}
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"MultipleItems": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(observedIPAM(types.IpamStateCreateComplete), observedIPAM(types.IpamStateCreateComplete)),
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID)),
+				err: awsclient.Wrap(errors.New(errMultipleItems), errDescribe),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAM
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamOutput, error) {
+						return &awsec2.CreateIpamOutput{Ipam: &types.Ipam{IpamId: aws.String(ipamID)}}, nil
+					},
+				},
+				cr: ipam(),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(),
+			},
+			want: want{
+				cr:  ipam(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify     *awsec2.ModifyIpamInput
+		createTags *awsec2.CreateTagsInput
+		deleteTags *awsec2.DeleteTagsInput
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyDescriptionAndRegions": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(types.Ipam{
+						IpamId:           aws.String(ipamID),
+						State:            types.IpamStateCreateComplete,
+						OperatingRegions: []types.IpamOperatingRegion{{RegionName: aws.String(region)}, {RegionName: aws.String("eu-west-1")}},
+					}),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc"), func(r *networkv1alpha1.IPAM) {
+					r.Spec.ForProvider.OperatingRegions = []string{"us-west-2"}
+				}),
+			},
+			want: want{
+				modify: &awsec2.ModifyIpamInput{
+					IpamId:                 aws.String(ipamID),
+					Description:            aws.String("desc"),
+					AddOperatingRegions:    []types.AddIpamOperatingRegion{{RegionName: aws.String("us-west-2")}},
+					RemoveOperatingRegions: []types.RemoveIpamOperatingRegion{{RegionName: aws.String("eu-west-1")}},
+				},
+			},
+		},
+		"UpdateTags": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(types.Ipam{
+						IpamId:           aws.String(ipamID),
+						Description:      aws.String("desc"),
+						State:            types.IpamStateCreateComplete,
+						OperatingRegions: []types.IpamOperatingRegion{{RegionName: aws.String(region)}},
+						Tags:             []types.Tag{{Key: aws.String("old"), Value: aws.String("value")}},
+					}),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc"), withTags(networkv1alpha1.Tag{Key: "new", Value: "value"})),
+			},
+			want: want{
+				createTags: &awsec2.CreateTagsInput{
+					Resources: []string{ipamID},
+					Tags:      []types.Tag{{Key: aws.String("new"), Value: aws.String("value")}},
+				},
+				deleteTags: &awsec2.DeleteTagsInput{
+					Resources: []string{ipamID},
+					Tags:      []types.Tag{{Key: aws.String("old")}},
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(types.Ipam{
+						IpamId: aws.String(ipamID),
+						State:  types.IpamStateModifyInProgress,
+					}),
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc")),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: describeIPAMs(types.Ipam{
+						IpamId:           aws.String(ipamID),
+						State:            types.IpamStateCreateComplete,
+						OperatingRegions: []types.IpamOperatingRegion{{RegionName: aws.String(region)}},
+					}),
+					MockModify: func(ctx context.Context, input *awsec2.ModifyIpamInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID), withDescription("desc")),
+			},
+			want: want{
+				modify: &awsec2.ModifyIpamInput{
+					IpamId:      aws.String(ipamID),
+					Description: aws.String("desc"),
+				},
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				modify     *awsec2.ModifyIpamInput
+				createTags *awsec2.CreateTagsInput
+				deleteTags *awsec2.DeleteTagsInput
+			)
+			c := tc.client.(*fake.MockIPAMClient)
+			if c.MockModify == nil {
+				c.MockModify = func(ctx context.Context, input *awsec2.ModifyIpamInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+					modify = input
+					return &awsec2.ModifyIpamOutput{}, nil
+				}
+			} else {
+				mock := c.MockModify
+				c.MockModify = func(ctx context.Context, input *awsec2.ModifyIpamInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamOutput, error) {
+					modify = input
+					return mock(ctx, input, opts)
+				}
+			}
+			c.MockCreateTags = func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+				createTags = input
+				return &awsec2.CreateTagsOutput{}, nil
+			}
+			c.MockDeleteTags = func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+				deleteTags = input
+				return &awsec2.DeleteTagsOutput{}, nil
+			}
+
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(awsec2.ModifyIpamInput{}, types.AddIpamOperatingRegion{}, types.RemoveIpamOperatingRegion{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.createTags, createTags, cmpopts.IgnoreUnexported(awsec2.CreateTagsInput{}, types.Tag{})); diff != "" {
+				t.Errorf("createTags: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleteTags, deleteTags, cmpopts.IgnoreUnexported(awsec2.DeleteTagsInput{}, types.Tag{})); diff != "" {
+				t.Errorf("deleteTags: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAM
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return &awsec2.DeleteIpamOutput{}, nil
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockIPAMClient{},
+				cr: ipam(withExternalName(ipamID),
+					withStatus(networkv1alpha1.IPAMObservation{State: string(types.IpamStateDeleteInProgress)})),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withConditions(xpv1.Deleting()),
+					withStatus(networkv1alpha1.IPAMObservation{State: string(types.IpamStateDeleteInProgress)})),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMIDNotFound}
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr: ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: ipam(withExternalName(ipamID)),
+			},
+			want: want{
+				cr:  ipam(withExternalName(ipamID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
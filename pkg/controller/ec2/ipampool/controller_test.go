@@ -0,0 +1,515 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampool
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	poolID  = "ipam-pool-1234"
+	scopeID = "ipam-scope-1234"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMPoolClient
+	cr     *networkv1alpha1.IPAMPool
+}
+
+type poolModifier func(*networkv1alpha1.IPAMPool)
+
+func withExternalName(name string) poolModifier {
+	return func(r *networkv1alpha1.IPAMPool) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) poolModifier {
+	return func(r *networkv1alpha1.IPAMPool) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s networkv1alpha1.IPAMPoolObservation) poolModifier {
+	return func(r *networkv1alpha1.IPAMPool) { r.Status.AtProvider = s }
+}
+
+func withSpec(p networkv1alpha1.IPAMPoolParameters) poolModifier {
+	return func(r *networkv1alpha1.IPAMPool) { r.Spec.ForProvider = p }
+}
+
+func pool(m ...poolModifier) *networkv1alpha1.IPAMPool {
+	cr := &networkv1alpha1.IPAMPool{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(m ...func(*networkv1alpha1.IPAMPoolParameters)) networkv1alpha1.IPAMPoolParameters {
+	p := networkv1alpha1.IPAMPoolParameters{
+		Region:               aws.String("us-east-1"),
+		IPAMScopeID:          aws.String(scopeID),
+		AddressFamily:        string(types.AddressFamilyIpv4),
+		Locale:               aws.String("us-east-1"),
+		Description:          aws.String("desc"),
+		AutoImport:           aws.Bool(false),
+		PubliclyAdvertisable: aws.Bool(false),
+	}
+	for _, f := range m {
+		f(&p)
+	}
+	return p
+}
+
+func observedPool(state types.IpamPoolState) types.IpamPool {
+	return types.IpamPool{
+		IpamPoolId:           aws.String(poolID),
+		AddressFamily:        types.AddressFamilyIpv4,
+		Locale:               aws.String("us-east-1"),
+		Description:          aws.String("desc"),
+		AutoImport:           aws.Bool(false),
+		PubliclyAdvertisable: aws.Bool(false),
+		State:                state,
+	}
+}
+
+func describePools(p ...types.IpamPool) func(context.Context, *awsec2.DescribeIpamPoolsInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+		return &awsec2.DescribeIpamPoolsOutput{IpamPools: p}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.IPAMPool
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{},
+				cr:     pool(withSpec(params())),
+			},
+			want: want{
+				cr: pool(withSpec(params())),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateCreateComplete)),
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID),
+					withStatus(networkv1alpha1.IPAMPoolObservation{IPAMPoolID: poolID, State: string(types.IpamPoolStateCreateComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateCreateComplete)),
+				},
+				cr: pool(withSpec(params(func(p *networkv1alpha1.IPAMPoolParameters) {
+					p.Locale = nil
+					p.AutoImport = nil
+				})), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID),
+					withStatus(networkv1alpha1.IPAMPoolObservation{IPAMPoolID: poolID, State: string(types.IpamPoolStateCreateComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateCreateComplete)),
+				},
+				cr: pool(withSpec(params(func(p *networkv1alpha1.IPAMPoolParameters) {
+					p.AllocationMaxNetmaskLength = aws.Int32(28)
+				})), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params(func(p *networkv1alpha1.IPAMPoolParameters) {
+					p.AllocationMaxNetmaskLength = aws.Int32(28)
+				})), withExternalName(poolID),
+					withStatus(networkv1alpha1.IPAMPoolObservation{IPAMPoolID: poolID, State: string(types.IpamPoolStateCreateComplete)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"CreateFailed": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(func() types.IpamPool {
+						p := observedPool(types.IpamPoolStateCreateFailed)
+						p.StateMessage = aws.String("overlap")
+						return p
+					}()),
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID),
+					withStatus(networkv1alpha1.IPAMPoolObservation{
+						IPAMPoolID:   poolID,
+						State:        string(types.IpamPoolStateCreateFailed),
+						StateMessage: "overlap",
+					}),
+					withConditions(xpv1.Unavailable().WithMessage("overlap"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateDeleteComplete)),
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound}
+					},
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				cr:  pool(withSpec(params()), withExternalName(poolID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMPool
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamPoolOutput, error) {
+						if aws.ToString(input.IpamScopeId) != scopeID || input.AddressFamily != types.AddressFamilyIpv4 {
+							return nil, errBoom
+						}
+						return &awsec2.CreateIpamPoolOutput{IpamPool: &types.IpamPool{IpamPoolId: aws.String(poolID)}}, nil
+					},
+				},
+				cr: pool(withSpec(params())),
+			},
+			want: want{
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamPoolOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withSpec(params())),
+			},
+			want: want{
+				cr:  pool(withSpec(params())),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify     *awsec2.ModifyIpamPoolInput
+		deleteTags *awsec2.DeleteTagsInput
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyNetmaskLengths": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateCreateComplete)),
+				},
+				cr: pool(withSpec(params(func(p *networkv1alpha1.IPAMPoolParameters) {
+					p.AllocationMinNetmaskLength = aws.Int32(16)
+					p.AllocationMaxNetmaskLength = aws.Int32(28)
+				})), withExternalName(poolID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyIpamPoolInput{
+					IpamPoolId:                 aws.String(poolID),
+					AllocationMinNetmaskLength: aws.Int32(16),
+					AllocationMaxNetmaskLength: aws.Int32(28),
+				},
+			},
+		},
+		"RemoveTags": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(func() types.IpamPool {
+						p := observedPool(types.IpamPoolStateCreateComplete)
+						p.Tags = []types.Tag{{Key: aws.String("key"), Value: aws.String("value")}}
+						return p
+					}()),
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				deleteTags: &awsec2.DeleteTagsInput{
+					Resources: []string{poolID},
+					Tags:      []types.Tag{{Key: aws.String("key")}},
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: describePools(observedPool(types.IpamPoolStateModifyInProgress)),
+				},
+				cr: pool(withSpec(params(func(p *networkv1alpha1.IPAMPoolParameters) {
+					p.Description = aws.String("other")
+				})), withExternalName(poolID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamPoolsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamPoolsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withSpec(params()), withExternalName(poolID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				modify     *awsec2.ModifyIpamPoolInput
+				deleteTags *awsec2.DeleteTagsInput
+			)
+			c := tc.client.(*fake.MockIPAMPoolClient)
+			c.MockModify = func(ctx context.Context, input *awsec2.ModifyIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamPoolOutput, error) {
+				modify = input
+				return &awsec2.ModifyIpamPoolOutput{}, nil
+			}
+			c.MockDeleteTags = func(ctx context.Context, input *awsec2.DeleteTagsInput, opts []func(*awsec2.Options)) (*awsec2.DeleteTagsOutput, error) {
+				deleteTags = input
+				return &awsec2.DeleteTagsOutput{}, nil
+			}
+
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(awsec2.ModifyIpamPoolInput{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.deleteTags, deleteTags, cmpopts.IgnoreUnexported(awsec2.DeleteTagsInput{}, types.Tag{})); diff != "" {
+				t.Errorf("deleteTags: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMPool
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+						return &awsec2.DeleteIpamPoolOutput{}, nil
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{},
+				cr: pool(withExternalName(poolID),
+					withStatus(networkv1alpha1.IPAMPoolObservation{State: string(types.IpamPoolStateDeleteInProgress)})),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withConditions(xpv1.Deleting()),
+					withStatus(networkv1alpha1.IPAMPoolObservation{State: string(types.IpamPoolStateDeleteInProgress)})),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMPoolIDNotFound}
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr: pool(withExternalName(poolID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMPoolClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamPoolInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamPoolOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: pool(withExternalName(poolID)),
+			},
+			want: want{
+				cr:  pool(withExternalName(poolID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampoolcidr
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an IPAMPoolCIDR resource"
+	errDescribe         = "failed to get the CIDRs of the IPAM pool"
+	errProvision        = "failed to provision the IPAMPoolCIDR resource"
+	errDeprovision      = "failed to deprovision the IPAMPoolCIDR resource"
+)
+
+// SetupIPAMPoolCIDR adds a controller that reconciles IPAMPoolCIDRs.
+func SetupIPAMPoolCIDR(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(networkv1alpha1.IPAMPoolCIDRGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&networkv1alpha1.IPAMPoolCIDR{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(networkv1alpha1.IPAMPoolCIDRGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewIPAMPoolCIDRClient})),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.IPAMPoolCIDRClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*networkv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, aws.ToString(cr.Spec.ForProvider.Region))
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.IPAMPoolCIDRClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*networkv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.find(ctx, aws.ToString(cr.Spec.ForProvider.IPAMPoolID), meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errDescribe)
+	}
+	if observed == nil || observed.State == awsec2types.IpamPoolCidrStateDeprovisioned {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = ec2.GenerateIPAMPoolCIDRObservation(*observed)
+
+	switch observed.State { // nolint:exhaustive
+	case awsec2types.IpamPoolCidrStatePendingProvision, awsec2types.IpamPoolCidrStatePendingImport:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.IpamPoolCidrStatePendingDeprovision:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.IpamPoolCidrStateFailedProvision, awsec2types.IpamPoolCidrStateFailedImport:
+		// A CIDR that failed to be provisioned is not part of the pool and
+		// does not have to be deprovisioned.
+		if meta.WasDeleted(cr) {
+			return managed.ExternalObservation{}, nil
+		}
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.FailureMessage))
+	case awsec2types.IpamPoolCidrStateFailedDeprovision:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(cr.Status.AtProvider.FailureMessage))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// All the parameters of a CIDR are immutable.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*networkv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	result, err := e.client.ProvisionIpamPoolCidr(ctx, &awsec2.ProvisionIpamPoolCidrInput{
+		IpamPoolId: cr.Spec.ForProvider.IPAMPoolID,
+		Cidr:       aws.String(cr.Spec.ForProvider.CIDR),
+	})
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errProvision)
+	}
+	cidr := cr.Spec.ForProvider.CIDR
+	if result.IpamPoolCidr != nil && result.IpamPoolCidr.Cidr != nil {
+		cidr = aws.ToString(result.IpamPoolCidr.Cidr)
+	}
+	meta.SetExternalName(cr, cidr)
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) {
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*networkv1alpha1.IPAMPoolCIDR)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == string(awsec2types.IpamPoolCidrStatePendingDeprovision) {
+		return nil
+	}
+
+	_, err := e.client.DeprovisionIpamPoolCidr(ctx, &awsec2.DeprovisionIpamPoolCidrInput{
+		IpamPoolId: cr.Spec.ForProvider.IPAMPoolID,
+		Cidr:       aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errDeprovision)
+}
+
+// find returns the given CIDR of the given pool, or nil if the pool does not
+// have it.
+func (e *external) find(ctx context.Context, poolID, cidr string) (*awsec2types.IpamPoolCidr, error) {
+	in := &awsec2.GetIpamPoolCidrsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+	for {
+		response, err := e.client.GetIpamPoolCidrs(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		for i := range response.IpamPoolCidrs {
+			if aws.ToString(response.IpamPoolCidrs[i].Cidr) == cidr {
+				return &response.IpamPoolCidrs[i], nil
+			}
+		}
+		if aws.ToString(response.NextToken) == "" {
+			return nil, nil
+		}
+		in.NextToken = response.NextToken
+	}
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipampoolcidr
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	poolID = "ipam-pool-1234"
+	cidr   = "10.0.0.0/8"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMPoolCIDRClient
+	cr     *networkv1alpha1.IPAMPoolCIDR
+}
+
+type poolCIDRModifier func(*networkv1alpha1.IPAMPoolCIDR)
+
+func withExternalName(name string) poolCIDRModifier {
+	return func(r *networkv1alpha1.IPAMPoolCIDR) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) poolCIDRModifier {
+	return func(r *networkv1alpha1.IPAMPoolCIDR) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s networkv1alpha1.IPAMPoolCIDRObservation) poolCIDRModifier {
+	return func(r *networkv1alpha1.IPAMPoolCIDR) { r.Status.AtProvider = s }
+}
+
+func withDeletionTimestamp() poolCIDRModifier {
+	return func(r *networkv1alpha1.IPAMPoolCIDR) {
+		now := metav1.NewTime(time.Unix(0, 0))
+		r.SetDeletionTimestamp(&now)
+	}
+}
+
+func poolCIDR(m ...poolCIDRModifier) *networkv1alpha1.IPAMPoolCIDR {
+	cr := &networkv1alpha1.IPAMPoolCIDR{
+		Spec: networkv1alpha1.IPAMPoolCIDRSpec{
+			ForProvider: networkv1alpha1.IPAMPoolCIDRParameters{
+				IPAMPoolID: aws.String(poolID),
+				CIDR:       cidr,
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func getCIDRs(c ...types.IpamPoolCidr) func(context.Context, *awsec2.GetIpamPoolCidrsInput, []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetIpamPoolCidrsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+		return &awsec2.GetIpamPoolCidrsOutput{IpamPoolCidrs: c}, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.IPAMPoolCIDR
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{},
+				cr:     poolCIDR(),
+			},
+			want: want{
+				cr: poolCIDR(),
+			},
+		},
+		"Provisioned": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(
+						types.IpamPoolCidr{Cidr: aws.String("10.1.0.0/16"), State: types.IpamPoolCidrStateProvisioned},
+						types.IpamPoolCidr{Cidr: aws.String(cidr), State: types.IpamPoolCidrStateProvisioned},
+					),
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{State: string(types.IpamPoolCidrStateProvisioned)}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"PendingProvision": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(types.IpamPoolCidr{Cidr: aws.String(cidr), State: types.IpamPoolCidrStatePendingProvision}),
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{State: string(types.IpamPoolCidrStatePendingProvision)}),
+					withConditions(xpv1.Creating())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedProvision": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(types.IpamPoolCidr{
+						Cidr:          aws.String(cidr),
+						State:         types.IpamPoolCidrStateFailedProvision,
+						FailureReason: &types.IpamPoolCidrFailureReason{Message: aws.String("overlap")},
+					}),
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{
+						State:          string(types.IpamPoolCidrStateFailedProvision),
+						FailureMessage: "overlap",
+					}),
+					withConditions(xpv1.Unavailable().WithMessage("overlap"))),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"FailedProvisionDeleted": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(types.IpamPoolCidr{Cidr: aws.String(cidr), State: types.IpamPoolCidrStateFailedProvision}),
+				},
+				cr: poolCIDR(withExternalName(cidr), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr), withDeletionTimestamp(),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{State: string(types.IpamPoolCidrStateFailedProvision)})),
+			},
+		},
+		"Deprovisioned": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(types.IpamPoolCidr{Cidr: aws.String(cidr), State: types.IpamPoolCidrStateDeprovisioned}),
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: getCIDRs(),
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+		},
+		"GetFail": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockGetCIDRs: func(ctx context.Context, input *awsec2.GetIpamPoolCidrsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolCidrsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr:  poolCIDR(withExternalName(cidr)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMPoolCIDR
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockProvision: func(ctx context.Context, input *awsec2.ProvisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						return &awsec2.ProvisionIpamPoolCidrOutput{
+							IpamPoolCidr: &types.IpamPoolCidr{Cidr: input.Cidr},
+						}, nil
+					},
+				},
+				cr: poolCIDR(),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+		},
+		"ProvisionFail": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockProvision: func(ctx context.Context, input *awsec2.ProvisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.ProvisionIpamPoolCidrOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: poolCIDR(),
+			},
+			want: want{
+				cr:  poolCIDR(),
+				err: awsclient.Wrap(errBoom, errProvision),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMPoolCIDR
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockDeprovision: func(ctx context.Context, input *awsec2.DeprovisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.DeprovisionIpamPoolCidrOutput, error) {
+						return &awsec2.DeprovisionIpamPoolCidrOutput{}, nil
+					},
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeprovisioning": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{},
+				cr: poolCIDR(withExternalName(cidr),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{State: string(types.IpamPoolCidrStatePendingDeprovision)})),
+			},
+			want: want{
+				cr: poolCIDR(withExternalName(cidr), withConditions(xpv1.Deleting()),
+					withStatus(networkv1alpha1.IPAMPoolCIDRObservation{State: string(types.IpamPoolCidrStatePendingDeprovision)})),
+			},
+		},
+		"DeprovisionFail": {
+			args: args{
+				client: &fake.MockIPAMPoolCIDRClient{
+					MockDeprovision: func(ctx context.Context, input *awsec2.DeprovisionIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.DeprovisionIpamPoolCidrOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: poolCIDR(withExternalName(cidr)),
+			},
+			want: want{
+				cr:  poolCIDR(withExternalName(cidr), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDeprovision),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,498 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ipamscope
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	scopeID = "ipam-scope-1234"
+	ipamID  = "ipam-1234"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.IPAMScopeClient
+	cr     *networkv1alpha1.IPAMScope
+}
+
+type scopeModifier func(*networkv1alpha1.IPAMScope)
+
+func withExternalName(name string) scopeModifier {
+	return func(r *networkv1alpha1.IPAMScope) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) scopeModifier {
+	return func(r *networkv1alpha1.IPAMScope) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withStatus(s networkv1alpha1.IPAMScopeObservation) scopeModifier {
+	return func(r *networkv1alpha1.IPAMScope) { r.Status.AtProvider = s }
+}
+
+func withDescription(d string) scopeModifier {
+	return func(r *networkv1alpha1.IPAMScope) { r.Spec.ForProvider.Description = aws.String(d) }
+}
+
+func withTags(t ...networkv1alpha1.Tag) scopeModifier {
+	return func(r *networkv1alpha1.IPAMScope) { r.Spec.ForProvider.Tags = t }
+}
+
+func scope(m ...scopeModifier) *networkv1alpha1.IPAMScope {
+	cr := &networkv1alpha1.IPAMScope{
+		Spec: networkv1alpha1.IPAMScopeSpec{
+			ForProvider: networkv1alpha1.IPAMScopeParameters{
+				Region: aws.String("us-east-1"),
+				IPAMID: aws.String(ipamID),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func describeScopes(s ...types.IpamScope) func(context.Context, *awsec2.DescribeIpamScopesInput, []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+		return &awsec2.DescribeIpamScopesOutput{IpamScopes: s}, nil
+	}
+}
+
+func observedScope(state types.IpamScopeState) types.IpamScope {
+	return types.IpamScope{
+		IpamScopeId:   aws.String(scopeID),
+		Description:   aws.String("desc"),
+		IpamScopeType: types.IpamScopeTypePrivate,
+		State:         state,
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.IPAMScope
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{},
+				cr:     scope(),
+			},
+			want: want{
+				cr: scope(),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateCreateComplete)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("desc")),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription("desc"),
+					withStatus(networkv1alpha1.IPAMScopeObservation{
+						IPAMScopeID:   scopeID,
+						IPAMScopeType: string(types.IpamScopeTypePrivate),
+						State:         string(types.IpamScopeStateCreateComplete),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"LateInitialize": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateCreateComplete)),
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription("desc"),
+					withStatus(networkv1alpha1.IPAMScopeObservation{
+						IPAMScopeID:   scopeID,
+						IPAMScopeType: string(types.IpamScopeTypePrivate),
+						State:         string(types.IpamScopeStateCreateComplete),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+				},
+			},
+		},
+		"NotUpToDate": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateModifyComplete)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("other")),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription("other"),
+					withStatus(networkv1alpha1.IPAMScopeObservation{
+						IPAMScopeID:   scopeID,
+						IPAMScopeType: string(types.IpamScopeTypePrivate),
+						State:         string(types.IpamScopeStateModifyComplete),
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"DeleteInProgress": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateDeleteInProgress)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("other")),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withDescription("other"),
+					withStatus(networkv1alpha1.IPAMScopeObservation{
+						IPAMScopeID:   scopeID,
+						IPAMScopeType: string(types.IpamScopeTypePrivate),
+						State:         string(types.IpamScopeStateDeleteInProgress),
+					}),
+					withConditions(xpv1.Deleting())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateDeleteComplete)),
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMScopeIDNotFound}
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr:  scope(withExternalName(scopeID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMScope
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamScopeOutput, error) {
+						if aws.ToString(input.IpamId) != ipamID {
+							return nil, errBoom
+						}
+						return &awsec2.CreateIpamScopeOutput{IpamScope: &types.IpamScope{IpamScopeId: aws.String(scopeID)}}, nil
+					},
+				},
+				cr: scope(),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.CreateIpamScopeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(),
+			},
+			want: want{
+				cr:  scope(),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify     *awsec2.ModifyIpamScopeInput
+		createTags *awsec2.CreateTagsInput
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"ModifyDescription": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateCreateComplete)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("other")),
+			},
+			want: want{
+				modify: &awsec2.ModifyIpamScopeInput{
+					IpamScopeId: aws.String(scopeID),
+					Description: aws.String("other"),
+				},
+			},
+		},
+		"AddTags": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateCreateComplete)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("desc"), withTags(networkv1alpha1.Tag{Key: "key", Value: "value"})),
+			},
+			want: want{
+				createTags: &awsec2.CreateTagsInput{
+					Resources: []string{scopeID},
+					Tags:      []types.Tag{{Key: aws.String("key"), Value: aws.String("value")}},
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: describeScopes(observedScope(types.IpamScopeStateModifyInProgress)),
+				},
+				cr: scope(withExternalName(scopeID), withDescription("other")),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeIpamScopesInput, opts []func(*awsec2.Options)) (*awsec2.DescribeIpamScopesOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var (
+				modify     *awsec2.ModifyIpamScopeInput
+				createTags *awsec2.CreateTagsInput
+			)
+			c := tc.client.(*fake.MockIPAMScopeClient)
+			c.MockModify = func(ctx context.Context, input *awsec2.ModifyIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.ModifyIpamScopeOutput, error) {
+				modify = input
+				return &awsec2.ModifyIpamScopeOutput{}, nil
+			}
+			c.MockCreateTags = func(ctx context.Context, input *awsec2.CreateTagsInput, opts []func(*awsec2.Options)) (*awsec2.CreateTagsOutput, error) {
+				createTags = input
+				return &awsec2.CreateTagsOutput{}, nil
+			}
+
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(awsec2.ModifyIpamScopeInput{})); diff != "" {
+				t.Errorf("modify: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.createTags, createTags, cmpopts.IgnoreUnexported(awsec2.CreateTagsInput{}, types.Tag{})); diff != "" {
+				t.Errorf("createTags: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.IPAMScope
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamScopeOutput, error) {
+						return &awsec2.DeleteIpamScopeOutput{}, nil
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleting": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{},
+				cr: scope(withExternalName(scopeID),
+					withStatus(networkv1alpha1.IPAMScopeObservation{State: string(types.IpamScopeStateDeleteInProgress)})),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withConditions(xpv1.Deleting()),
+					withStatus(networkv1alpha1.IPAMScopeObservation{State: string(types.IpamScopeStateDeleteInProgress)})),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamScopeOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.IPAMScopeIDNotFound}
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr: scope(withExternalName(scopeID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockIPAMScopeClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteIpamScopeInput, opts []func(*awsec2.Options)) (*awsec2.DeleteIpamScopeOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: scope(withExternalName(scopeID)),
+			},
+			want: want{
+				cr:  scope(withExternalName(scopeID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
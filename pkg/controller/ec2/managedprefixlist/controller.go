@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a ManagedPrefixList resource"
+	errDescribe         = "failed to describe ManagedPrefixList"
+	errMultipleItems    = "retrieved multiple ManagedPrefixLists for the given prefixListId"
+	errGetEntries       = "failed to get entries of ManagedPrefixList"
+	errCreate           = "failed to create the ManagedPrefixList resource"
+	errModify           = "failed to modify the ManagedPrefixList resource"
+	errCreateTags       = "failed to create tags for the ManagedPrefixList resource"
+	errDeleteTags       = "failed to delete tags for the ManagedPrefixList resource"
+	errDelete           = "failed to delete the ManagedPrefixList resource"
+)
+
+// SetupManagedPrefixList adds a controller that reconciles ManagedPrefixLists.
+func SetupManagedPrefixList(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(networkv1alpha1.ManagedPrefixListGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&networkv1alpha1.ManagedPrefixList{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(networkv1alpha1.ManagedPrefixListGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewManagedPrefixListClient})),
+			managed.WithCreationGracePeriod(3*time.Minute),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			managed.WithInitializers(),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ec2.ManagedPrefixListClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*networkv1alpha1.ManagedPrefixList)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, aws.ToString(cr.Spec.ForProvider.Region))
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg)}, nil
+}
+
+type external struct {
+	client ec2.ManagedPrefixListClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mgd.(*networkv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err), errDescribe)
+	}
+	if observed.State == awsec2types.PrefixListStateDeleteComplete {
+		return managed.ExternalObservation{}, nil
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errGetEntries)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ec2.LateInitializeManagedPrefixList(&cr.Spec.ForProvider, *observed)
+
+	cr.Status.AtProvider = ec2.GenerateManagedPrefixListObservation(*observed)
+
+	switch observed.State { // nolint:exhaustive
+	case awsec2types.PrefixListStateCreateInProgress:
+		cr.SetConditions(xpv1.Creating())
+	case awsec2types.PrefixListStateDeleteInProgress:
+		cr.SetConditions(xpv1.Deleting())
+	case awsec2types.PrefixListStateCreateFailed, awsec2types.PrefixListStateDeleteFailed:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(aws.ToString(observed.StateMessage)))
+	default:
+		cr.SetConditions(xpv1.Available())
+	}
+
+	// NOTE: A prefix list cannot be modified while an operation is in
+	// progress, so we wait for it to settle before comparing.
+	upToDate := ec2.IsManagedPrefixListInProgress(observed.State) ||
+		ec2.IsManagedPrefixListUpToDate(cr.Spec.ForProvider, *observed, entries)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mgd.(*networkv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	result, err := e.client.CreateManagedPrefixList(ctx, ec2.GenerateCreateManagedPrefixListInput(string(cr.UID), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+	}
+	if result.PrefixList != nil {
+		meta.SetExternalName(cr, aws.ToString(result.PrefixList.PrefixListId))
+	}
+	return managed.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*networkv1alpha1.ManagedPrefixList)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errDescribe)
+	}
+	if ec2.IsManagedPrefixListInProgress(observed.State) {
+		return managed.ExternalUpdate{}, nil
+	}
+
+	addTags, removeTags := awsclient.DiffEC2Tags(networkv1alpha1.GenerateEC2Tags(cr.Spec.ForProvider.Tags), observed.Tags)
+	if len(removeTags) > 0 {
+		if _, err := e.client.DeleteTags(ctx, &awsec2.DeleteTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      removeTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errDeleteTags)
+		}
+	}
+	if len(addTags) > 0 {
+		if _, err := e.client.CreateTags(ctx, &awsec2.CreateTagsInput{
+			Resources: []string{meta.GetExternalName(cr)},
+			Tags:      addTags,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errCreateTags)
+		}
+	}
+
+	entries, err := e.getEntries(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errGetEntries)
+	}
+
+	// NOTE: The size of a prefix list cannot be changed together with its
+	// entries. We grow it before and shrink it after changing the entries so
+	// that the new entries always fit. Each modification creates a new
+	// version, and the next one is done once it is complete.
+	maxEntries := aws.ToInt32(observed.MaxEntries)
+	if cr.Spec.ForProvider.MaxEntries > maxEntries {
+		return managed.ExternalUpdate{}, e.modify(ctx, &awsec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(meta.GetExternalName(cr)),
+			MaxEntries:   aws.Int32(cr.Spec.ForProvider.MaxEntries),
+		})
+	}
+
+	add, remove := ec2.DiffPrefixListEntries(cr.Spec.ForProvider.Entries, entries)
+	nameChanged := cr.Spec.ForProvider.PrefixListName != aws.ToString(observed.PrefixListName)
+	if len(add) > 0 || len(remove) > 0 || nameChanged {
+		in := &awsec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(meta.GetExternalName(cr)),
+			AddEntries:   add,
+		}
+		if len(add) > 0 || len(remove) > 0 {
+			// The current version makes the modification fail instead of
+			// overwriting the entries if someone else changed them since
+			// they were observed.
+			in.CurrentVersion = observed.Version
+			in.RemoveEntries = remove
+		}
+		if nameChanged {
+			in.PrefixListName = aws.String(cr.Spec.ForProvider.PrefixListName)
+		}
+		return managed.ExternalUpdate{}, e.modify(ctx, in)
+	}
+
+	if cr.Spec.ForProvider.MaxEntries < maxEntries {
+		return managed.ExternalUpdate{}, e.modify(ctx, &awsec2.ModifyManagedPrefixListInput{
+			PrefixListId: aws.String(meta.GetExternalName(cr)),
+			MaxEntries:   aws.Int32(cr.Spec.ForProvider.MaxEntries),
+		})
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*networkv1alpha1.ManagedPrefixList)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(xpv1.Deleting())
+	if cr.Status.AtProvider.State == string(awsec2types.PrefixListStateDeleteInProgress) {
+		return nil
+	}
+
+	_, err := e.client.DeleteManagedPrefixList(ctx, &awsec2.DeleteManagedPrefixListInput{
+		PrefixListId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ec2.IsManagedPrefixListNotFoundErr, err), errDelete)
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2types.ManagedPrefixList, error) {
+	response, err := e.client.DescribeManagedPrefixLists(ctx, &awsec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{id},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// in a successful response, there should be one and only one object
+	if len(response.PrefixLists) != 1 {
+		return nil, errors.New(errMultipleItems)
+	}
+	return &response.PrefixLists[0], nil
+}
+
+func (e *external) getEntries(ctx context.Context, id string) ([]awsec2types.PrefixListEntry, error) {
+	var entries []awsec2types.PrefixListEntry
+	in := &awsec2.GetManagedPrefixListEntriesInput{
+		PrefixListId: aws.String(id),
+	}
+	for {
+		response, err := e.client.GetManagedPrefixListEntries(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, response.Entries...)
+		if aws.ToString(response.NextToken) == "" {
+			return entries, nil
+		}
+		in.NextToken = response.NextToken
+	}
+}
+
+func (e *external) modify(ctx context.Context, in *awsec2.ModifyManagedPrefixListInput) error {
+	_, err := e.client.ModifyManagedPrefixList(ctx, in)
+	return awsclient.Wrap(err, errModify)
+}
@@ -0,0 +1,496 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package managedprefixlist
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ec2/networkv1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	plID   = "pl-1234"
+	plName = "pl"
+	cidr1  = "10.0.0.0/16"
+	cidr2  = "10.1.0.0/16"
+
+	errBoom = errors.New("boom")
+)
+
+type args struct {
+	client ec2.ManagedPrefixListClient
+	cr     *networkv1alpha1.ManagedPrefixList
+}
+
+type prefixListModifier func(*networkv1alpha1.ManagedPrefixList)
+
+func withExternalName(name string) prefixListModifier {
+	return func(r *networkv1alpha1.ManagedPrefixList) { meta.SetExternalName(r, name) }
+}
+
+func withConditions(c ...xpv1.Condition) prefixListModifier {
+	return func(r *networkv1alpha1.ManagedPrefixList) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withSpec(p networkv1alpha1.ManagedPrefixListParameters) prefixListModifier {
+	return func(r *networkv1alpha1.ManagedPrefixList) { r.Spec.ForProvider = p }
+}
+
+func withStatus(s networkv1alpha1.ManagedPrefixListObservation) prefixListModifier {
+	return func(r *networkv1alpha1.ManagedPrefixList) { r.Status.AtProvider = s }
+}
+
+func prefixList(m ...prefixListModifier) *networkv1alpha1.ManagedPrefixList {
+	cr := &networkv1alpha1.ManagedPrefixList{}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func params(maxEntries int32, cidrs ...string) networkv1alpha1.ManagedPrefixListParameters {
+	p := networkv1alpha1.ManagedPrefixListParameters{
+		PrefixListName: plName,
+		AddressFamily:  "IPv4",
+		MaxEntries:     maxEntries,
+	}
+	for _, c := range cidrs {
+		p.Entries = append(p.Entries, networkv1alpha1.PrefixListEntry{CIDR: c})
+	}
+	return p
+}
+
+func describe(state types.PrefixListState, maxEntries int32) func(context.Context, *awsec2.DescribeManagedPrefixListsInput, []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+	return func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+		return &awsec2.DescribeManagedPrefixListsOutput{
+			PrefixLists: []types.ManagedPrefixList{{
+				PrefixListId:   aws.String(plID),
+				PrefixListName: aws.String(plName),
+				MaxEntries:     aws.Int32(maxEntries),
+				State:          state,
+				Version:        aws.Int64(2),
+			}},
+		}, nil
+	}
+}
+
+func entries(cidrs ...string) func(context.Context, *awsec2.GetManagedPrefixListEntriesInput, []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+	return func(ctx context.Context, input *awsec2.GetManagedPrefixListEntriesInput, opts []func(*awsec2.Options)) (*awsec2.GetManagedPrefixListEntriesOutput, error) {
+		out := &awsec2.GetManagedPrefixListEntriesOutput{}
+		for _, c := range cidrs {
+			out.Entries = append(out.Entries, types.PrefixListEntry{Cidr: aws.String(c)})
+		}
+		return out, nil
+	}
+}
+
+var _ managed.ExternalClient = &external{}
+var _ managed.ExternalConnecter = &connector{}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.ManagedPrefixList
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoExternalName": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{},
+				cr:     prefixList(withSpec(params(5, cidr1))),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr1))),
+			},
+		},
+		"Available": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateCreateComplete, 5),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID),
+					withStatus(networkv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: plID,
+						State:        string(types.PrefixListStateCreateComplete),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"EntriesChanged": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateModifyComplete, 5),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(5, cidr2)), withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr2)), withExternalName(plID),
+					withStatus(networkv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: plID,
+						State:        string(types.PrefixListStateModifyComplete),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+				},
+			},
+		},
+		"ModifyInProgress": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateModifyInProgress, 5),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(5, cidr2)), withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr2)), withExternalName(plID),
+					withStatus(networkv1alpha1.ManagedPrefixListObservation{
+						PrefixListID: plID,
+						State:        string(types.PrefixListStateModifyInProgress),
+						Version:      2,
+					}),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"DeleteComplete": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(types.PrefixListStateDeleteComplete, 5),
+				},
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+		},
+		"DescribeFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeManagedPrefixListsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeManagedPrefixListsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				cr:  prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+				err: awsclient.Wrap(errBoom, errDescribe),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *networkv1alpha1.ManagedPrefixList
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return &awsec2.CreateManagedPrefixListOutput{
+							PrefixList: &types.ManagedPrefixList{PrefixListId: aws.String(plID)},
+						}, nil
+					},
+				},
+				cr: prefixList(withSpec(params(5, cidr1))),
+			},
+			want: want{
+				cr: prefixList(withSpec(params(5, cidr1)), withExternalName(plID)),
+			},
+		},
+		"CreateFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.CreateManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withSpec(params(5, cidr1))),
+			},
+			want: want{
+				cr:  prefixList(withSpec(params(5, cidr1))),
+				err: awsclient.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		modify *awsec2.ModifyManagedPrefixListInput
+		err    error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"GrowFirst": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateCreateComplete, 1),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(2, cidr1, cidr2)), withExternalName(plID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(plID),
+					MaxEntries:   aws.Int32(2),
+				},
+			},
+		},
+		"ModifyEntries": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateCreateComplete, 2),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(2, cidr2)), withExternalName(plID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId:   aws.String(plID),
+					CurrentVersion: aws.Int64(2),
+					AddEntries:     []types.AddPrefixListEntry{{Cidr: aws.String(cidr2)}},
+					RemoveEntries:  []types.RemovePrefixListEntry{{Cidr: aws.String(cidr1)}},
+				},
+			},
+		},
+		"ShrinkLast": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateModifyComplete, 5),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(1, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(plID),
+					MaxEntries:   aws.Int32(1),
+				},
+			},
+		},
+		"InProgress": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe: describe(types.PrefixListStateModifyInProgress, 1),
+				},
+				cr: prefixList(withSpec(params(2, cidr1, cidr2)), withExternalName(plID)),
+			},
+		},
+		"ModifyFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDescribe:   describe(types.PrefixListStateCreateComplete, 1),
+					MockGetEntries: entries(cidr1),
+				},
+				cr: prefixList(withSpec(params(2, cidr1)), withExternalName(plID)),
+			},
+			want: want{
+				modify: &awsec2.ModifyManagedPrefixListInput{
+					PrefixListId: aws.String(plID),
+					MaxEntries:   aws.Int32(2),
+				},
+				err: awsclient.Wrap(errBoom, errModify),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var modify *awsec2.ModifyManagedPrefixListInput
+			c := tc.client.(*fake.MockManagedPrefixListClient)
+			c.MockModify = func(ctx context.Context, input *awsec2.ModifyManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.ModifyManagedPrefixListOutput, error) {
+				modify = input
+				if tc.want.err != nil {
+					return nil, errBoom
+				}
+				return &awsec2.ModifyManagedPrefixListOutput{}, nil
+			}
+			e := &external{client: c}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.modify, modify, cmpopts.IgnoreUnexported(awsec2.ModifyManagedPrefixListInput{}, types.AddPrefixListEntry{}, types.RemovePrefixListEntry{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *networkv1alpha1.ManagedPrefixList
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return &awsec2.DeleteManagedPrefixListOutput{}, nil
+					},
+				},
+				cr: prefixList(withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(plID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"NotFound": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.PrefixListIDNotFound}
+					},
+				},
+				cr: prefixList(withExternalName(plID)),
+			},
+			want: want{
+				cr: prefixList(withExternalName(plID), withConditions(xpv1.Deleting())),
+			},
+		},
+		"DeleteFail": {
+			args: args{
+				client: &fake.MockManagedPrefixListClient{
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteManagedPrefixListInput, opts []func(*awsec2.Options)) (*awsec2.DeleteManagedPrefixListOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: prefixList(withExternalName(plID)),
+			},
+			want: want{
+				cr:  prefixList(withExternalName(plID), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -20,6 +19,7 @@ import (
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -45,11 +45,12 @@ func SetupRoute(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Route{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.RouteGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -63,6 +64,7 @@ func preCreate(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.CreateRoute
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
 	obj.InstanceId = cr.Spec.ForProvider.InstanceID
 	obj.GatewayId = cr.Spec.ForProvider.GatewayID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return nil
 }
 
@@ -95,10 +97,11 @@ func (e *external) observer(ctx context.Context, mg cpresource.Managed) (managed
 
 func preDelete(_ context.Context, cr *svcapitypes.Route, obj *svcsdk.DeleteRouteInput) (bool, error) {
 	obj.RouteTableId = cr.Spec.ForProvider.RouteTableID
+	obj.DestinationPrefixListId = cr.Spec.ForProvider.DestinationPrefixListID
 	return false, nil
 }
 
-// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 destination.
+// findRouteByDestination returns the route corresponding to the specified IPv4/IPv6 or prefix list destination.
 // Returns NotFoundError if no route is found.
 func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.Route) (*svcsdk.Route, error) {
 
@@ -117,6 +120,12 @@ func (e *external) findRouteByDestination(ctx context.Context, cr *svcapitypes.R
 
 	for _, route := range response.RouteTables[0].Routes {
 		if awsclients.StringValue(route.Origin) == svcsdk.RouteOriginCreateRoute {
+			if cr.Spec.ForProvider.DestinationPrefixListID != nil {
+				if awsclients.StringValue(route.DestinationPrefixListId) == awsclients.StringValue(cr.Spec.ForProvider.DestinationPrefixListID) {
+					return route, nil
+				}
+				continue
+			}
 			if awsclients.CIDRBlocksEqual(awsclients.StringValue(route.DestinationCidrBlock), awsclients.StringValue(cr.Spec.ForProvider.DestinationCIDRBlock)) {
 				return route, nil
 			}
@@ -41,9 +41,6 @@ func GenerateCreateRouteInput(cr *svcapitypes.Route) *svcsdk.CreateRouteInput {
 	if cr.Spec.ForProvider.DestinationIPv6CIDRBlock != nil {
 		res.SetDestinationIpv6CidrBlock(*cr.Spec.ForProvider.DestinationIPv6CIDRBlock)
 	}
-	if cr.Spec.ForProvider.DestinationPrefixListID != nil {
-		res.SetDestinationPrefixListId(*cr.Spec.ForProvider.DestinationPrefixListID)
-	}
 	if cr.Spec.ForProvider.EgressOnlyInternetGatewayID != nil {
 		res.SetEgressOnlyInternetGatewayId(*cr.Spec.ForProvider.EgressOnlyInternetGatewayID)
 	}
@@ -70,9 +67,6 @@ func GenerateDeleteRouteInput(cr *svcapitypes.Route) *svcsdk.DeleteRouteInput {
 	if cr.Spec.ForProvider.DestinationIPv6CIDRBlock != nil {
 		res.SetDestinationIpv6CidrBlock(*cr.Spec.ForProvider.DestinationIPv6CIDRBlock)
 	}
-	if cr.Spec.ForProvider.DestinationPrefixListID != nil {
-		res.SetDestinationPrefixListId(*cr.Spec.ForProvider.DestinationPrefixListID)
-	}
 
 	return res
 }
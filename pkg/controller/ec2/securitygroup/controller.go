@@ -32,7 +32,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -42,8 +41,8 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
-	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -76,16 +75,16 @@ func SetupSecurityGroup(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(filter.Filter).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.SecurityGroup{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SecurityGroupGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient})),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -147,9 +146,19 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 	current := cr.Spec.ForProvider.DeepCopy()
 	ec2.LateInitializeSG(&cr.Spec.ForProvider, &observed)
 
-	cr.Status.AtProvider = ec2.GenerateSGObservation(observed)
+	obs := ec2.GenerateSGObservation(observed)
+	obs.OwnedIngressRules = cr.Status.AtProvider.OwnedIngressRules
+	obs.OwnedEgressRules = cr.Status.AtProvider.OwnedEgressRules
+	if aws.ToString(cr.Spec.ForProvider.RuleManagementPolicy) != v1beta1.RuleManagementPolicyAdditive {
+		// NOTE: An authoritative security group owns all of its desired rules.
+		// Keeping track of them allows switching to the additive policy later
+		// on without orphaning the rules it authorized.
+		obs.OwnedIngressRules = ec2.RuleIDs(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Ingress))
+		obs.OwnedEgressRules = ec2.RuleIDs(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Egress))
+	}
+	cr.Status.AtProvider = obs
 
-	upToDate := ec2.IsSGUpToDate(cr.Spec.ForProvider, observed)
+	upToDate, drift := ec2.IsSGUpToDate(cr.Spec.ForProvider, cr.Status.AtProvider, observed)
 	// this is to make sure that the security group exists with the specified traffic rules.
 	if upToDate {
 		cr.SetConditions(xpv1.Available())
@@ -159,6 +168,7 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		ResourceExists:          true,
 		ResourceUpToDate:        upToDate,
 		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		Diff:                    controller.RecordDrift(cr, drift),
 	}, nil
 }
 
@@ -245,7 +255,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	if !awsclient.BoolValue(cr.Spec.ForProvider.IgnorIngress) {
-		add, remove := ec2.DiffPermissions(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Ingress), response.SecurityGroups[0].IpPermissions)
+		add, remove := ec2.DiffSGPermissions(cr.Spec.ForProvider, cr.Spec.ForProvider.Ingress, response.SecurityGroups[0].IpPermissions, cr.Status.AtProvider.OwnedIngressRules)
 		if len(remove) > 0 {
 			if _, err := e.sg.RevokeSecurityGroupIngress(ctx, &awsec2.RevokeSecurityGroupIngressInput{
 				GroupId:       aws.String(meta.GetExternalName(cr)),
@@ -262,10 +272,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 				return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeIngress)
 			}
 		}
+		cr.Status.AtProvider.OwnedIngressRules = ec2.OwnedRuleIDs(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Ingress), add, cr.Status.AtProvider.OwnedIngressRules)
 	}
 
 	if !awsclient.BoolValue(cr.Spec.ForProvider.IgnorEgress) {
-		add, remove := ec2.DiffPermissions(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Egress), response.SecurityGroups[0].IpPermissionsEgress)
+		add, remove := ec2.DiffSGPermissions(cr.Spec.ForProvider, cr.Spec.ForProvider.Egress, response.SecurityGroups[0].IpPermissionsEgress, cr.Status.AtProvider.OwnedEgressRules)
 		if len(remove) > 0 {
 			if _, err = e.sg.RevokeSecurityGroupEgress(ctx, &awsec2.RevokeSecurityGroupEgressInput{
 				GroupId:       aws.String(meta.GetExternalName(cr)),
@@ -283,6 +294,7 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 				return managed.ExternalUpdate{}, awsclient.Wrap(err, errAuthorizeEgress)
 			}
 		}
+		cr.Status.AtProvider.OwnedEgressRules = ec2.OwnedRuleIDs(ec2.GenerateEC2Permissions(cr.Spec.ForProvider.Egress), add, cr.Status.AtProvider.OwnedEgressRules)
 	}
 
 	return managed.ExternalUpdate{}, nil
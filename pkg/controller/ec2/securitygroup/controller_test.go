@@ -424,11 +424,96 @@ func TestUpdate(t *testing.T) {
 				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
 					Ingress: specPermissions(),
 					Egress:  specPermissions(),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID:   sgID,
+						OwnedIngressRules: []string{"tcp:80-80:192.168.0.0/32"},
+						OwnedEgressRules:  []string{"tcp:80-80:192.168.0.0/32"},
+					})),
+			},
+		},
+		"AdditiveKeepsForeignRules": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupsOutput, error) {
+						return &awsec2.DescribeSecurityGroupsOutput{
+							SecurityGroups: []awsec2types.SecurityGroup{{
+								IpPermissions: sgPermissions(port100, cidr),
+							}},
+						}, nil
+					},
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						if diff := cmp.Diff(sgPermissions(port80, cidr), input.IpPermissions, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.AuthorizeSecurityGroupIngressOutput{}, nil
+					},
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					IgnorEgress:          aws.Bool(true),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
 				}),
 					withStatus(v1beta1.SecurityGroupObservation{
 						SecurityGroupID: sgID,
 					})),
 			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					IgnorEgress:          aws.Bool(true),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID:   sgID,
+						OwnedIngressRules: []string{"tcp:80-80:192.168.0.0/32"},
+					})),
+			},
+		},
+		"AdditiveRevokesOwnedRules": {
+			args: args{
+				sg: &fake.MockSecurityGroupClient{
+					MockDescribe: func(ctx context.Context, input *awsec2.DescribeSecurityGroupsInput, opts []func(*awsec2.Options)) (*awsec2.DescribeSecurityGroupsOutput, error) {
+						return &awsec2.DescribeSecurityGroupsOutput{
+							SecurityGroups: []awsec2types.SecurityGroup{{
+								IpPermissions: append(sgPermissions(port80, cidr), sgPermissions(port100, cidr, "10.0.0.0/8")...),
+							}},
+						}, nil
+					},
+					MockAuthorizeIngress: func(ctx context.Context, input *awsec2.AuthorizeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.AuthorizeSecurityGroupIngressOutput, error) {
+						return nil, errBoom
+					},
+					MockRevokeIngress: func(ctx context.Context, input *awsec2.RevokeSecurityGroupIngressInput, opts []func(*awsec2.Options)) (*awsec2.RevokeSecurityGroupIngressOutput, error) {
+						if diff := cmp.Diff(sgPermissions(port100, cidr), input.IpPermissions, cmpopts.IgnoreTypes(document.NoSerde{})); diff != "" {
+							t.Errorf("r: -want, +got:\n%s", diff)
+						}
+						return &awsec2.RevokeSecurityGroupIngressOutput{}, nil
+					},
+				},
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					IgnorEgress:          aws.Bool(true),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID:   sgID,
+						OwnedIngressRules: []string{"tcp:100-100:192.168.0.0/32", "tcp:80-80:192.168.0.0/32"},
+					})),
+			},
+			want: want{
+				cr: sg(withSpec(v1beta1.SecurityGroupParameters{
+					Ingress:              specPermissions(),
+					IgnorEgress:          aws.Bool(true),
+					RuleManagementPolicy: aws.String(v1beta1.RuleManagementPolicyAdditive),
+				}),
+					withStatus(v1beta1.SecurityGroupObservation{
+						SecurityGroupID:   sgID,
+						OwnedIngressRules: []string{"tcp:80-80:192.168.0.0/32"},
+					})),
+			},
 		},
 		"IngressFail": {
 			args: args{
@@ -31,7 +31,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -42,6 +41,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/ec2"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -55,6 +55,8 @@ const (
 	errUpdate        = "failed to update the Subnet resource"
 	errCreateTags    = "failed to create tags for the Subnet resource"
 	errDeleteTags    = "failed to delete tags for the Subnet resource"
+	errAllocateCIDR  = "failed to allocate a CIDR from the IPAM pool"
+	errReleaseCIDR   = "failed to release the CIDR allocated from the IPAM pool"
 )
 
 // SetupSubnet adds a controller that reconciles Subnets.
@@ -69,15 +71,16 @@ func SetupSubnet(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.Subnet{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.SubnetGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ec2.NewSubnetClient})),
 			managed.WithCreationGracePeriod(3*time.Minute),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -159,10 +162,27 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (managed.Ex
 		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	cidr := cr.Spec.ForProvider.CIDRBlock
+	if cidr == "" && cr.Spec.ForProvider.IPv4IPAMPoolID != nil {
+		// NOTE: A subnet cannot be created from an IPAM pool directly, so we
+		// allocate its CIDR first. The client token makes the allocation
+		// idempotent in case creating the subnet fails and is retried.
+		result, err := e.client.AllocateIpamPoolCidr(ctx, &awsec2.AllocateIpamPoolCidrInput{
+			IpamPoolId:    cr.Spec.ForProvider.IPv4IPAMPoolID,
+			NetmaskLength: cr.Spec.ForProvider.IPv4NetmaskLength,
+			ClientToken:   aws.String(string(cr.UID)),
+			Description:   aws.String(cr.GetName()),
+		})
+		if err != nil {
+			return managed.ExternalCreation{}, awsclient.Wrap(err, errAllocateCIDR)
+		}
+		cidr = aws.ToString(result.IpamPoolAllocation.Cidr)
+	}
+
 	result, err := e.client.CreateSubnet(ctx, &awsec2.CreateSubnetInput{
 		AvailabilityZone:   cr.Spec.ForProvider.AvailabilityZone,
 		AvailabilityZoneId: cr.Spec.ForProvider.AvailabilityZoneID,
-		CidrBlock:          aws.String(cr.Spec.ForProvider.CIDRBlock),
+		CidrBlock:          aws.String(cidr),
 		Ipv6CidrBlock:      cr.Spec.ForProvider.IPv6CIDRBlock,
 		VpcId:              cr.Spec.ForProvider.VPCID,
 	})
@@ -250,8 +270,49 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	_, err := e.client.DeleteSubnet(ctx, &awsec2.DeleteSubnetInput{
 		SubnetId: aws.String(meta.GetExternalName(cr)),
 	})
+	if resource.Ignore(ec2.IsSubnetNotFoundErr, err) != nil {
+		return awsclient.Wrap(err, errDelete)
+	}
+	if cr.Spec.ForProvider.IPv4IPAMPoolID == nil || cr.Spec.ForProvider.CIDRBlock == "" {
+		return nil
+	}
 
-	return awsclient.Wrap(resource.Ignore(ec2.IsSubnetNotFoundErr, err), errDelete)
+	// DeleteSubnet deletes the subnet synchronously, so its allocation can
+	// be released right away. It must not wait until the subnet is no
+	// longer described: once Observe does not find the subnet anymore,
+	// Delete is not called again and the allocation would never be
+	// released.
+	err = e.releaseAllocation(ctx, aws.ToString(cr.Spec.ForProvider.IPv4IPAMPoolID), cr.Spec.ForProvider.CIDRBlock)
+	return awsclient.Wrap(resource.Ignore(ec2.IsIPAMPoolNotFoundErr, err), errReleaseCIDR)
+}
+
+// releaseAllocation releases the custom allocation of the given CIDR in the
+// given IPAM pool, if there is one.
+func (e *external) releaseAllocation(ctx context.Context, poolID, cidr string) error {
+	in := &awsec2.GetIpamPoolAllocationsInput{
+		IpamPoolId: aws.String(poolID),
+	}
+	for {
+		response, err := e.client.GetIpamPoolAllocations(ctx, in)
+		if err != nil {
+			return err
+		}
+		for _, a := range response.IpamPoolAllocations {
+			if a.ResourceType != awsec2types.IpamPoolAllocationResourceTypeCustom || aws.ToString(a.Cidr) != cidr {
+				continue
+			}
+			_, err := e.client.ReleaseIpamPoolAllocation(ctx, &awsec2.ReleaseIpamPoolAllocationInput{
+				IpamPoolId:           aws.String(poolID),
+				IpamPoolAllocationId: a.IpamPoolAllocationId,
+				Cidr:                 a.Cidr,
+			})
+			return err
+		}
+		if aws.ToString(response.NextToken) == "" {
+			return nil
+		}
+		in.NextToken = response.NextToken
+	}
 }
 
 type tagger struct {
@@ -23,6 +23,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
 	awsec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -39,7 +40,9 @@ import (
 )
 
 var (
-	subnetID = "some Id"
+	subnetID   = "some Id"
+	ipamPoolID = "ipam-pool-1234"
+	poolCIDR   = "10.0.1.0/24"
 
 	errBoom = errors.New("boom")
 )
@@ -230,6 +233,58 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulFromIPAMPool": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockAllocateIpamPoolCidr: func(ctx context.Context, input *awsec2.AllocateIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.AllocateIpamPoolCidrOutput, error) {
+						if aws.ToString(input.IpamPoolId) != ipamPoolID || aws.ToInt32(input.NetmaskLength) != 24 {
+							return nil, errBoom
+						}
+						return &awsec2.AllocateIpamPoolCidrOutput{
+							IpamPoolAllocation: &awsec2types.IpamPoolAllocation{Cidr: aws.String(poolCIDR)},
+						}, nil
+					},
+					MockCreate: func(ctx context.Context, input *awsec2.CreateSubnetInput, opts []func(*awsec2.Options)) (*awsec2.CreateSubnetOutput, error) {
+						if aws.ToString(input.CidrBlock) != poolCIDR {
+							return nil, errBoom
+						}
+						return &awsec2.CreateSubnetOutput{
+							Subnet: &awsec2types.Subnet{
+								SubnetId: aws.String(subnetID),
+							},
+						}, nil
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv4IPAMPoolID:    aws.String(ipamPoolID),
+					IPv4NetmaskLength: aws.Int32(24),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv4IPAMPoolID:    aws.String(ipamPoolID),
+					IPv4NetmaskLength: aws.Int32(24),
+				}), withExternalName(subnetID)),
+			},
+		},
+		"AllocateFailed": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockAllocateIpamPoolCidr: func(ctx context.Context, input *awsec2.AllocateIpamPoolCidrInput, opts []func(*awsec2.Options)) (*awsec2.AllocateIpamPoolCidrOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				})),
+				err: awsclient.Wrap(errBoom, errAllocateCIDR),
+			},
+		},
 		"CreateFailed": {
 			args: args{
 				kube: &test.MockClient{
@@ -384,6 +439,91 @@ func TestDelete(t *testing.T) {
 				}), withConditions(xpv1.Deleting())),
 			},
 		},
+		"ReleasesIPAMPoolAllocation": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockGetIpamPoolAllocations: func(ctx context.Context, input *awsec2.GetIpamPoolAllocationsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolAllocationsOutput, error) {
+						return &awsec2.GetIpamPoolAllocationsOutput{
+							IpamPoolAllocations: []awsec2types.IpamPoolAllocation{
+								{IpamPoolAllocationId: aws.String("vpc"), Cidr: aws.String("10.0.0.0/16"), ResourceType: awsec2types.IpamPoolAllocationResourceTypeVpc},
+								{IpamPoolAllocationId: aws.String("subnet"), Cidr: aws.String(poolCIDR), ResourceType: awsec2types.IpamPoolAllocationResourceTypeCustom},
+							},
+						}, nil
+					},
+					MockReleaseIpamPoolAllocation: func(ctx context.Context, input *awsec2.ReleaseIpamPoolAllocationInput, opts []func(*awsec2.Options)) (*awsec2.ReleaseIpamPoolAllocationOutput, error) {
+						if aws.ToString(input.IpamPoolAllocationId) != "subnet" {
+							return nil, errBoom
+						}
+						return &awsec2.ReleaseIpamPoolAllocationOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSubnetInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSubnetOutput, error) {
+						return &awsec2.DeleteSubnetOutput{}, nil
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"SubnetAlreadyDeleted": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockGetIpamPoolAllocations: func(ctx context.Context, input *awsec2.GetIpamPoolAllocationsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolAllocationsOutput, error) {
+						return &awsec2.GetIpamPoolAllocationsOutput{
+							IpamPoolAllocations: []awsec2types.IpamPoolAllocation{
+								{IpamPoolAllocationId: aws.String("subnet"), Cidr: aws.String(poolCIDR), ResourceType: awsec2types.IpamPoolAllocationResourceTypeCustom},
+							},
+						}, nil
+					},
+					MockReleaseIpamPoolAllocation: func(ctx context.Context, input *awsec2.ReleaseIpamPoolAllocationInput, opts []func(*awsec2.Options)) (*awsec2.ReleaseIpamPoolAllocationOutput, error) {
+						return &awsec2.ReleaseIpamPoolAllocationOutput{}, nil
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSubnetInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSubnetOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SubnetIDNotFound}
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				}), withConditions(xpv1.Deleting())),
+			},
+		},
+		"ReleaseFailed": {
+			args: args{
+				subnet: &fake.MockSubnetClient{
+					MockGetIpamPoolAllocations: func(ctx context.Context, input *awsec2.GetIpamPoolAllocationsInput, opts []func(*awsec2.Options)) (*awsec2.GetIpamPoolAllocationsOutput, error) {
+						return nil, errBoom
+					},
+					MockDelete: func(ctx context.Context, input *awsec2.DeleteSubnetInput, opts []func(*awsec2.Options)) (*awsec2.DeleteSubnetOutput, error) {
+						return nil, &smithy.GenericAPIError{Code: ec2.SubnetIDNotFound}
+					},
+				},
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				})),
+			},
+			want: want{
+				cr: subnet(withSpec(v1beta1.SubnetParameters{
+					CIDRBlock:      poolCIDR,
+					IPv4IPAMPoolID: aws.String(ipamPoolID),
+				}), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errReleaseCIDR),
+			},
+		},
 		"DeleteFailed": {
 			args: args{
 				subnet: &fake.MockSubnetClient{
@@ -275,6 +275,35 @@ func TestCreate(t *testing.T) {
 				result: managed.ExternalCreation{},
 			},
 		},
+		"SuccessfulFromIPAMPool": {
+			args: args{
+				vpc: &fake.MockVPCClient{
+					MockCreate: func(ctx context.Context, input *awsec2.CreateVpcInput, opts []func(*awsec2.Options)) (*awsec2.CreateVpcOutput, error) {
+						if input.CidrBlock != nil || aws.ToString(input.Ipv4IpamPoolId) != "ipam-pool-1234" || aws.ToInt32(input.Ipv4NetmaskLength) != 16 {
+							return nil, errBoom
+						}
+						return &awsec2.CreateVpcOutput{
+							Vpc: &awsec2types.Vpc{
+								VpcId:     aws.String(vpcID),
+								CidrBlock: aws.String("10.0.0.0/16"),
+							},
+						}, nil
+					},
+				},
+				cr: vpc(withSpec(v1beta1.VPCParameters{
+					IPv4IPAMPoolID:    aws.String("ipam-pool-1234"),
+					IPv4NetmaskLength: aws.Int32(16),
+				})),
+			},
+			want: want{
+				cr: vpc(withExternalName(vpcID),
+					withSpec(v1beta1.VPCParameters{
+						IPv4IPAMPoolID:    aws.String("ipam-pool-1234"),
+						IPv4NetmaskLength: aws.Int32(16),
+					})),
+				result: managed.ExternalCreation{},
+			},
+		},
 		"CreateFail": {
 			args: args{
 				vpc: &fake.MockVPCClient{
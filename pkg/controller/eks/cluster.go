@@ -28,7 +28,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -39,20 +38,22 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/eks"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
 	errNotEKSCluster    = "managed resource is not an EKS cluster custom resource"
 	errKubeUpdateFailed = "cannot update EKS cluster custom resource"
 
-	errCreateFailed        = "cannot create EKS cluster"
-	errUpdateConfigFailed  = "cannot update EKS cluster configuration"
-	errUpdateVersionFailed = "cannot update EKS cluster version"
-	errAddTagsFailed       = "cannot add tags to EKS cluster"
-	errDeleteFailed        = "cannot delete EKS cluster"
-	errDescribeFailed      = "cannot describe EKS cluster"
-	errPatchCreationFailed = "cannot create a patch object"
-	errUpToDateFailed      = "cannot check whether object is up-to-date"
+	errCreateFailed         = "cannot create EKS cluster"
+	errUpdateConfigFailed   = "cannot update EKS cluster configuration"
+	errUpdateVersionFailed  = "cannot update EKS cluster version"
+	errAddTagsFailed        = "cannot add tags to EKS cluster"
+	errDeleteFailed         = "cannot delete EKS cluster"
+	errDescribeFailed       = "cannot describe EKS cluster"
+	errPatchCreationFailed  = "cannot create a patch object"
+	errUpToDateFailed       = "cannot check whether object is up-to-date"
+	errGetConnectionDetails = "cannot get connection details of EKS cluster"
 )
 
 // SetupCluster adds a controller that reconciles Clusters.
@@ -67,13 +68,13 @@ func SetupCluster(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.Cluster{}).
-		Complete(managed.NewReconciler(mgr,
-			resource.ManagedKind(v1beta1.ClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient}),
+		Complete(o.NewReconcilerWithMaxPollInterval(mgr,
+			resource.ManagedKind(v1beta1.ClusterGroupVersionKind), eks.TokenRefreshInterval,
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: eks.NewEKSClient, newSTSClientFn: eks.NewSTSClient})),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
-			managed.WithPollInterval(o.PollInterval),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -95,7 +96,11 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 	if err != nil {
 		return nil, err
 	}
-	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*cfg), kube: c.kube}, nil
+	stsCfg, err := eks.GetKubeconfigTokenConfig(ctx, c.kube, cr, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), sts: c.newSTSClientFn(*stsCfg), kube: c.kube}, nil
 }
 
 type external struct {
@@ -139,10 +144,15 @@ func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.Wrap(err, errUpToDateFailed)
 	}
 
+	conn, err := eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts, cr.Spec.Kubeconfig)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetConnectionDetails)
+	}
+
 	return managed.ExternalObservation{
 		ResourceExists:    true,
 		ResourceUpToDate:  upToDate,
-		ConnectionDetails: eks.GetConnectionDetails(ctx, rsp.Cluster, e.sts),
+		ConnectionDetails: conn,
 	}, nil
 }
 
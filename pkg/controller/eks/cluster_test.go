@@ -20,8 +20,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	awsekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,6 +48,7 @@ var (
 
 type args struct {
 	eks  eks.Client
+	sts  eks.STSClient
 	kube client.Client
 	cr   *v1beta1.Cluster
 }
@@ -77,6 +81,13 @@ func withStatus(s v1beta1.ClusterStatusType) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Status.AtProvider.Status = s }
 }
 
+func withEndpoint(e, ca string) clusterModifier {
+	return func(r *v1beta1.Cluster) {
+		r.Status.AtProvider.Endpoint = e
+		r.Status.AtProvider.CertificateAuthorityData = ca
+	}
+}
+
 func withConfig(c v1beta1.VpcConfigRequest) clusterModifier {
 	return func(r *v1beta1.Cluster) { r.Spec.ForProvider.ResourcesVpcConfig = c }
 }
@@ -123,7 +134,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -147,7 +158,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -171,7 +182,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -202,6 +213,35 @@ func TestObserve(t *testing.T) {
 				cr: cluster(),
 			},
 		},
+		"FailedPresign": {
+			args: args{
+				eks: &fake.MockClient{
+					MockDescribeCluster: func(ctx context.Context, input *awseks.DescribeClusterInput, opts []func(*awseks.Options)) (*awseks.DescribeClusterOutput, error) {
+						return &awseks.DescribeClusterOutput{
+							Cluster: &awsekstypes.Cluster{
+								Name:                 aws.String("cool-cluster"),
+								Endpoint:             aws.String("https://example.eks.amazonaws.com"),
+								CertificateAuthority: &awsekstypes.Certificate{Data: aws.String("Y2E=")},
+								Status:               awsekstypes.ClusterStatusActive,
+							},
+						}, nil
+					},
+				},
+				sts: &fake.MockSTSClient{
+					MockPresignGetCallerIdentity: func(_ context.Context, _ *sts.GetCallerIdentityInput, _ []func(*sts.PresignOptions)) (*v4.PresignedHTTPRequest, error) {
+						return nil, errBoom
+					},
+				},
+				cr: cluster(),
+			},
+			want: want{
+				cr: cluster(
+					withConditions(xpv1.Available()),
+					withStatus(v1beta1.ClusterStatusActive),
+					withEndpoint("https://example.eks.amazonaws.com", "Y2E=")),
+				err: errors.Wrap(errors.Wrap(errBoom, "cannot presign token"), errGetConnectionDetails),
+			},
+		},
 		"LateInitSuccess": {
 			args: args{
 				kube: &test.MockClient{
@@ -229,7 +269,7 @@ func TestObserve(t *testing.T) {
 				result: managed.ExternalObservation{
 					ResourceExists:    true,
 					ResourceUpToDate:  true,
-					ConnectionDetails: eks.GetConnectionDetails(context.TODO(), &awsekstypes.Cluster{}, &fake.MockSTSClient{}),
+					ConnectionDetails: managed.ConnectionDetails{},
 				},
 			},
 		},
@@ -259,7 +299,7 @@ func TestObserve(t *testing.T) {
 
 	for name, tc := range cases {
 		t.Run(name, func(t *testing.T) {
-			e := &external{kube: tc.kube, client: tc.eks}
+			e := &external{kube: tc.kube, client: tc.eks, sts: tc.sts}
 			o, err := e.Observe(context.Background(), tc.args.cr)
 
 			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
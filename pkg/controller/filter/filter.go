@@ -1,54 +1,103 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package filter contains predicates that filter the events that trigger
+// reconciles of managed resources.
 package filter
 
 import (
-	"log"
-	"reflect"
-
-	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/google/go-cmp/cmp"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
 )
 
-func GetResourceStatus(a any) *xpv1.ResourceStatus {
-	status := reflect.ValueOf(a).Elem().FieldByName("Status").FieldByName("ResourceStatus").Interface()
-	if s, ok := status.(xpv1.ResourceStatus); ok {
-		return &s
+// AnnotationKeyReconcileRequestedAt is the annotation that requests an
+// immediate reconcile of a managed resource. Any change to its value, e.g.
+// setting it to the current time, triggers a reconcile even if update
+// events of the resource are filtered.
+const AnnotationKeyReconcileRequestedAt = "aws.crossplane.io/reconcile-requested-at"
+
+// An Option configures an update filter.
+type Option func(*updateFilter)
+
+// WithLogger configures the logger the filter logs filtered events with.
+func WithLogger(l logging.Logger) Option {
+	return func(f *updateFilter) {
+		f.log = l
 	}
-	log.Printf("Could not get resource status %T %t", status, status)
-	return nil
 }
 
-var Filter = predicate.Funcs{
-	// https://stuartleeks.com/posts/kubebuilder-event-filters-part-2-update/
-	UpdateFunc: func(e event.UpdateEvent) bool {
-		diff := cmp.Diff(e.ObjectOld, e.ObjectNew)
-		log.Println(diff)
-		if res := GetResourceStatus(e.ObjectNew); res != nil {
-			ready := res.GetCondition(xpv1.TypeReady).Status == v1.ConditionTrue
-			synced := res.GetCondition(xpv1.TypeSynced).Status == v1.ConditionTrue
-			oldGeneration := e.ObjectOld.GetGeneration()
-			newGeneration := e.ObjectNew.GetGeneration()
-			// Generation is only updated on spec changes (also on deletion),
-			// not metadata or status
-			// Filter out events where the generation hasn't changed to
-			// avoid being triggered by status updates
-
-			// TODO: This also excludes a change if you add a custom label/annotation manually to trigger reconcile
-
-			log.Println("filter event?", "gen equals", oldGeneration == newGeneration, "ready", ready, "synced", synced)
-			if oldGeneration == newGeneration && ready && synced {
-				return false
-			}
-		}
+// WithAnnotations configures annotations whose changes are never filtered,
+// in addition to AnnotationKeyReconcileRequestedAt.
+func WithAnnotations(keys ...string) Option {
+	return func(f *updateFilter) {
+		f.annotations = append(f.annotations, keys...)
+	}
+}
+
+type updateFilter struct {
+	log         logging.Logger
+	annotations []string
+}
+
+// NewUpdateFilter returns a predicate that filters update events of managed
+// resources that are ready and synced and whose spec did not change, i.e.
+// events that are caused by updates of their status or metadata. Events that
+// change the reconcile-requested-at annotation, or one of the supplied
+// allowed annotations, are never filtered. All other events pass.
+func NewUpdateFilter(o ...Option) predicate.Predicate {
+	f := &updateFilter{log: logging.NewNopLogger()}
+	for _, fn := range o {
+		fn(f)
+	}
+	return predicate.Funcs{UpdateFunc: f.update}
+}
 
+func (f *updateFilter) update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return true
+	}
+	// Generation is only updated on spec changes (also on deletion), not on
+	// metadata or status changes.
+	if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() || !e.ObjectNew.GetDeletionTimestamp().IsZero() {
+		return true
+	}
+	for _, k := range append([]string{AnnotationKeyReconcileRequestedAt}, f.annotations...) {
+		if annotationChanged(e.ObjectOld, e.ObjectNew, k) {
+			return true
+		}
+	}
+	c, ok := e.ObjectNew.(resource.Conditioned)
+	if !ok {
 		return true
-	},
-	DeleteFunc: func(e event.DeleteEvent) bool {
-		// The reconciler adds a finalizer so we perform clean-up
-		// when the delete timestamp is added
-		// Suppress Delete events to avoid filtering them out in the Reconcile function
+	}
+	if c.GetCondition(xpv1.TypeReady).Status != corev1.ConditionTrue || c.GetCondition(xpv1.TypeSynced).Status != corev1.ConditionTrue {
 		return true
-	},
+	}
+	f.log.Debug("Filtered update event", "name", e.ObjectNew.GetName(), "generation", e.ObjectNew.GetGeneration())
+	return false
+}
+
+func annotationChanged(o, n client.Object, key string) bool {
+	ov, ook := o.GetAnnotations()[key]
+	nv, nok := n.GetAnnotations()[key]
+	return ov != nv || ook != nok
 }
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filter
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+type managedModifier func(*fake.Managed)
+
+func withGeneration(g int64) managedModifier {
+	return func(mg *fake.Managed) { mg.SetGeneration(g) }
+}
+
+func withAnnotations(a map[string]string) managedModifier {
+	return func(mg *fake.Managed) { mg.SetAnnotations(a) }
+}
+
+func withConditions(c ...xpv1.Condition) managedModifier {
+	return func(mg *fake.Managed) { mg.SetConditions(c...) }
+}
+
+func withDeletionTimestamp() managedModifier {
+	return func(mg *fake.Managed) {
+		now := metav1.Now()
+		mg.SetDeletionTimestamp(&now)
+	}
+}
+
+func managed(m ...managedModifier) *fake.Managed {
+	mg := &fake.Managed{}
+	mg.SetGeneration(1)
+	mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+	for _, fn := range m {
+		fn(mg)
+	}
+	return mg
+}
+
+func TestUpdateFilter(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		opts   []Option
+		old    *fake.Managed
+		new    *fake.Managed
+		want   bool
+	}{
+		"StatusOrMetadataChanged": {
+			reason: "Updates of a ready and synced resource whose spec did not change should be filtered.",
+			old:    managed(),
+			new:    managed(withAnnotations(map[string]string{"example.org/other": "v"})),
+			want:   false,
+		},
+		"SpecChanged": {
+			reason: "Updates that change the spec should not be filtered.",
+			old:    managed(),
+			new:    managed(withGeneration(2)),
+			want:   true,
+		},
+		"Deleted": {
+			reason: "Updates of a resource that is being deleted should not be filtered.",
+			old:    managed(),
+			new:    managed(withDeletionTimestamp()),
+			want:   true,
+		},
+		"NotReady": {
+			reason: "Updates of a resource that is not ready should not be filtered.",
+			old:    managed(),
+			new:    managed(withConditions(xpv1.Creating())),
+			want:   true,
+		},
+		"NotSynced": {
+			reason: "Updates of a resource that is not synced should not be filtered.",
+			old:    managed(),
+			new:    managed(withConditions(xpv1.ReconcileError(errors.New("boom")))),
+			want:   true,
+		},
+		"ReconcileRequested": {
+			reason: "Updates that change the reconcile-requested-at annotation should not be filtered.",
+			old:    managed(withAnnotations(map[string]string{AnnotationKeyReconcileRequestedAt: "1"})),
+			new:    managed(withAnnotations(map[string]string{AnnotationKeyReconcileRequestedAt: "2"})),
+			want:   true,
+		},
+		"AllowedAnnotationAdded": {
+			reason: "Updates that add an allowed annotation should not be filtered.",
+			opts:   []Option{WithAnnotations("example.org/allowed")},
+			old:    managed(),
+			new:    managed(withAnnotations(map[string]string{"example.org/allowed": ""})),
+			want:   true,
+		},
+		"AllowedAnnotationUnchanged": {
+			reason: "Updates that do not change an allowed annotation should be filtered.",
+			opts:   []Option{WithAnnotations("example.org/allowed")},
+			old:    managed(withAnnotations(map[string]string{"example.org/allowed": "v"})),
+			new:    managed(withAnnotations(map[string]string{"example.org/allowed": "v", "example.org/other": "v"})),
+			want:   false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := NewUpdateFilter(tc.opts...).Update(event.UpdateEvent{ObjectOld: tc.old, ObjectNew: tc.new})
+			if got != tc.want {
+				t.Errorf("\n%s\nUpdate(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
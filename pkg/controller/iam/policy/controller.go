@@ -18,6 +18,7 @@ package policy
 
 import (
 	"context"
+	"sort"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
@@ -30,7 +31,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -41,6 +41,7 @@ import (
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/iam"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -57,6 +58,11 @@ const (
 	errKubeUpdateFailed = "cannot late initialize IAM Policy"
 	errTag              = "cannot tag policy"
 	errUntag            = "cannot untag policy"
+	errListVersions     = "cannot list policy versions"
+
+	// maxPolicyVersions is the maximum number of versions IAM keeps for a
+	// managed policy.
+	maxPolicyVersions = 5
 )
 
 // SetupPolicy adds a controller that reconciles IAM Policy.
@@ -71,13 +77,14 @@ func SetupPolicy(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&v1beta1.Policy{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(v1beta1.PolicyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: iam.NewPolicyClient, newSTSClientFn: iam.NewSTSClient})),
 			managed.WithInitializers(&tagger{kube: mgr.GetClient()}),
 			managed.WithConnectionPublishers(),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -154,6 +161,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (managed.E
 		return managed.ExternalObservation{}, awsclient.Wrap(err, errPolicyVersion)
 	}
 
+	versions, err := e.listPolicyVersions(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListVersions)
+	}
+	cr.Status.AtProvider.Versions = iam.GeneratePolicyVersions(versions)
+
 	update, err := iam.IsPolicyUpToDate(cr.Spec.ForProvider, *versionRsp.PolicyVersion)
 
 	if err != nil {
@@ -211,11 +224,11 @@ func (e *external) Update(ctx context.Context, mgd resource.Managed) (managed.Ex
 	}
 
 	// An update to AWS Policy is a new version of that policy.
-	// A maximum of 5 versions are allowed. Below, the oldest version is deleted
-	// for an update request when 5 versions already exist.
+	// A maximum of 5 versions are allowed. Below, the oldest non-default
+	// versions are deleted until there is room for the new version.
 	// The new version is set as default.
 
-	if err := e.deleteOldestVersion(ctx, meta.GetExternalName(cr)); err != nil {
+	if err := e.deleteOldestVersions(ctx, meta.GetExternalName(cr)); err != nil {
 		return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
 	}
 
@@ -298,35 +311,44 @@ func (e *external) listPolicyVersions(ctx context.Context, policyArn string) ([]
 	return resp.Versions, nil
 }
 
-func (e *external) deleteOldestVersion(ctx context.Context, arn string) error {
+func (e *external) deleteOldestVersions(ctx context.Context, arn string) error {
 	allVersions, err := e.listPolicyVersions(ctx, arn)
 	if err != nil {
 		return err
 	}
 
-	if len(allVersions) < 5 {
-		return nil
+	for _, version := range oldestVersions(allVersions, len(allVersions)-maxPolicyVersions+1) {
+		if _, err := e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
+			PolicyArn: aws.String(arn),
+			VersionId: version.VersionId,
+		}); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	var oldestVersion awsiamtypes.PolicyVersion
-
-	// loop through all version to find the oldest version.
-	for _, version := range allVersions {
-		if version.IsDefaultVersion {
-			continue
-		}
-		if oldestVersion.CreateDate == nil ||
-			version.CreateDate.Before(*oldestVersion.CreateDate) {
-			oldestVersion = version
+// oldestVersions returns the n oldest non-default versions.
+func oldestVersions(versions []awsiamtypes.PolicyVersion, n int) []awsiamtypes.PolicyVersion {
+	if n <= 0 {
+		return nil
+	}
+	candidates := make([]awsiamtypes.PolicyVersion, 0, len(versions))
+	for _, version := range versions {
+		if !version.IsDefaultVersion {
+			candidates = append(candidates, version)
 		}
 	}
-
-	_, err = e.client.DeletePolicyVersion(ctx, &awsiam.DeletePolicyVersionInput{
-		PolicyArn: aws.String(arn),
-		VersionId: oldestVersion.VersionId,
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].CreateDate == nil || candidates[j].CreateDate == nil {
+			return candidates[j].CreateDate != nil
+		}
+		return candidates[i].CreateDate.Before(*candidates[j].CreateDate)
 	})
-
-	return err
+	if n > len(candidates) {
+		n = len(candidates)
+	}
+	return candidates[:n]
 }
 
 func (e *external) deleteNonDefaultVersions(ctx context.Context, policyArn string) error {
@@ -18,8 +18,10 @@ package policy
 
 import (
 	"context"
+	"fmt"
 
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -32,6 +34,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 
 	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
@@ -63,6 +66,8 @@ var (
 	  }`
 	boolFalse = false
 
+	createDate = time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+
 	errBoom = errors.New("boom")
 
 	getCallerIdentityOutput = &sts.GetCallerIdentityOutput{
@@ -131,6 +136,10 @@ func withSpec(spec v1beta1.PolicyParameters) policyModifier {
 	}
 }
 
+func withObservation(o v1beta1.PolicyObservation) policyModifier {
+	return func(r *v1beta1.Policy) { r.Status.AtProvider = o }
+}
+
 func withPath(path string) policyModifier {
 	return func(r *v1beta1.Policy) {
 		r.Spec.ForProvider.Path = awsclient.String(path)
@@ -191,6 +200,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withSpec(v1beta1.PolicyParameters{
 					Document: document,
@@ -209,6 +221,56 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"SuccessfulWithVersions": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{
+								DefaultVersionId: aws.String("v2"),
+							},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &document,
+							},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{
+							Versions: []awsiamtypes.PolicyVersion{
+								{VersionId: aws.String("v2"), IsDefaultVersion: true, CreateDate: &createDate},
+								{VersionId: aws.String("v1")},
+							},
+						}, nil
+					},
+				},
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document: document,
+					Name:     name,
+				}), withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withSpec(v1beta1.PolicyParameters{
+					Document: document,
+					Name:     name,
+				}), withExternalName(policyArn),
+					withConditions(xpv1.Available()),
+					withObservation(v1beta1.PolicyObservation{
+						DefaultVersionID: "v2",
+						Versions: []v1beta1.PolicyVersion{
+							{VersionID: "v2", IsDefaultVersion: true, CreateDate: &metav1.Time{Time: createDate}},
+							{VersionID: "v1"},
+						},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -247,6 +309,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withExternalName(policyArn)),
 			},
@@ -278,6 +343,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withExternalName("")),
 			},
@@ -308,6 +376,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withExternalName(""), withPath("/org-unit/")),
 			},
@@ -338,6 +409,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withExternalName("")),
 			},
@@ -349,6 +423,33 @@ func TestObserve(t *testing.T) {
 				},
 			},
 		},
+		"ListVersionsError": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+					MockGetPolicyVersion: func(ctx context.Context, input *awsiam.GetPolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyVersionOutput, error) {
+						return &awsiam.GetPolicyVersionOutput{
+							PolicyVersion: &awsiamtypes.PolicyVersion{
+								Document: &document,
+							},
+						}, nil
+					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: policy(withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn),
+					withConditions(xpv1.Available())),
+				err: awsclient.Wrap(errBoom, errListVersions),
+			},
+		},
 		"DifferentTags": {
 			args: args{
 				iam: &fake.MockPolicyClient{
@@ -368,6 +469,9 @@ func TestObserve(t *testing.T) {
 							},
 						}, nil
 					},
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						return &awsiam.ListPolicyVersionsOutput{}, nil
+					},
 				},
 				cr: policy(withSpec(v1beta1.PolicyParameters{
 					Document: document,
@@ -561,6 +665,39 @@ func TestUpdate(t *testing.T) {
 				cr: policy(withExternalName(policyArn)),
 			},
 		},
+		"DeleteOldestVersions": {
+			args: args{
+				iam: &fake.MockPolicyClient{
+					MockListPolicyVersions: func(ctx context.Context, input *awsiam.ListPolicyVersionsInput, opts []func(*awsiam.Options)) (*awsiam.ListPolicyVersionsOutput, error) {
+						versions := make([]awsiamtypes.PolicyVersion, 5)
+						for i := range versions {
+							d := createDate.AddDate(0, 0, -i)
+							versions[i] = awsiamtypes.PolicyVersion{VersionId: aws.String(fmt.Sprintf("v%d", 5-i)), CreateDate: &d}
+						}
+						versions[0].IsDefaultVersion = true
+						return &awsiam.ListPolicyVersionsOutput{Versions: versions}, nil
+					},
+					MockDeletePolicyVersion: func(ctx context.Context, input *awsiam.DeletePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.DeletePolicyVersionOutput, error) {
+						if aws.StringValue(input.VersionId) != "v1" {
+							return nil, errors.Errorf("unexpected deletion of version %s", aws.StringValue(input.VersionId))
+						}
+						return &awsiam.DeletePolicyVersionOutput{}, nil
+					},
+					MockCreatePolicyVersion: func(ctx context.Context, input *awsiam.CreatePolicyVersionInput, opts []func(*awsiam.Options)) (*awsiam.CreatePolicyVersionOutput, error) {
+						return &awsiam.CreatePolicyVersionOutput{}, nil
+					},
+					MockGetPolicy: func(ctx context.Context, input *awsiam.GetPolicyInput, opts []func(*awsiam.Options)) (*awsiam.GetPolicyOutput, error) {
+						return &awsiam.GetPolicyOutput{
+							Policy: &awsiamtypes.Policy{},
+						}, nil
+					},
+				},
+				cr: policy(withExternalName(policyArn)),
+			},
+			want: want{
+				cr: policy(withExternalName(policyArn)),
+			},
+		},
 		"InValidInput": {
 			args: args{
 				cr: unexpectedItem,
@@ -14,14 +14,11 @@ limitations under the License.
 package scramsecretassociation
 
 import (
-	"bytes"
 	"context"
-	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/kafka"
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	cpresource "github.com/crossplane/crossplane-runtime/pkg/resource"
 	"github.com/pkg/errors"
@@ -35,6 +32,7 @@ import (
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 // SetupScramSecretAssociation adds a controller that reconciles ScramSecretAssociation.
@@ -56,11 +54,12 @@ func SetupScramSecretAssociation(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.ScramSecretAssociation{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			cpresource.ManagedKind(svcapitypes.ScramSecretAssociationGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -162,22 +161,13 @@ func (e *external) customObserve(ctx context.Context, mg cpresource.Managed) (ma
 		resourceExists = count > 0
 	}
 
-	diff := &bytes.Buffer{}
-	for _, v := range add {
-		fmt.Fprintf(diff, "+%v ", awsclient.StringValue(v))
-	}
-
-	for _, v := range remove {
-		fmt.Fprintf(diff, "-%v ", awsclient.StringValue(v))
-	}
-
-	upToDate := len(add) == 0 && len(remove) == 0
+	drift := awsclient.ElementsDrift("SecretARNList", add, remove)
 
 	cr.SetConditions(xpv1.Available())
 	return managed.ExternalObservation{
 		ResourceExists:   resourceExists,
-		ResourceUpToDate: upToDate,
-		Diff:             diff.String(),
+		ResourceUpToDate: len(drift) == 0,
+		Diff:             controller.RecordDrift(cr, drift),
 		//ResourceLateInitialized: !cmp.Equal(&cr.Spec.ForProvider, currentSpec),
 	}, nil
 }
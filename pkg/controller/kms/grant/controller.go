@@ -0,0 +1,296 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "managed resource is not a Grant resource"
+	errCreateSession    = "cannot create a new session"
+	errListGrants       = "cannot list grants"
+	errCreateGrant      = "cannot create grant"
+	errRetireGrant      = "cannot retire grant"
+	errRevokeGrant      = "cannot revoke grant"
+	errModifyGrant      = "grants cannot be modified, delete the Grant and create it again to change its parameters"
+
+	// errCodeAccessDenied is the code of the error KMS returns if the
+	// caller may not perform an operation.
+	errCodeAccessDenied = "AccessDeniedException"
+
+	// operationRetireGrant is the grant operation that allows the grantee
+	// to retire the grant.
+	operationRetireGrant = "RetireGrant"
+)
+
+// SetupGrant adds a controller that reconciles Grant.
+func SetupGrant(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(svcapitypes.GrantGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), v1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&svcapitypes.Grant{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(svcapitypes.GrantGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: newClient})),
+			managed.WithInitializers(),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+func newClient(sess *session.Session) svcsdkapi.KMSAPI {
+	return svcsdk.New(sess)
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(*session.Session) svcsdkapi.KMSAPI
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*svcapitypes.Grant)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	sess, err := awsclient.GetConfigV1(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateSession)
+	}
+	return &external{client: c.newClientFn(sess)}, nil
+}
+
+type external struct {
+	client svcsdkapi.KMSAPI
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*svcapitypes.Grant)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{}, nil
+	}
+
+	resp, err := e.client.ListGrantsWithContext(ctx, &svcsdk.ListGrantsInput{
+		KeyId:   cr.Spec.ForProvider.KeyID,
+		GrantId: aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(resource.Ignore(isNotFound, err), errListGrants)
+	}
+	if len(resp.Grants) == 0 {
+		return managed.ExternalObservation{}, nil
+	}
+
+	cr.Status.AtProvider = generateObservation(resp.Grants[0])
+	cr.SetConditions(xpv1.Available())
+
+	// Grants cannot be modified, all parameters are immutable. Changes of
+	// them are reported as drift, and fail to be applied by Update.
+	drift := grantDrift(&cr.Spec.ForProvider, resp.Grants[0])
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: len(drift) == 0,
+		Diff:             controller.RecordDrift(cr, drift),
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*svcapitypes.Grant)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Creating())
+
+	resp, err := e.client.CreateGrantWithContext(ctx, generateCreateGrantInput(&cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, awsclient.Wrap(err, errCreateGrant)
+	}
+	meta.SetExternalName(cr, aws.StringValue(resp.GrantId))
+
+	return managed.ExternalCreation{
+		ConnectionDetails: managed.ConnectionDetails{
+			svcapitypes.ResourceCredentialsSecretGrantTokenKey: []byte(aws.StringValue(resp.GrantToken)),
+		},
+	}, nil
+}
+
+func (e *external) Update(_ context.Context, _ resource.Managed) (managed.ExternalUpdate, error) {
+	// Update is only called if the parameters of the grant changed.
+	return managed.ExternalUpdate{}, errors.New(errModifyGrant)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*svcapitypes.Grant)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	// RetireGrant requires the key ARN, the spec may only contain the key ID.
+	keyID := cr.Spec.ForProvider.KeyID
+	if cr.Status.AtProvider.KeyARN != nil {
+		keyID = cr.Status.AtProvider.KeyARN
+	}
+
+	// Only the retiring principal of a grant may retire it, or its grantee
+	// if the grant permits the RetireGrant operation. Grants that the
+	// provider may not retire are revoked instead, which the
+	// administrators of the key may do.
+	if canRetire(&cr.Spec.ForProvider) {
+		_, err := e.client.RetireGrantWithContext(ctx, &svcsdk.RetireGrantInput{
+			KeyId:   keyID,
+			GrantId: aws.String(meta.GetExternalName(cr)),
+		})
+		if !isAccessDenied(err) {
+			return awsclient.Wrap(resource.Ignore(isNotFound, err), errRetireGrant)
+		}
+	}
+	_, err := e.client.RevokeGrantWithContext(ctx, &svcsdk.RevokeGrantInput{
+		KeyId:   keyID,
+		GrantId: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(isNotFound, err), errRevokeGrant)
+}
+
+// canRetire returns whether a grant with the supplied parameters may be
+// retired by anyone but the administrators of its key.
+func canRetire(p *svcapitypes.GrantParameters) bool {
+	if p.RetiringPrincipal != nil {
+		return true
+	}
+	for _, o := range p.Operations {
+		if o == operationRetireGrant {
+			return true
+		}
+	}
+	return false
+}
+
+func generateCreateGrantInput(p *svcapitypes.GrantParameters) *svcsdk.CreateGrantInput {
+	in := &svcsdk.CreateGrantInput{
+		KeyId:             p.KeyID,
+		GranteePrincipal:  p.GranteePrincipal,
+		RetiringPrincipal: p.RetiringPrincipal,
+		Operations:        aws.StringSlice(p.Operations),
+		Name:              p.Name,
+	}
+	if p.Constraints != nil {
+		in.Constraints = &svcsdk.GrantConstraints{}
+		if len(p.Constraints.EncryptionContextEquals) != 0 {
+			in.Constraints.EncryptionContextEquals = aws.StringMap(p.Constraints.EncryptionContextEquals)
+		}
+		if len(p.Constraints.EncryptionContextSubset) != 0 {
+			in.Constraints.EncryptionContextSubset = aws.StringMap(p.Constraints.EncryptionContextSubset)
+		}
+	}
+	return in
+}
+
+func generateObservation(g *svcsdk.GrantListEntry) svcapitypes.GrantObservation {
+	o := svcapitypes.GrantObservation{
+		GrantID:        g.GrantId,
+		KeyARN:         g.KeyId,
+		IssuingAccount: g.IssuingAccount,
+	}
+	if g.CreationDate != nil {
+		t := metav1.NewTime(*g.CreationDate)
+		o.CreationDate = &t
+	}
+	return o
+}
+
+// grantDrift returns the parameters of the supplied grant that differ from
+// the supplied desired parameters. The order of the operations does not
+// matter.
+func grantDrift(p *svcapitypes.GrantParameters, g *svcsdk.GrantListEntry) []v1alpha1.FieldDrift {
+	var drift []v1alpha1.FieldDrift
+	drift = append(drift, awsclient.DriftAt("GranteePrincipal", aws.StringValue(p.GranteePrincipal), aws.StringValue(g.GranteePrincipal))...)
+	drift = append(drift, awsclient.DriftAt("RetiringPrincipal", aws.StringValue(p.RetiringPrincipal), aws.StringValue(g.RetiringPrincipal))...)
+	drift = append(drift, awsclient.DriftAt("Name", aws.StringValue(p.Name), aws.StringValue(g.Name))...)
+
+	desired := append([]string{}, p.Operations...)
+	observed := aws.StringValueSlice(g.Operations)
+	sort.Strings(desired)
+	sort.Strings(observed)
+	drift = append(drift, awsclient.DriftAt("Operations", desired, observed, cmpopts.EquateEmpty())...)
+
+	var desiredConstraints, observedConstraints svcapitypes.KeyGrantConstraints
+	if p.Constraints != nil {
+		desiredConstraints = *p.Constraints
+	}
+	if g.Constraints != nil {
+		observedConstraints.EncryptionContextEquals = aws.StringValueMap(g.Constraints.EncryptionContextEquals)
+		observedConstraints.EncryptionContextSubset = aws.StringValueMap(g.Constraints.EncryptionContextSubset)
+	}
+	return append(drift, awsclient.DriftAt("Constraints", desiredConstraints, observedConstraints, cmpopts.EquateEmpty())...)
+}
+
+// isAccessDenied returns whether the given error indicates that the caller
+// may not perform the operation.
+func isAccessDenied(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == errCodeAccessDenied
+}
+
+// isNotFound returns whether the given error indicates that the key or the
+// grant does not exist.
+func isNotFound(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	return awsErr.Code() == svcsdk.ErrCodeNotFoundException || awsErr.Code() == svcsdk.ErrCodeInvalidGrantIdException
+}
@@ -0,0 +1,416 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grant
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+var (
+	keyID    = "1234abcd-12ab-34cd-56ef-1234567890ab"
+	keyARN   = "arn:aws:kms:us-east-1:123456789012:key/" + keyID
+	grantID  = "0c237476b39f8bc44e45212e08498fbe3151305030726c0590dd8d3e9f3d6a60"
+	token    = "AQpAM2RhZTk1MGMyNTk2ZmZmMzEyYWVhOWViN2I1MWM4Mzc0MW"
+	created  = time.Date(2022, 10, 1, 0, 0, 0, 0, time.UTC)
+	errBoom  = errors.New("boom")
+	notFound = awserr.New(svcsdk.ErrCodeNotFoundException, "not found", nil)
+)
+
+type mockKMSClient struct {
+	svcsdkapi.KMSAPI
+
+	MockListGrants  func(*svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error)
+	MockCreateGrant func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error)
+	MockRetireGrant func(*svcsdk.RetireGrantInput) (*svcsdk.RetireGrantOutput, error)
+	MockRevokeGrant func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error)
+}
+
+func (m *mockKMSClient) ListGrantsWithContext(_ context.Context, in *svcsdk.ListGrantsInput, _ ...request.Option) (*svcsdk.ListGrantsResponse, error) {
+	return m.MockListGrants(in)
+}
+
+func (m *mockKMSClient) CreateGrantWithContext(_ context.Context, in *svcsdk.CreateGrantInput, _ ...request.Option) (*svcsdk.CreateGrantOutput, error) {
+	return m.MockCreateGrant(in)
+}
+
+func (m *mockKMSClient) RetireGrantWithContext(_ context.Context, in *svcsdk.RetireGrantInput, _ ...request.Option) (*svcsdk.RetireGrantOutput, error) {
+	return m.MockRetireGrant(in)
+}
+
+func (m *mockKMSClient) RevokeGrantWithContext(_ context.Context, in *svcsdk.RevokeGrantInput, _ ...request.Option) (*svcsdk.RevokeGrantOutput, error) {
+	return m.MockRevokeGrant(in)
+}
+
+type grantModifier func(*svcapitypes.Grant)
+
+func withExternalName(n string) grantModifier {
+	return func(r *svcapitypes.Grant) { meta.SetExternalName(r, n) }
+}
+
+func withConditions(c ...xpv1.Condition) grantModifier {
+	return func(r *svcapitypes.Grant) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func withObservation(o svcapitypes.GrantObservation) grantModifier {
+	return func(r *svcapitypes.Grant) { r.Status.AtProvider = o }
+}
+
+func withRetiringPrincipal(p string) grantModifier {
+	return func(r *svcapitypes.Grant) { r.Spec.ForProvider.RetiringPrincipal = aws.String(p) }
+}
+
+func withDrift(d ...v1alpha1.FieldDrift) grantModifier {
+	return func(r *svcapitypes.Grant) { r.Status.Drift = d }
+}
+
+// listedGrant returns the grant as it is listed by KMS.
+func listedGrant() *svcsdk.GrantListEntry {
+	return &svcsdk.GrantListEntry{
+		GrantId:          aws.String(grantID),
+		KeyId:            aws.String(keyARN),
+		GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/app"),
+		Operations:       aws.StringSlice([]string{"Decrypt", "Encrypt"}),
+		Name:             aws.String("app"),
+		IssuingAccount:   aws.String("arn:aws:iam::123456789012:root"),
+		CreationDate:     &created,
+	}
+}
+
+func grant(m ...grantModifier) *svcapitypes.Grant {
+	cr := &svcapitypes.Grant{
+		Spec: svcapitypes.GrantSpec{
+			ForProvider: svcapitypes.GrantParameters{
+				Region:           "us-east-1",
+				KeyID:            aws.String(keyID),
+				GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/app"),
+				Operations:       []string{"Encrypt", "Decrypt"},
+				Name:             aws.String("app"),
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.Grant
+		result managed.ExternalObservation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client svcsdkapi.KMSAPI
+		cr     *svcapitypes.Grant
+		want   want
+	}{
+		"NoExternalName": {
+			client: &mockKMSClient{},
+			cr:     grant(),
+			want: want{
+				cr: grant(),
+			},
+		},
+		"Exists": {
+			client: &mockKMSClient{
+				MockListGrants: func(in *svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error) {
+					if aws.StringValue(in.GrantId) != grantID {
+						return nil, errBoom
+					}
+					return &svcsdk.ListGrantsResponse{Grants: []*svcsdk.GrantListEntry{listedGrant()}}, nil
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+			want: want{
+				cr: grant(withExternalName(grantID),
+					withConditions(xpv1.Available()),
+					withObservation(svcapitypes.GrantObservation{
+						GrantID:        aws.String(grantID),
+						KeyARN:         aws.String(keyARN),
+						IssuingAccount: aws.String("arn:aws:iam::123456789012:root"),
+						CreationDate:   &metav1.Time{Time: created},
+					})),
+				result: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"ParametersChanged": {
+			client: &mockKMSClient{
+				MockListGrants: func(in *svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error) {
+					g := listedGrant()
+					g.Operations = aws.StringSlice([]string{"Decrypt"})
+					return &svcsdk.ListGrantsResponse{Grants: []*svcsdk.GrantListEntry{g}}, nil
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+			want: want{
+				cr: grant(withExternalName(grantID),
+					withConditions(xpv1.Available()),
+					withObservation(svcapitypes.GrantObservation{
+						GrantID:        aws.String(grantID),
+						KeyARN:         aws.String(keyARN),
+						IssuingAccount: aws.String("arn:aws:iam::123456789012:root"),
+						CreationDate:   &metav1.Time{Time: created},
+					}),
+					withDrift(v1alpha1.FieldDrift{Path: "Operations[1]", Desired: `"Encrypt"`})),
+				result: managed.ExternalObservation{
+					ResourceExists: true,
+					Diff:           `Operations[1]: desired "Encrypt", observed none`,
+				},
+			},
+		},
+		"Retired": {
+			client: &mockKMSClient{
+				MockListGrants: func(*svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error) {
+					return &svcsdk.ListGrantsResponse{}, nil
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+			want: want{
+				cr: grant(withExternalName(grantID)),
+			},
+		},
+		"KeyNotFound": {
+			client: &mockKMSClient{
+				MockListGrants: func(*svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error) {
+					return nil, notFound
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+			want: want{
+				cr: grant(withExternalName(grantID)),
+			},
+		},
+		"ListError": {
+			client: &mockKMSClient{
+				MockListGrants: func(*svcsdk.ListGrantsInput) (*svcsdk.ListGrantsResponse, error) {
+					return nil, errBoom
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+			want: want{
+				cr:  grant(withExternalName(grantID)),
+				err: awsclient.Wrap(errBoom, errListGrants),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr     *svcapitypes.Grant
+		result managed.ExternalCreation
+		err    error
+	}
+
+	cases := map[string]struct {
+		client svcsdkapi.KMSAPI
+		cr     *svcapitypes.Grant
+		want   want
+	}{
+		"Successful": {
+			client: &mockKMSClient{
+				MockCreateGrant: func(in *svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					want := &svcsdk.CreateGrantInput{
+						KeyId:            aws.String(keyID),
+						GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/app"),
+						Operations:       aws.StringSlice([]string{"Encrypt", "Decrypt"}),
+						Name:             aws.String("app"),
+					}
+					if diff := cmp.Diff(want, in); diff != "" {
+						return nil, errors.New(diff)
+					}
+					return &svcsdk.CreateGrantOutput{GrantId: aws.String(grantID), GrantToken: aws.String(token)}, nil
+				},
+			},
+			cr: grant(),
+			want: want{
+				cr: grant(withExternalName(grantID), withConditions(xpv1.Creating())),
+				result: managed.ExternalCreation{
+					ConnectionDetails: managed.ConnectionDetails{
+						svcapitypes.ResourceCredentialsSecretGrantTokenKey: []byte(token),
+					},
+				},
+			},
+		},
+		"CreateError": {
+			client: &mockKMSClient{
+				MockCreateGrant: func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr: grant(),
+			want: want{
+				cr:  grant(withConditions(xpv1.Creating())),
+				err: awsclient.Wrap(errBoom, errCreateGrant),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			o, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	e := &external{client: &mockKMSClient{}}
+	_, err := e.Update(context.Background(), grant(withExternalName(grantID)))
+	if diff := cmp.Diff(errors.New(errModifyGrant), err, test.EquateErrors()); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	accessDenied := awserr.New(errCodeAccessDenied, "denied", nil)
+	revoke := func(in *svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error) {
+		if aws.StringValue(in.KeyId) != keyARN || aws.StringValue(in.GrantId) != grantID {
+			return nil, errBoom
+		}
+		return &svcsdk.RevokeGrantOutput{}, nil
+	}
+
+	cases := map[string]struct {
+		client svcsdkapi.KMSAPI
+		cr     *svcapitypes.Grant
+		err    error
+	}{
+		"Retire": {
+			client: &mockKMSClient{
+				MockRetireGrant: func(in *svcsdk.RetireGrantInput) (*svcsdk.RetireGrantOutput, error) {
+					if aws.StringValue(in.KeyId) != keyARN || aws.StringValue(in.GrantId) != grantID {
+						return nil, errBoom
+					}
+					return &svcsdk.RetireGrantOutput{}, nil
+				},
+			},
+			cr: grant(withExternalName(grantID), withRetiringPrincipal("arn:aws:iam::123456789012:role/admin"),
+				withObservation(svcapitypes.GrantObservation{KeyARN: aws.String(keyARN)})),
+		},
+		"RevokeWithoutRetiringPrincipal": {
+			client: &mockKMSClient{
+				MockRevokeGrant: revoke,
+			},
+			cr: grant(withExternalName(grantID), withObservation(svcapitypes.GrantObservation{KeyARN: aws.String(keyARN)})),
+		},
+		"RevokeIfRetireDenied": {
+			client: &mockKMSClient{
+				MockRetireGrant: func(*svcsdk.RetireGrantInput) (*svcsdk.RetireGrantOutput, error) {
+					return nil, accessDenied
+				},
+				MockRevokeGrant: revoke,
+			},
+			cr: grant(withExternalName(grantID), withRetiringPrincipal("arn:aws:iam::123456789012:role/admin"),
+				withObservation(svcapitypes.GrantObservation{KeyARN: aws.String(keyARN)})),
+		},
+		"AlreadyRetired": {
+			client: &mockKMSClient{
+				MockRetireGrant: func(*svcsdk.RetireGrantInput) (*svcsdk.RetireGrantOutput, error) {
+					return nil, awserr.New(svcsdk.ErrCodeInvalidGrantIdException, "invalid", nil)
+				},
+			},
+			cr: grant(withExternalName(grantID), withRetiringPrincipal("arn:aws:iam::123456789012:role/admin")),
+		},
+		"AlreadyRevoked": {
+			client: &mockKMSClient{
+				MockRevokeGrant: func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error) {
+					return nil, awserr.New(svcsdk.ErrCodeInvalidGrantIdException, "invalid", nil)
+				},
+			},
+			cr: grant(withExternalName(grantID)),
+		},
+		"RetireError": {
+			client: &mockKMSClient{
+				MockRetireGrant: func(*svcsdk.RetireGrantInput) (*svcsdk.RetireGrantOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:  grant(withExternalName(grantID), withRetiringPrincipal("arn:aws:iam::123456789012:role/admin")),
+			err: awsclient.Wrap(errBoom, errRetireGrant),
+		},
+		"RevokeError": {
+			client: &mockKMSClient{
+				MockRevokeGrant: func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error) {
+					return nil, accessDenied
+				},
+			},
+			cr:  grant(withExternalName(grantID)),
+			err: awsclient.Wrap(accessDenied, errRevokeGrant),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
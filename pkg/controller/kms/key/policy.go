@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/apis/s3/v1alpha3"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/s3"
+)
+
+const (
+	errSerializePolicy = "cannot serialize key policy"
+)
+
+// desiredPolicy returns the key policy document of the spec, serializing
+// keyPolicy if it is set.
+func desiredPolicy(p *svcapitypes.KeyParameters) (*string, error) {
+	if p.KeyPolicy == nil {
+		return p.Policy, nil
+	}
+	body, err := s3.Serialize(convertPolicy(p.KeyPolicy))
+	if err != nil {
+		return nil, errors.Wrap(err, errSerializePolicy)
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, errors.Wrap(err, errSerializePolicy)
+	}
+	return awsclients.String(string(b)), nil
+}
+
+// convertPolicy converts a key policy to a bucket policy, which has the same
+// structure, so that it can be serialized like one.
+func convertPolicy(p *svcapitypes.KeyPolicyBody) *v1alpha3.BucketPolicyBody {
+	b := &v1alpha3.BucketPolicyBody{
+		Version:    p.Version,
+		ID:         awsclients.StringValue(p.ID),
+		Statements: make([]v1alpha3.BucketPolicyStatement, len(p.Statements)),
+	}
+	for i, s := range p.Statements {
+		b.Statements[i] = v1alpha3.BucketPolicyStatement{
+			SID:          s.SID,
+			Effect:       s.Effect,
+			Principal:    convertPrincipal(s.Principal),
+			NotPrincipal: convertPrincipal(s.NotPrincipal),
+			Action:       s.Action,
+			NotAction:    s.NotAction,
+			Resource:     s.Resource,
+			NotResource:  s.NotResource,
+			Condition:    convertConditions(s.Condition),
+		}
+	}
+	return b
+}
+
+func convertPrincipal(p *svcapitypes.KeyPolicyPrincipal) *v1alpha3.BucketPrincipal {
+	if p == nil {
+		return nil
+	}
+	b := &v1alpha3.BucketPrincipal{
+		AllowAnon: awsclients.BoolValue(p.AllowAnon),
+		Service:   p.Service,
+	}
+	for _, a := range p.AWSPrincipals {
+		b.AWSPrincipals = append(b.AWSPrincipals, v1alpha3.AWSPrincipal{
+			UserARN:      a.UserARN,
+			AWSAccountID: accountPrincipal(a.AWSAccountID),
+			IAMRoleARN:   a.IAMRoleARN,
+		})
+	}
+	return b
+}
+
+// accountPrincipal converts a plain account ID to the root ARN of the account
+// since AWS does the same when it stores a key policy, which would otherwise
+// never be up to date.
+func accountPrincipal(id *string) *string {
+	if id == nil {
+		return nil
+	}
+	if _, err := strconv.ParseInt(*id, 10, 64); err == nil {
+		return awsclients.String(fmt.Sprintf("arn:aws:iam::%s:root", *id))
+	}
+	return id
+}
+
+func convertConditions(c []svcapitypes.Condition) []v1alpha3.Condition {
+	if c == nil {
+		return nil
+	}
+	b := make([]v1alpha3.Condition, len(c))
+	for i, v := range c {
+		b[i] = v1alpha3.Condition{
+			OperatorKey: v.OperatorKey,
+			Conditions:  make([]v1alpha3.ConditionPair, len(v.Conditions)),
+		}
+		for j, p := range v.Conditions {
+			b[i].Conditions[j] = v1alpha3.ConditionPair(p)
+		}
+	}
+	return b
+}
@@ -2,15 +2,18 @@ package key
 
 import (
 	"context"
+	"sort"
 
+	"github.com/aws/aws-sdk-go/aws"
 	svcsdk "github.com/aws/aws-sdk-go/service/kms"
 	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -19,8 +22,16 @@ import (
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
-	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errGetKeyRotation = "cannot get key rotation status"
+	errReplicateKey   = "cannot replicate Key"
+	errListGrants     = "cannot list grants"
+	errCreateGrant    = "cannot create grant"
+	errRevokeGrant    = "cannot revoke grant"
 )
 
 // SetupKey adds a controller that reconciles Key.
@@ -28,14 +39,15 @@ func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.KeyGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.preObserve = preObserve
+			o := &observer{client: e.client}
+			e.preObserve = o.preObserve
 			e.postObserve = postObserve
+			e.preCreate = preCreate
 			e.postCreate = postCreate
 			u := &updater{client: e.client}
 			e.update = u.update
 			d := &deleter{client: e.client}
 			e.delete = d.delete
-			o := &observer{client: e.client}
 			e.isUpToDate = o.isUpToDate
 			e.lateInitialize = o.lateInitialize
 		},
@@ -49,12 +61,12 @@ func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(filter.Filter).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Key{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.KeyGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
+			o.WithPollInterval(name),
 			managed.WithInitializers(),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
@@ -62,7 +74,8 @@ func SetupKey(mgr ctrl.Manager, o controller.Options) error {
 			managed.WithConnectionPublishers(cps...)))
 }
 
-func preObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKeyInput) error {
+func (o *observer) preObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKeyInput) error {
+	o.grantIDs = cr.Status.AtProvider.GrantIDs
 	obj.KeyId = awsclients.String(meta.GetExternalName(cr))
 	return nil
 }
@@ -90,6 +103,15 @@ func postObserve(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.DescribeKey
 	return obs, nil
 }
 
+func preCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyInput) error {
+	policy, err := desiredPolicy(&cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	obj.Policy = policy
+	return nil
+}
+
 func postCreate(_ context.Context, cr *svcapitypes.Key, obj *svcsdk.CreateKeyOutput, creation managed.ExternalCreation, err error) (managed.ExternalCreation, error) {
 	if err != nil {
 		return creation, err
@@ -118,30 +140,42 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	}
 
 	// Policy
-	if _, err := u.client.PutKeyPolicyWithContext(ctx, &svcsdk.PutKeyPolicyInput{
-		KeyId:      awsclients.String(meta.GetExternalName(cr)),
-		PolicyName: awsclients.String("default"),
-		Policy:     cr.Spec.ForProvider.Policy,
-	}); err != nil {
-		return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+	policy, err := desiredPolicy(&cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
 	}
-
-	if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) {
-		// EnableKeyRotation
-		if _, err := u.client.EnableKeyRotationWithContext(ctx, &svcsdk.EnableKeyRotationInput{
-			KeyId: awsclients.String(meta.GetExternalName(cr)),
+	if policy != nil {
+		if _, err := u.client.PutKeyPolicyWithContext(ctx, &svcsdk.PutKeyPolicyInput{
+			KeyId:      awsclients.String(meta.GetExternalName(cr)),
+			PolicyName: awsclients.String("default"),
+			Policy:     policy,
 		}); err != nil {
 			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
 		}
-	} else {
-		// DisableKeyRotation
-		if _, err := u.client.DisableKeyRotationWithContext(ctx, &svcsdk.DisableKeyRotationInput{
-			KeyId: awsclients.String(meta.GetExternalName(cr)),
+	}
+
+	// Rotation
+	if err := u.updateKeyRotation(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Replicas
+	for _, region := range missingReplicaRegions(cr.Spec.ForProvider.ReplicaRegions, cr.Status.AtProvider.MultiRegionConfiguration) {
+		if _, err := u.client.ReplicateKeyWithContext(ctx, &svcsdk.ReplicateKeyInput{
+			KeyId:         awsclients.String(meta.GetExternalName(cr)),
+			ReplicaRegion: awsclients.String(region),
+			Description:   cr.Spec.ForProvider.Description,
+			Policy:        policy,
 		}); err != nil {
-			return managed.ExternalUpdate{}, awsclients.Wrap(err, errUpdate)
+			return managed.ExternalUpdate{}, awsclients.Wrap(err, errReplicateKey)
 		}
 	}
 
+	// Grants
+	if err := u.updateGrants(ctx, cr); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
 	// Tags
 	if err := u.updateTags(ctx, cr); err != nil {
 		return managed.ExternalUpdate{}, err
@@ -155,6 +189,71 @@ func (u *updater) update(ctx context.Context, mg resource.Managed) (managed.Exte
 	return managed.ExternalUpdate{}, nil
 }
 
+func (u *updater) updateKeyRotation(ctx context.Context, cr *svcapitypes.Key) error {
+	if cr.Spec.ForProvider.EnableKeyRotation == nil {
+		return nil
+	}
+	resRotation, err := u.client.GetKeyRotationStatusWithContext(ctx, &svcsdk.GetKeyRotationStatusInput{
+		KeyId: awsclients.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return awsclients.Wrap(err, errGetKeyRotation)
+	}
+	if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) == awsclients.BoolValue(resRotation.KeyRotationEnabled) {
+		return nil
+	}
+
+	if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) {
+		// EnableKeyRotation
+		if _, err := u.client.EnableKeyRotationWithContext(ctx, &svcsdk.EnableKeyRotationInput{
+			KeyId: awsclients.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return awsclients.Wrap(err, errUpdate)
+		}
+	} else {
+		// DisableKeyRotation
+		if _, err := u.client.DisableKeyRotationWithContext(ctx, &svcsdk.DisableKeyRotationInput{
+			KeyId: awsclients.String(meta.GetExternalName(cr)),
+		}); err != nil {
+			return awsclients.Wrap(err, errUpdate)
+		}
+	}
+	return nil
+}
+
+func (u *updater) updateGrants(ctx context.Context, cr *svcapitypes.Key) error {
+	if cr.Spec.ForProvider.Grants == nil {
+		return nil
+	}
+	current, err := listGrants(ctx, u.client, meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
+
+	// NOTE: The status is updated after every call so that it is correct
+	// even if a later call fails. Grants that no longer exist are forgotten.
+	owned := ownedGrants(current, cr.Status.AtProvider.GrantIDs)
+	cr.Status.AtProvider.GrantIDs = grantIDs(owned)
+	add, revoke := diffGrants(cr.Spec.ForProvider.Grants, owned)
+	for _, id := range revoke {
+		if _, err := u.client.RevokeGrantWithContext(ctx, &svcsdk.RevokeGrantInput{
+			KeyId:   awsclients.String(meta.GetExternalName(cr)),
+			GrantId: id,
+		}); err != nil {
+			return awsclients.Wrap(err, errRevokeGrant)
+		}
+		cr.Status.AtProvider.GrantIDs = withoutGrantID(cr.Status.AtProvider.GrantIDs, awsclients.StringValue(id))
+	}
+	for _, g := range add {
+		resp, err := u.client.CreateGrantWithContext(ctx, generateCreateGrantInput(meta.GetExternalName(cr), g))
+		if err != nil {
+			return awsclients.Wrap(err, errCreateGrant)
+		}
+		cr.Status.AtProvider.GrantIDs = append(cr.Status.AtProvider.GrantIDs, resp.GrantId)
+	}
+	return nil
+}
+
 func (u *updater) updateTags(ctx context.Context, cr *svcapitypes.Key) error {
 	tagsOutput, err := u.client.ListResourceTagsWithContext(ctx, &svcsdk.ListResourceTagsInput{
 		KeyId: awsclients.String(meta.GetExternalName(cr)),
@@ -240,11 +339,15 @@ func (d *deleter) delete(ctx context.Context, mg resource.Managed) error {
 
 type observer struct {
 	client svcsdkapi.KMSAPI
+
+	// grantIDs are the IDs of the grants that were created for the Key, as
+	// they were recorded in the status before it was observed.
+	grantIDs []*string
 }
 
 func (o *observer) lateInitialize(in *svcapitypes.KeyParameters, obj *svcsdk.DescribeKeyOutput) error {
 	// Policy
-	if in.Policy == nil {
+	if in.Policy == nil && in.KeyPolicy == nil {
 		resPolicy, err := o.client.GetKeyPolicy(&svcsdk.GetKeyPolicyInput{
 			KeyId:      obj.KeyMetadata.KeyId,
 			PolicyName: awsclients.String("default"),
@@ -282,6 +385,9 @@ func (o *observer) lateInitialize(in *svcapitypes.KeyParameters, obj *svcsdk.Des
 }
 
 func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput) (bool, error) { // nolint:gocyclo
+	// The observation was replaced by the one generated from the response.
+	cr.Status.AtProvider.GrantIDs = o.grantIDs
+
 	// Description
 	if obj.KeyMetadata.Description != nil &&
 		cr.Spec.ForProvider.Description != nil &&
@@ -302,21 +408,43 @@ func (o *observer) isUpToDate(cr *svcapitypes.Key, obj *svcsdk.DescribeKeyOutput
 	if err != nil {
 		return false, awsclients.Wrap(err, "cannot get key policy")
 	}
-	if awsclients.StringValue(cr.Spec.ForProvider.Policy) != awsclients.StringValue(resPolicy.Policy) {
+	policy, err := desiredPolicy(&cr.Spec.ForProvider)
+	if err != nil {
+		return false, err
+	}
+	if policy != nil && !awsclients.IsPolicyUpToDate(policy, resPolicy.Policy) {
 		return false, nil
 	}
 
 	// EnableKeyRotation
-	resRotation, err := o.client.GetKeyRotationStatus(&svcsdk.GetKeyRotationStatusInput{
-		KeyId: awsclients.String(meta.GetExternalName(cr)),
-	})
-	if err != nil {
-		return false, awsclients.Wrap(err, "cannot get key rotation status")
+	if cr.Spec.ForProvider.EnableKeyRotation != nil {
+		resRotation, err := o.client.GetKeyRotationStatus(&svcsdk.GetKeyRotationStatusInput{
+			KeyId: awsclients.String(meta.GetExternalName(cr)),
+		})
+		if err != nil {
+			return false, awsclients.Wrap(err, errGetKeyRotation)
+		}
+		if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) != awsclients.BoolValue(resRotation.KeyRotationEnabled) {
+			return false, nil
+		}
 	}
-	if awsclients.BoolValue(cr.Spec.ForProvider.EnableKeyRotation) != awsclients.BoolValue(resRotation.KeyRotationEnabled) {
+
+	// Replicas
+	if len(missingReplicaRegions(cr.Spec.ForProvider.ReplicaRegions, cr.Status.AtProvider.MultiRegionConfiguration)) != 0 {
 		return false, nil
 	}
 
+	// Grants
+	if cr.Spec.ForProvider.Grants != nil {
+		current, err := listGrants(context.TODO(), o.client, meta.GetExternalName(cr))
+		if err != nil {
+			return false, err
+		}
+		if add, revoke := diffGrants(cr.Spec.ForProvider.Grants, ownedGrants(current, cr.Status.AtProvider.GrantIDs)); len(add) != 0 || len(revoke) != 0 {
+			return false, nil
+		}
+	}
+
 	// Tags
 	resTags, err := o.client.ListResourceTags(&svcsdk.ListResourceTagsInput{
 		KeyId: awsclients.String(meta.GetExternalName(cr)),
@@ -350,3 +478,139 @@ func diffTags(spec []*svcapitypes.Tag, current []*svcsdk.Tag) (addTags []*svcsdk
 	}
 	return
 }
+
+// missingReplicaRegions returns the regions of the spec that have no replica
+// key yet.
+func missingReplicaRegions(spec []string, cfg *svcapitypes.MultiRegionConfiguration) []string {
+	existing := map[string]struct{}{}
+	if cfg != nil {
+		for _, r := range cfg.ReplicaKeys {
+			existing[awsclients.StringValue(r.Region)] = struct{}{}
+		}
+	}
+	var missing []string
+	for _, r := range spec {
+		if _, ok := existing[r]; !ok {
+			missing = append(missing, r)
+		}
+	}
+	return missing
+}
+
+func listGrants(ctx context.Context, client svcsdkapi.KMSAPI, keyID string) ([]*svcsdk.GrantListEntry, error) {
+	var grants []*svcsdk.GrantListEntry
+	err := client.ListGrantsPagesWithContext(ctx, &svcsdk.ListGrantsInput{
+		KeyId: awsclients.String(keyID),
+	}, func(page *svcsdk.ListGrantsResponse, _ bool) bool {
+		grants = append(grants, page.Grants...)
+		return true
+	})
+	return grants, awsclients.Wrap(err, errListGrants)
+}
+
+// ownedGrants returns the grants of the supplied list that were created for
+// the Key, i.e. whose IDs are recorded in its status.
+func ownedGrants(current []*svcsdk.GrantListEntry, ids []*string) []*svcsdk.GrantListEntry {
+	owned := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		owned[awsclients.StringValue(id)] = struct{}{}
+	}
+	var res []*svcsdk.GrantListEntry
+	for _, c := range current {
+		if _, ok := owned[awsclients.StringValue(c.GrantId)]; ok {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+func grantIDs(grants []*svcsdk.GrantListEntry) []*string {
+	var ids []*string
+	for _, g := range grants {
+		ids = append(ids, g.GrantId)
+	}
+	return ids
+}
+
+func withoutGrantID(ids []*string, id string) []*string {
+	var res []*string
+	for _, i := range ids {
+		if awsclients.StringValue(i) != id {
+			res = append(res, i)
+		}
+	}
+	return res
+}
+
+// diffGrants returns the grants of the spec that have to be created and the
+// IDs of the owned grants that have to be revoked. A grant whose attributes
+// differ from the spec is revoked and created again.
+func diffGrants(spec []svcapitypes.KeyGrant, owned []*svcsdk.GrantListEntry) (add []svcapitypes.KeyGrant, revoke []*string) {
+	found := make(map[string]bool, len(spec))
+	for _, c := range owned {
+		name := awsclients.StringValue(c.Name)
+		upToDate := false
+		for i := range spec {
+			if spec[i].Name == name && !found[name] && isGrantUpToDate(spec[i], c) {
+				upToDate = true
+				found[name] = true
+				break
+			}
+		}
+		if !upToDate {
+			revoke = append(revoke, c.GrantId)
+		}
+	}
+	for _, g := range spec {
+		if !found[g.Name] {
+			add = append(add, g)
+		}
+	}
+	return add, revoke
+}
+
+func isGrantUpToDate(spec svcapitypes.KeyGrant, current *svcsdk.GrantListEntry) bool {
+	if spec.GranteePrincipal != awsclients.StringValue(current.GranteePrincipal) ||
+		awsclients.StringValue(spec.RetiringPrincipal) != awsclients.StringValue(current.RetiringPrincipal) {
+		return false
+	}
+	ops := make([]string, len(spec.Operations))
+	copy(ops, spec.Operations)
+	currentOps := aws.StringValueSlice(current.Operations)
+	sort.Strings(ops)
+	sort.Strings(currentOps)
+	if !cmp.Equal(ops, currentOps, cmpopts.EquateEmpty()) {
+		return false
+	}
+	var equals, subset map[string]string
+	if spec.Constraints != nil {
+		equals, subset = spec.Constraints.EncryptionContextEquals, spec.Constraints.EncryptionContextSubset
+	}
+	var currentEquals, currentSubset map[string]string
+	if current.Constraints != nil {
+		currentEquals = aws.StringValueMap(current.Constraints.EncryptionContextEquals)
+		currentSubset = aws.StringValueMap(current.Constraints.EncryptionContextSubset)
+	}
+	return cmp.Equal(equals, currentEquals, cmpopts.EquateEmpty()) &&
+		cmp.Equal(subset, currentSubset, cmpopts.EquateEmpty())
+}
+
+func generateCreateGrantInput(keyID string, g svcapitypes.KeyGrant) *svcsdk.CreateGrantInput {
+	in := &svcsdk.CreateGrantInput{
+		KeyId:             awsclients.String(keyID),
+		Name:              awsclients.String(g.Name),
+		GranteePrincipal:  awsclients.String(g.GranteePrincipal),
+		Operations:        awsclients.StringSliceToPtr(g.Operations),
+		RetiringPrincipal: g.RetiringPrincipal,
+	}
+	if g.Constraints != nil {
+		in.Constraints = &svcsdk.GrantConstraints{}
+		if len(g.Constraints.EncryptionContextEquals) != 0 {
+			in.Constraints.EncryptionContextEquals = aws.StringMap(g.Constraints.EncryptionContextEquals)
+		}
+		if len(g.Constraints.EncryptionContextSubset) != 0 {
+			in.Constraints.EncryptionContextSubset = aws.StringMap(g.Constraints.EncryptionContextSubset)
+		}
+	}
+	return in
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package key
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdk "github.com/aws/aws-sdk-go/service/kms"
+	svcsdkapi "github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/kms/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+func TestDesiredPolicy(t *testing.T) {
+	cases := map[string]struct {
+		params svcapitypes.KeyParameters
+		want   *string
+	}{
+		"RawPolicy": {
+			params: svcapitypes.KeyParameters{Policy: aws.String(`{"Version":"2012-10-17"}`)},
+			want:   aws.String(`{"Version":"2012-10-17"}`),
+		},
+		"Nil": {
+			params: svcapitypes.KeyParameters{},
+		},
+		"KeyPolicy": {
+			params: svcapitypes.KeyParameters{
+				CustomKeyParameters: svcapitypes.CustomKeyParameters{
+					KeyPolicy: &svcapitypes.KeyPolicyBody{
+						Version: "2012-10-17",
+						Statements: []svcapitypes.KeyPolicyStatement{{
+							SID:    aws.String("root"),
+							Effect: "Allow",
+							Principal: &svcapitypes.KeyPolicyPrincipal{
+								AWSPrincipals: []svcapitypes.AWSPrincipal{{AWSAccountID: aws.String("123456789012")}},
+							},
+							Action:   []string{"kms:*"},
+							Resource: []string{"*"},
+						}},
+					},
+				},
+			},
+			want: aws.String(`{"Statement":[{"Action":"kms:*","Effect":"Allow","Principal":{"AWS":"arn:aws:iam::123456789012:root"},"Resource":"*","Sid":"root"}],"Version":"2012-10-17"}`),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := desiredPolicy(&tc.params)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMissingReplicaRegions(t *testing.T) {
+	cases := map[string]struct {
+		spec []string
+		cfg  *svcapitypes.MultiRegionConfiguration
+		want []string
+	}{
+		"NoConfiguration": {
+			spec: []string{"eu-west-1"},
+			want: []string{"eu-west-1"},
+		},
+		"SomeMissing": {
+			spec: []string{"eu-west-1", "us-west-2"},
+			cfg: &svcapitypes.MultiRegionConfiguration{
+				ReplicaKeys: []*svcapitypes.MultiRegionKey{{Region: aws.String("eu-west-1")}},
+			},
+			want: []string{"us-west-2"},
+		},
+		"AllReplicated": {
+			spec: []string{"eu-west-1"},
+			cfg: &svcapitypes.MultiRegionConfiguration{
+				ReplicaKeys: []*svcapitypes.MultiRegionKey{{Region: aws.String("eu-west-1")}, {Region: aws.String("us-west-2")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := missingReplicaRegions(tc.spec, tc.cfg)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffGrants(t *testing.T) {
+	grant := svcapitypes.KeyGrant{
+		Name:             "app",
+		GranteePrincipal: "arn:aws:iam::123456789012:role/app",
+		Operations:       []string{"Encrypt", "Decrypt"},
+		Constraints: &svcapitypes.KeyGrantConstraints{
+			EncryptionContextSubset: map[string]string{"app": "test"},
+		},
+	}
+	upToDate := &svcsdk.GrantListEntry{
+		GrantId:          aws.String("1"),
+		Name:             aws.String("app"),
+		GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/app"),
+		Operations:       aws.StringSlice([]string{"Decrypt", "Encrypt"}),
+		Constraints: &svcsdk.GrantConstraints{
+			EncryptionContextSubset: aws.StringMap(map[string]string{"app": "test"}),
+		},
+	}
+
+	type want struct {
+		add    []svcapitypes.KeyGrant
+		revoke []*string
+	}
+	cases := map[string]struct {
+		spec    []svcapitypes.KeyGrant
+		current []*svcsdk.GrantListEntry
+		want    want
+	}{
+		"Create": {
+			spec: []svcapitypes.KeyGrant{grant},
+			want: want{add: []svcapitypes.KeyGrant{grant}},
+		},
+		"UpToDate": {
+			spec:    []svcapitypes.KeyGrant{grant},
+			current: []*svcsdk.GrantListEntry{upToDate},
+		},
+		"Changed": {
+			spec: []svcapitypes.KeyGrant{grant},
+			current: []*svcsdk.GrantListEntry{{
+				GrantId:          aws.String("1"),
+				Name:             aws.String("app"),
+				GranteePrincipal: aws.String("arn:aws:iam::123456789012:role/app"),
+				Operations:       aws.StringSlice([]string{"Decrypt"}),
+			}},
+			want: want{add: []svcapitypes.KeyGrant{grant}, revoke: []*string{aws.String("1")}},
+		},
+		"RevokeRemoved": {
+			spec: []svcapitypes.KeyGrant{grant},
+			current: []*svcsdk.GrantListEntry{
+				upToDate,
+				{GrantId: aws.String("2"), Name: aws.String("old")},
+			},
+			want: want{revoke: []*string{aws.String("2")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, revoke := diffGrants(tc.spec, tc.current)
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.revoke, revoke, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("revoke: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOwnedGrants(t *testing.T) {
+	cases := map[string]struct {
+		current []*svcsdk.GrantListEntry
+		ids     []*string
+		want    []*svcsdk.GrantListEntry
+	}{
+		"NoneOwned": {
+			current: []*svcsdk.GrantListEntry{{GrantId: aws.String("1"), Name: aws.String("app")}},
+		},
+		"OnlyOwned": {
+			current: []*svcsdk.GrantListEntry{
+				{GrantId: aws.String("1"), Name: aws.String("app")},
+				{GrantId: aws.String("2"), Name: aws.String("foreign")},
+			},
+			ids:  []*string{aws.String("1"), aws.String("3")},
+			want: []*svcsdk.GrantListEntry{{GrantId: aws.String("1"), Name: aws.String("app")}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ownedGrants(tc.current, tc.ids)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockKMSClient struct {
+	svcsdkapi.KMSAPI
+
+	MockListGrantsPages func(*svcsdk.ListGrantsInput, func(*svcsdk.ListGrantsResponse, bool) bool) error
+	MockRevokeGrant     func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error)
+	MockCreateGrant     func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error)
+}
+
+func (m *mockKMSClient) ListGrantsPagesWithContext(_ context.Context, in *svcsdk.ListGrantsInput, fn func(*svcsdk.ListGrantsResponse, bool) bool, _ ...request.Option) error {
+	return m.MockListGrantsPages(in, fn)
+}
+
+func (m *mockKMSClient) RevokeGrantWithContext(_ context.Context, in *svcsdk.RevokeGrantInput, _ ...request.Option) (*svcsdk.RevokeGrantOutput, error) {
+	return m.MockRevokeGrant(in)
+}
+
+func (m *mockKMSClient) CreateGrantWithContext(_ context.Context, in *svcsdk.CreateGrantInput, _ ...request.Option) (*svcsdk.CreateGrantOutput, error) {
+	return m.MockCreateGrant(in)
+}
+
+func TestUpdateGrants(t *testing.T) {
+	errBoom := errors.New("boom")
+	grant := svcapitypes.KeyGrant{
+		Name:             "app",
+		GranteePrincipal: "arn:aws:iam::123456789012:role/app",
+		Operations:       []string{"Decrypt"},
+	}
+	listGrants := func(grants ...*svcsdk.GrantListEntry) func(*svcsdk.ListGrantsInput, func(*svcsdk.ListGrantsResponse, bool) bool) error {
+		return func(_ *svcsdk.ListGrantsInput, fn func(*svcsdk.ListGrantsResponse, bool) bool) error {
+			fn(&svcsdk.ListGrantsResponse{Grants: grants}, true)
+			return nil
+		}
+	}
+	key := func(grantIDs ...*string) *svcapitypes.Key {
+		cr := &svcapitypes.Key{}
+		cr.Spec.ForProvider.Grants = []svcapitypes.KeyGrant{grant}
+		cr.Status.AtProvider.GrantIDs = grantIDs
+		return cr
+	}
+
+	type want struct {
+		grantIDs []*string
+		err      error
+	}
+	cases := map[string]struct {
+		reason string
+		client *mockKMSClient
+		cr     *svcapitypes.Key
+		want   want
+	}{
+		"CreateAndRecord": {
+			reason: "Created grants should be recorded in the status.",
+			client: &mockKMSClient{
+				MockListGrantsPages: listGrants(),
+				MockCreateGrant: func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					return &svcsdk.CreateGrantOutput{GrantId: aws.String("1")}, nil
+				},
+			},
+			cr:   key(),
+			want: want{grantIDs: []*string{aws.String("1")}},
+		},
+		"KeepForeignGrants": {
+			reason: "Grants that were not created for the Key should neither be revoked nor recorded.",
+			client: &mockKMSClient{
+				MockListGrantsPages: listGrants(&svcsdk.GrantListEntry{GrantId: aws.String("2"), Name: aws.String("foreign")}),
+				MockRevokeGrant: func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error) {
+					return nil, errBoom
+				},
+				MockCreateGrant: func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					return &svcsdk.CreateGrantOutput{GrantId: aws.String("1")}, nil
+				},
+			},
+			cr:   key(),
+			want: want{grantIDs: []*string{aws.String("1")}},
+		},
+		"RevokeChanged": {
+			reason: "An owned grant that differs from the spec should be revoked, forgotten and created again.",
+			client: &mockKMSClient{
+				MockListGrantsPages: listGrants(&svcsdk.GrantListEntry{GrantId: aws.String("1"), Name: aws.String("app")}),
+				MockRevokeGrant: func(*svcsdk.RevokeGrantInput) (*svcsdk.RevokeGrantOutput, error) {
+					return &svcsdk.RevokeGrantOutput{}, nil
+				},
+				MockCreateGrant: func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					return &svcsdk.CreateGrantOutput{GrantId: aws.String("2")}, nil
+				},
+			},
+			cr:   key(aws.String("1")),
+			want: want{grantIDs: []*string{aws.String("2")}},
+		},
+		"ForgetMissing": {
+			reason: "Grants that no longer exist should be removed from the status.",
+			client: &mockKMSClient{
+				MockListGrantsPages: listGrants(),
+				MockCreateGrant: func(*svcsdk.CreateGrantInput) (*svcsdk.CreateGrantOutput, error) {
+					return nil, errBoom
+				},
+			},
+			cr:   key(aws.String("1")),
+			want: want{err: awsclients.Wrap(errBoom, errCreateGrant)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			u := &updater{client: tc.client}
+			err := u.updateGrants(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("%s\nupdateGrants(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.grantIDs, tc.cr.Status.AtProvider.GrantIDs); diff != "" {
+				t.Errorf("%s\nupdateGrants(...): -want grantIDs, +got grantIDs:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -20,10 +20,10 @@ import (
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/rds"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
@@ -38,9 +38,11 @@ const (
 	errUpdateTags               = "cannot update tags"
 	errRestore                  = "cannot restore DBCluster in AWS"
 	errUnknownRestoreFromSource = "unknown restoreFrom source"
+	errRotatePasswordFailed     = "cannot rotate master user password"
 )
 
 type updater struct {
+	kube   client.Client
 	client svcsdkapi.RDSAPI
 }
 
@@ -49,12 +51,12 @@ func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.DBClusterGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.preObserve = preObserve
 			c := &custom{client: e.client, kube: e.kube}
+			e.preObserve = c.preObserve
 			e.postObserve = c.postObserve
-			e.isUpToDate = isUpToDate
-			e.preUpdate = preUpdate
-			u := &updater{client: e.client}
+			e.isUpToDate = c.isUpToDate
+			e.preUpdate = c.preUpdate
+			u := &updater{kube: e.kube, client: e.client}
 			e.postUpdate = u.postUpdate
 			e.preCreate = c.preCreate
 			e.preDelete = preDelete
@@ -70,20 +72,25 @@ func SetupDBCluster(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.DBCluster{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBClusterGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
 			managed.WithConnectionPublishers(cps...)))
 }
 
-func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.DescribeDBClustersInput) error {
+func (e *custom) preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.DescribeDBClustersInput) error {
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
-	return nil
+	e.passwordRotatedAt = cr.Status.AtProvider.PasswordRotatedAt
+	if meta.WasDeleted(cr) {
+		return nil
+	}
+	return rds.ValidatePasswordRotation(cr.Spec.ForProvider.PasswordRotation.GetInterval(), aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword), cr.Spec.ForProvider.MasterUserPasswordSecretRef)
 }
 
 // This probably requires custom Conditions to be defined for handling all statuses
@@ -91,6 +98,8 @@ func preObserve(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.Descri
 // Need to get help from community on how to deal with this. Ideally the status should reflect
 // the true status value as described by the provider.
 func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, resp *svcsdk.DescribeDBClustersOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	// The observation was replaced by the one generated from the response.
+	cr.Status.AtProvider.PasswordRotatedAt = e.passwordRotatedAt
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -108,6 +117,10 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, res
 		xpv1.ResourceCredentialsSecretUserKey:     []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
 		xpv1.ResourceCredentialsSecretPortKey:     []byte(strconv.FormatInt(aws.Int64Value(cr.Spec.ForProvider.Port), 10)),
 	}
+	if s := cr.Status.AtProvider.MasterUserSecret; s != nil {
+		rds.AddMasterUserSecret(ctx, rds.NewSecretsManagerClientFor(e.kube, cr, cr.Spec.ForProvider.Region), cr, aws.StringValue(s.SecretARN), obs.ConnectionDetails)
+		return obs, nil
+	}
 	pw, _, _ := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if pw != "" {
 		obs.ConnectionDetails[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
@@ -119,24 +132,36 @@ func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBCluster, res
 type custom struct {
 	kube   client.Client
 	client svcsdkapi.RDSAPI
+
+	// passwordRotatedAt is the time of the last password rotation, which
+	// the observation generated from the DescribeDBClusters response does
+	// not contain.
+	passwordRotatedAt *metav1.Time
 }
 
 func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.CreateDBClusterInput) error { // nolint:gocyclo
-	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil {
-		return errors.Wrap(err, "cannot get password from the given secret")
-	}
-	if pw == "" && aws.BoolValue(&cr.Spec.ForProvider.AutogeneratePassword) {
-		pw, err = password.Generate()
-		if err != nil {
-			return errors.Wrap(err, "unable to generate a password")
+	// The password of the master user is generated by RDS if it is managed
+	// in Secrets Manager.
+	if aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		obj.ManageMasterUserPassword = cr.Spec.ForProvider.ManageMasterUserPassword
+		obj.MasterUserSecretKmsKeyId = cr.Spec.ForProvider.MasterUserSecretKMSKeyID
+	} else {
+		pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, "cannot get password from the given secret")
 		}
-		if err := e.savePasswordSecret(ctx, cr, pw); err != nil {
-			return errors.Wrap(err, errSaveSecretFailed)
+		if pw == "" && aws.BoolValue(&cr.Spec.ForProvider.AutogeneratePassword) {
+			pw, err = password.Generate()
+			if err != nil {
+				return errors.Wrap(err, "unable to generate a password")
+			}
+			if err := e.savePasswordSecret(ctx, cr, pw); err != nil {
+				return errors.Wrap(err, errSaveSecretFailed)
+			}
 		}
+		obj.MasterUserPassword = aws.String(pw)
 	}
 
-	obj.MasterUserPassword = aws.String(pw)
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
 	obj.VpcSecurityGroupIds = make([]*string, len(cr.Spec.ForProvider.VPCSecurityGroupIDs))
 	for i, v := range cr.Spec.ForProvider.VPCSecurityGroupIDs {
@@ -148,10 +173,12 @@ func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBCluster, obj *
 		case "S3":
 			input := generateRestoreDBClusterFromS3Input(cr)
 			input.MasterUserPassword = obj.MasterUserPassword
+			input.ManageMasterUserPassword = obj.ManageMasterUserPassword
+			input.MasterUserSecretKmsKeyId = obj.MasterUserSecretKmsKeyId
 			input.DBClusterIdentifier = obj.DBClusterIdentifier
 			input.VpcSecurityGroupIds = obj.VpcSecurityGroupIds
 
-			if _, err = e.client.RestoreDBClusterFromS3WithContext(ctx, input); err != nil {
+			if _, err := e.client.RestoreDBClusterFromS3WithContext(ctx, input); err != nil {
 				return errors.Wrap(err, errRestore)
 			}
 		default:
@@ -287,7 +314,7 @@ func generateRestoreDBClusterFromS3Input(cr *svcapitypes.DBCluster) *svcsdk.Rest
 	return res
 }
 
-func isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput) (bool, error) { // nolint:gocyclo
+func (e *custom) isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput) (bool, error) { // nolint:gocyclo
 	status := aws.StringValue(out.DBClusters[0].Status)
 	if status == "modifying" || status == "upgrading" || status == "configuring-iam-database-auth" || status == "migrating" || status == "prepairing-data-migration" {
 		return true, nil
@@ -338,7 +365,11 @@ func isUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput)
 	if len(add) > 0 || len(remove) > 0 {
 		return false, nil
 	}
-	return true, nil
+
+	if rds.IsMasterUserPasswordManagementChanged(cr.Spec.ForProvider.ManageMasterUserPassword, out.DBClusters[0].MasterUserSecret != nil) {
+		return false, nil
+	}
+	return !rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), e.passwordRotatedAt), nil
 }
 
 func isPreferredMaintenanceWindowUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOutput) bool {
@@ -411,15 +442,61 @@ func isPortUpToDate(cr *svcapitypes.DBCluster, out *svcsdk.DescribeDBClustersOut
 	return true
 }
 
-func preUpdate(_ context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyDBClusterInput) error {
+func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyDBClusterInput) error {
 	obj.DBClusterIdentifier = aws.String(meta.GetExternalName(cr))
 	obj.ApplyImmediately = cr.Spec.ForProvider.ApplyImmediately
 
+	rotate := rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), cr.Status.AtProvider.PasswordRotatedAt)
+	if rds.IsMasterUserPasswordManagementChanged(cr.Spec.ForProvider.ManageMasterUserPassword, cr.Status.AtProvider.MasterUserSecret != nil) {
+		obj.ManageMasterUserPassword = cr.Spec.ForProvider.ManageMasterUserPassword
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		if obj.ManageMasterUserPassword != nil {
+			obj.MasterUserSecretKmsKeyId = cr.Spec.ForProvider.MasterUserSecretKMSKeyID
+		}
+		// RDS generates the new password of a master user secret that it
+		// manages, but only if the modification is applied immediately.
+		if rotate {
+			obj.RotateMasterUserPassword = aws.Bool(true)
+			obj.ApplyImmediately = aws.Bool(true)
+		}
+		return nil
+	}
+
+	// RDS requires a password if it stops managing the password of the
+	// master user in Secrets Manager. The new password is staged until
+	// postUpdate commits it.
+	if rotate || obj.ManageMasterUserPassword != nil {
+		pw, err := rds.StagePassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef)
+		if err != nil {
+			return errors.Wrap(err, errRotatePasswordFailed)
+		}
+		obj.MasterUserPassword = aws.String(pw)
+	}
+
 	return nil
 }
 
 func (u *updater) postUpdate(ctx context.Context, cr *svcapitypes.DBCluster, obj *svcsdk.ModifyDBClusterOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
 	if err == nil {
+		if !aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+			if err := rds.CommitPassword(ctx, u.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef); err != nil {
+				return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePasswordFailed)
+			}
+		}
+		if rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), cr.Status.AtProvider.PasswordRotatedAt) {
+			now := metav1.Now()
+			cr.Status.AtProvider.PasswordRotatedAt = &now
+			if !aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+				pw, _, err := rds.GetPassword(ctx, u.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+				if err != nil {
+					return managed.ExternalUpdate{}, err
+				}
+				upd.ConnectionDetails = managed.ConnectionDetails{
+					xpv1.ResourceCredentialsSecretPasswordKey: []byte(pw),
+				}
+			}
+		}
 
 		input := GenerateDescribeDBClustersInput(cr)
 		resp, err := u.client.DescribeDBClustersWithContext(ctx, input)
@@ -330,6 +330,21 @@ func GenerateDBCluster(resp *svcsdk.DescribeDBClustersOutput) *svcapitypes.DBClu
 		} else {
 			cr.Status.AtProvider.LatestRestorableTime = nil
 		}
+		if elem.MasterUserSecret != nil {
+			f46 := &svcapitypes.MasterUserSecret{}
+			if elem.MasterUserSecret.KmsKeyId != nil {
+				f46.KMSKeyID = elem.MasterUserSecret.KmsKeyId
+			}
+			if elem.MasterUserSecret.SecretArn != nil {
+				f46.SecretARN = elem.MasterUserSecret.SecretArn
+			}
+			if elem.MasterUserSecret.SecretStatus != nil {
+				f46.SecretStatus = elem.MasterUserSecret.SecretStatus
+			}
+			cr.Status.AtProvider.MasterUserSecret = f46
+		} else {
+			cr.Status.AtProvider.MasterUserSecret = nil
+		}
 		if elem.MasterUsername != nil {
 			cr.Spec.ForProvider.MasterUsername = elem.MasterUsername
 		} else {
@@ -19,7 +19,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/password"
@@ -31,11 +30,13 @@ import (
 	aws "github.com/crossplane-contrib/provider-aws/pkg/clients"
 	"github.com/crossplane-contrib/provider-aws/pkg/clients/rds"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 // error constants
 const (
-	errSaveSecretFailed = "failed to save generated password to Kubernetes secret"
+	errSaveSecretFailed     = "failed to save generated password to Kubernetes secret"
+	errRotatePasswordFailed = "cannot rotate master user password"
 )
 
 // time formats
@@ -52,7 +53,7 @@ func SetupDBInstance(mgr ctrl.Manager, o controller.Options) error {
 			c := &custom{client: e.client, kube: e.kube, external: e}
 			e.lateInitialize = lateInitialize
 			e.isUpToDate = c.isUpToDate
-			e.preObserve = preObserve
+			e.preObserve = c.preObserve
 			e.postObserve = c.postObserve
 			e.preCreate = c.preCreate
 			e.preDelete = c.preDelete
@@ -70,11 +71,12 @@ func SetupDBInstance(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.DBInstance{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.DBInstanceGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
-			managed.WithPollInterval(o.PollInterval),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
@@ -85,28 +87,44 @@ type custom struct {
 	kube     client.Client
 	client   svcsdkapi.RDSAPI
 	external *external
+
+	// passwordRotatedAt is the time of the last password rotation, which
+	// the observation generated from the DescribeDBInstances response does
+	// not contain.
+	passwordRotatedAt *metav1.Time
 }
 
-func preObserve(_ context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.DescribeDBInstancesInput) error {
+func (e *custom) preObserve(_ context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.DescribeDBInstancesInput) error {
 	obj.DBInstanceIdentifier = aws.String(meta.GetExternalName(cr))
-	return nil
+	e.passwordRotatedAt = cr.Status.AtProvider.PasswordRotatedAt
+	if meta.WasDeleted(cr) {
+		return nil
+	}
+	return rds.ValidatePasswordRotation(cr.Spec.ForProvider.PasswordRotation.GetInterval(), aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword), cr.Spec.ForProvider.MasterUserPasswordSecretRef)
 }
 
 func (e *custom) preCreate(ctx context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.CreateDBInstanceInput) error {
-	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if resource.IgnoreNotFound(err) != nil {
-		return errors.Wrap(err, "cannot get password from the given secret")
-	}
-	if pw == "" && cr.Spec.ForProvider.AutogeneratePassword {
-		pw, err = password.Generate()
-		if err != nil {
-			return errors.Wrap(err, "unable to generate a password")
+	// The password of the master user is generated by RDS if it is managed
+	// in Secrets Manager.
+	if aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		obj.ManageMasterUserPassword = cr.Spec.ForProvider.ManageMasterUserPassword
+		obj.MasterUserSecretKmsKeyId = cr.Spec.ForProvider.MasterUserSecretKMSKeyID
+	} else {
+		pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if resource.IgnoreNotFound(err) != nil {
+			return errors.Wrap(err, "cannot get password from the given secret")
 		}
-		if err := e.savePasswordSecret(ctx, cr, pw); err != nil {
-			return errors.Wrap(err, errSaveSecretFailed)
+		if pw == "" && cr.Spec.ForProvider.AutogeneratePassword {
+			pw, err = password.Generate()
+			if err != nil {
+				return errors.Wrap(err, "unable to generate a password")
+			}
+			if err := e.savePasswordSecret(ctx, cr, pw); err != nil {
+				return errors.Wrap(err, errSaveSecretFailed)
+			}
 		}
+		obj.MasterUserPassword = aws.String(pw)
 	}
-	obj.MasterUserPassword = aws.String(pw)
 	obj.DBInstanceIdentifier = aws.String(meta.GetExternalName(cr))
 	if len(cr.Spec.ForProvider.VPCSecurityGroupIDs) > 0 {
 		obj.VpcSecurityGroupIds = make([]*string, len(cr.Spec.ForProvider.VPCSecurityGroupIDs))
@@ -127,12 +145,16 @@ func (e *custom) assembleConnectionDetails(ctx context.Context, cr *svcapitypes.
 	conn := managed.ConnectionDetails{
 		xpv1.ResourceCredentialsSecretUserKey: []byte(aws.StringValue(cr.Spec.ForProvider.MasterUsername)),
 	}
-	pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if err != nil {
-		return managed.ConnectionDetails{}, errors.Wrap(err, "cannot get password from the given secret")
-	}
-	if pw != "" {
-		conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+	if s := cr.Status.AtProvider.MasterUserSecret; s != nil {
+		rds.AddMasterUserSecret(ctx, rds.NewSecretsManagerClientFor(e.kube, cr, cr.Spec.ForProvider.Region), cr, aws.StringValue(s.SecretARN), conn)
+	} else {
+		pw, _, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return managed.ConnectionDetails{}, errors.Wrap(err, "cannot get password from the given secret")
+		}
+		if pw != "" {
+			conn[xpv1.ResourceCredentialsSecretPasswordKey] = []byte(pw)
+		}
 	}
 	if cr.Status.AtProvider.Endpoint != nil {
 		if aws.StringValue(cr.Status.AtProvider.Endpoint.Address) != "" {
@@ -148,10 +170,37 @@ func (e *custom) assembleConnectionDetails(ctx context.Context, cr *svcapitypes.
 func (e *custom) preUpdate(ctx context.Context, cr *svcapitypes.DBInstance, obj *svcsdk.ModifyDBInstanceInput) error {
 	obj.DBInstanceIdentifier = aws.String(meta.GetExternalName(cr))
 	obj.ApplyImmediately = cr.Spec.ForProvider.ApplyImmediately
+	rotate := rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), cr.Status.AtProvider.PasswordRotatedAt)
+	if rds.IsMasterUserPasswordManagementChanged(cr.Spec.ForProvider.ManageMasterUserPassword, cr.Status.AtProvider.MasterUserSecret != nil) {
+		obj.ManageMasterUserPassword = cr.Spec.ForProvider.ManageMasterUserPassword
+	}
+	if aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		if obj.ManageMasterUserPassword != nil {
+			obj.MasterUserSecretKmsKeyId = cr.Spec.ForProvider.MasterUserSecretKMSKeyID
+		}
+		// RDS generates the new password of a master user secret that it
+		// manages, but only if the modification is applied immediately.
+		if rotate {
+			obj.RotateMasterUserPassword = aws.Bool(true)
+			obj.ApplyImmediately = aws.Bool(true)
+		}
+		return nil
+	}
 	pw, pwchanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
 	if err != nil {
 		return err
 	}
+	// The new password is staged until postUpdate commits it. RDS requires
+	// a password if it stops managing the password of the master user in
+	// Secrets Manager.
+	if rotate || (obj.ManageMasterUserPassword != nil && pw == "") {
+		if pw, err = rds.StagePassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef); err != nil {
+			return errors.Wrap(err, errRotatePasswordFailed)
+		}
+	}
+	if rotate || obj.ManageMasterUserPassword != nil {
+		pwchanged = true
+	}
 	if pwchanged {
 		obj.MasterUserPassword = aws.String(pw)
 	}
@@ -163,6 +212,15 @@ func (e *custom) postUpdate(ctx context.Context, cr *svcapitypes.DBInstance, out
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
+	if !aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		if err := rds.CommitPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef); err != nil {
+			return managed.ExternalUpdate{}, errors.Wrap(err, errRotatePasswordFailed)
+		}
+	}
+	if rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), cr.Status.AtProvider.PasswordRotatedAt) {
+		now := metav1.Now()
+		cr.Status.AtProvider.PasswordRotatedAt = &now
+	}
 	conn, err := e.assembleConnectionDetails(ctx, cr)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
@@ -182,6 +240,8 @@ func (e *custom) preDelete(ctx context.Context, cr *svcapitypes.DBInstance, obj
 }
 
 func (e *custom) postObserve(ctx context.Context, cr *svcapitypes.DBInstance, resp *svcsdk.DescribeDBInstancesOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+	// The observation was replaced by the one generated from the response.
+	cr.Status.AtProvider.PasswordRotatedAt = e.passwordRotatedAt
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -310,9 +370,16 @@ func (e *custom) isUpToDate(cr *svcapitypes.DBInstance, out *svcsdk.DescribeDBIn
 		return true, nil
 	}
 
-	_, pwChanged, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
-	if err != nil {
-		return false, err
+	if rds.IsMasterUserPasswordManagementChanged(cr.Spec.ForProvider.ManageMasterUserPassword, db.MasterUserSecret != nil) {
+		return false, nil
+	}
+	pwChanged := false
+	if !aws.BoolValue(cr.Spec.ForProvider.ManageMasterUserPassword) {
+		_, changed, err := rds.GetPassword(ctx, e.kube, cr.Spec.ForProvider.MasterUserPasswordSecretRef, cr.Spec.WriteConnectionSecretToReference)
+		if err != nil {
+			return false, err
+		}
+		pwChanged = changed
 	}
 
 	// (PocketMobsters): AWS reformats our preferred time windows for backups and maintenance
@@ -337,7 +404,11 @@ func (e *custom) isUpToDate(cr *svcapitypes.DBInstance, out *svcsdk.DescribeDBIn
 		cmpopts.IgnoreFields(svcapitypes.DBInstanceParameters{}, "PreferredMaintenanceWindow"),
 		cmpopts.IgnoreFields(svcapitypes.DBInstanceParameters{}, "PreferredBackupWindow"),
 		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "ApplyImmediately"),
-	) && !maintenanceWindowChanged && !backupWindowChanged && !pwChanged, nil
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "PasswordRotation"),
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "ManageMasterUserPassword"),
+		cmpopts.IgnoreFields(svcapitypes.CustomDBInstanceParameters{}, "MasterUserSecretKMSKeyID"),
+	) && !maintenanceWindowChanged && !backupWindowChanged && !pwChanged &&
+		!rds.IsPasswordRotationDue(cr, cr.Spec.ForProvider.PasswordRotation.GetInterval(), e.passwordRotatedAt), nil
 }
 
 func createPatch(out *svcsdk.DescribeDBInstancesOutput, target *svcapitypes.DBInstanceParameters) (*svcapitypes.DBInstanceParameters, error) {
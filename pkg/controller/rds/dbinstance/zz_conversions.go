@@ -398,6 +398,21 @@ func GenerateDBInstance(resp *svcsdk.DescribeDBInstancesOutput) *svcapitypes.DBI
 		} else {
 			cr.Status.AtProvider.ListenerEndpoint = nil
 		}
+		if elem.MasterUserSecret != nil {
+			f47 := &svcapitypes.MasterUserSecret{}
+			if elem.MasterUserSecret.KmsKeyId != nil {
+				f47.KMSKeyID = elem.MasterUserSecret.KmsKeyId
+			}
+			if elem.MasterUserSecret.SecretArn != nil {
+				f47.SecretARN = elem.MasterUserSecret.SecretArn
+			}
+			if elem.MasterUserSecret.SecretStatus != nil {
+				f47.SecretStatus = elem.MasterUserSecret.SecretStatus
+			}
+			cr.Status.AtProvider.MasterUserSecret = f47
+		} else {
+			cr.Status.AtProvider.MasterUserSecret = nil
+		}
 		if elem.MasterUsername != nil {
 			cr.Spec.ForProvider.MasterUsername = elem.MasterUsername
 		} else {
@@ -19,9 +19,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 
+	awsarn "github.com/aws/aws-sdk-go/aws/arn"
+	svcsdkkms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
 	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
@@ -32,7 +37,6 @@ import (
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/connection"
-	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
@@ -41,8 +45,8 @@ import (
 	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/secretsmanager/v1beta1"
 	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
 	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
-	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
 	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
 )
 
 const (
@@ -60,9 +64,16 @@ const (
 	errParseSecretValue     = "cannot parse AWS secret value"
 	errGetAWSSecretValue    = "cannot get AWS secret value"
 	errCreateK8sSecret      = "canoot create secret in K8s"
+	errGetK8sSecret         = "cannot get secret in K8s"
+	errUpdateK8sSecret      = "cannot update secret in K8s"
 	errNoAWSValue           = "neither SecretString nor SecretBinary field is filled in the returned object"
 	errNoSecretRef          = "neither binarySecretRef nor stringSecretRef is given"
 	errOnlyOneSecretRef     = "only one of binarySecretRef or stringSecretRef must be set"
+	errRotateSecret         = "cannot configure rotation of the secret"
+	errCancelRotateSecret   = "cannot turn off rotation of the secret"
+	errReplicateSecret      = "cannot replicate the secret to regions"
+	errRemoveReplicas       = "cannot remove regions from replication of the secret"
+	errDescribeReplicaKey   = "cannot describe the KMS key of a replica"
 )
 
 // SetupSecret adds a controller that reconciles a Secret.
@@ -70,12 +81,13 @@ func SetupSecret(mgr ctrl.Manager, o controller.Options) error {
 	name := managed.ControllerName(svcapitypes.SecretGroupKind)
 	opts := []option{
 		func(e *external) {
-			e.preObserve = preObserve
-			e.postObserve = postObserve
-			h := &hooks{client: e.client, kube: e.kube}
+			h := &hooks{client: e.client, kube: e.kube, newKMSClient: newKMSClient(e.kube)}
+			e.preObserve = h.preObserve
+			e.postObserve = h.postObserve
 			e.lateInitialize = h.lateInitialize
 			e.isUpToDate = h.isUpToDate
 			e.preUpdate = h.preUpdate
+			e.postUpdate = h.postUpdate
 			e.preCreate = h.preCreate
 			e.preDelete = preDelete
 		},
@@ -89,26 +101,29 @@ func SetupSecret(mgr ctrl.Manager, o controller.Options) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
 		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(filter.Filter).
+		WithEventFilter(o.EventFilter(name)).
 		For(&svcapitypes.Secret{}).
-		Complete(managed.NewReconciler(mgr,
+		Complete(o.NewReconciler(mgr,
 			resource.ManagedKind(svcapitypes.SecretGroupVersionKind),
-			managed.WithExternalConnecter(&connector{kube: mgr.GetClient(), opts: opts}),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), opts: opts})),
 			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
 			managed.WithInitializers(managed.NewDefaultProviderConfig(mgr.GetClient()), managed.NewNameAsExternalName(mgr.GetClient()), &tagger{kube: mgr.GetClient()}),
-			managed.WithPollInterval(o.PollInterval),
+			o.WithPollInterval(name),
 			managed.WithLogger(o.Logger.WithValues("controller", name)),
 			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
 			managed.WithMetricsReconciler(o.MetricsReconciler),
 			managed.WithConnectionPublishers(cps...)))
 }
 
-func preObserve(_ context.Context, cr *svcapitypes.Secret, obj *svcsdk.DescribeSecretInput) error {
+func (e *hooks) preObserve(ctx context.Context, cr *svcapitypes.Secret, obj *svcsdk.DescribeSecretInput) error {
 	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
-	return nil
+	if meta.WasDeleted(cr) {
+		return nil
+	}
+	return e.resolveReplicaKeys(ctx, cr)
 }
 
-func postObserve(_ context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
+func (e *hooks) postObserve(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput, obs managed.ExternalObservation, err error) (managed.ExternalObservation, error) {
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
@@ -119,15 +134,134 @@ func postObserve(_ context.Context, cr *svcapitypes.Secret, resp *svcsdk.Describ
 		return obs, nil
 	}
 	cr.SetConditions(xpv1.Available())
+	// NOTE: A secret that can not be synced must not block the reconciliation,
+	// and in particular the deletion, of the managed resource.
+	if isSyncFromAWS(&cr.Spec.ForProvider) && !meta.WasDeleted(cr) {
+		if err := e.syncFromAWS(ctx, cr); err != nil {
+			cr.SetConditions(SyncFromAWSFailed(err))
+			return obs, nil
+		}
+		cr.SetConditions(SyncedFromAWS())
+	}
 	return obs, nil
 }
 
+// TypeSyncedFromAWS is the type of the condition that indicates whether the
+// value of a secret that is synced from AWS was written to the referenced
+// Kubernetes secret.
+const TypeSyncedFromAWS xpv1.ConditionType = "SyncedFromAWS"
+
+// Reasons the value of a secret was or was not synced from AWS.
+const (
+	ReasonSyncedFromAWS     xpv1.ConditionReason = "SyncedFromAWS"
+	ReasonSyncFromAWSFailed xpv1.ConditionReason = "SyncFromAWSFailed"
+)
+
+// SyncedFromAWS returns a condition that indicates that the value of the
+// secret in AWS was written to the referenced Kubernetes secret.
+func SyncedFromAWS() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSyncedFromAWS,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSyncedFromAWS,
+	}
+}
+
+// SyncFromAWSFailed returns a condition that indicates that the value of the
+// secret in AWS could not be written to the referenced Kubernetes secret
+// because of the supplied error.
+func SyncFromAWSFailed(err error) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeSyncedFromAWS,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSyncFromAWSFailed,
+		Message:            err.Error(),
+	}
+}
+
 type hooks struct {
 	client secretsmanageriface.SecretsManagerAPI
 	kube   client.Client
+
+	// newKMSClient returns a KMS client for the supplied region. It is used to
+	// resolve the KMS keys of replicas.
+	newKMSClient func(ctx context.Context, cr *svcapitypes.Secret, region string) (kmsiface.KMSAPI, error)
+
+	// replicaKeys are the desired KMS keys of the replicas by region as they
+	// were resolved in preObserve.
+	replicaKeys map[string]*svcsdkkms.KeyMetadata
+
+	// described is the secret as it was described in preUpdate.
+	described *svcsdk.DescribeSecretOutput
+}
+
+func isSyncFromAWS(params *svcapitypes.SecretParameters) bool {
+	return awsclients.StringValue(params.SyncDirection) == svcapitypes.SyncDirectionFromAWS
+}
+
+// syncFromAWS writes the current version of the secret in AWS into the
+// referenced Kubernetes secret and records its version ID.
+func (e *hooks) syncFromAWS(ctx context.Context, cr *svcapitypes.Secret) error {
+	ref, err := getSecretRef(&cr.Spec.ForProvider)
+	if err != nil {
+		return err
+	}
+	s, err := e.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{
+		SecretId: awsclients.String(meta.GetExternalName(cr)),
+	})
+	// NOTE: A secret that is created without a value has no version to read
+	// until one is put into it, e.g. by the rotation function.
+	if IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return awsclients.Wrap(err, errGetSecretValue)
+	}
+	data, err := getAWSSecretData(ref, s)
+	if err != nil {
+		return errors.Wrap(err, errGetAWSSecretValue)
+	}
+
+	sc := &corev1.Secret{}
+	err = e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc)
+	if kerrors.IsNotFound(err) {
+		sc = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        ref.Name,
+				Namespace:   ref.Namespace,
+				Annotations: map[string]string{svcapitypes.AnnotationKeyVersionID: awsclients.StringValue(s.VersionId)},
+			},
+			Data: data,
+		}
+		return errors.Wrap(e.kube.Create(ctx, sc), errCreateK8sSecret)
+	}
+	if err != nil {
+		return errors.Wrap(err, errGetK8sSecret)
+	}
+
+	desired := sc.DeepCopy()
+	if ref.Key != nil {
+		if desired.Data == nil {
+			desired.Data = map[string][]byte{}
+		}
+		desired.Data[*ref.Key] = data[*ref.Key]
+	} else {
+		desired.Data = data
+	}
+	meta.AddAnnotations(desired, map[string]string{svcapitypes.AnnotationKeyVersionID: awsclients.StringValue(s.VersionId)})
+	if cmp.Equal(sc, desired) {
+		return nil
+	}
+	return errors.Wrap(e.kube.Update(ctx, desired), errUpdateK8sSecret)
 }
 
 func (e *hooks) lateInitialize(spec *svcapitypes.SecretParameters, resp *svcsdk.DescribeSecretOutput) error {
+	// The Kubernetes secret is kept in sync in postObserve.
+	if isSyncFromAWS(spec) {
+		return nil
+	}
 	_, err := e.getPayload(context.TODO(), spec)
 	if err := client.IgnoreNotFound(err); err != nil {
 		return err
@@ -192,15 +326,22 @@ func getAWSSecretData(ref *svcapitypes.SecretReference, s *svcsdk.GetSecretValue
 		return nil, errors.New(errNoAWSValue)
 	}
 
-	parsed := map[string]string{}
+	parsed := map[string]json.RawMessage{}
 	err := json.Unmarshal(raw, &parsed)
 	if err != nil {
 		return nil, errors.Wrap(err, errParseSecretValue)
 	}
 
+	// Every key of the JSON object ends up in its own key in the Kubernetes
+	// secret. Strings are unquoted, other values are kept as JSON.
 	payload := map[string][]byte{}
 	for k, v := range parsed {
-		payload[k] = []byte(v)
+		var str string
+		if err := json.Unmarshal(v, &str); err == nil {
+			payload[k] = []byte(str)
+			continue
+		}
+		payload[k] = v
 	}
 	return payload, nil
 }
@@ -224,6 +365,14 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 	if len(add) != 0 && len(remove) != 0 {
 		return false, nil
 	}
+	if !isRotationUpToDate(&cr.Spec.ForProvider, resp) {
+		return false, nil
+	}
+
+	addReplicas, removeReplicas := diffReplicas(cr.Spec.ForProvider.AddReplicaRegions, resp.ReplicationStatus, e.sameReplicaKey)
+	if len(addReplicas) != 0 || len(removeReplicas) != 0 {
+		return false, nil
+	}
 
 	// TODO(muvaf): We need isUpToDate to have context.
 	ctx := context.TODO()
@@ -251,6 +400,13 @@ func (e *hooks) isUpToDate(cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOu
 		return false, nil
 	}
 
+	// NOTE: Once rotation is turned on, the value of the secret is owned by
+	// the rotation function, so we don't compare it with the referenced one.
+	// The same goes for secrets that are synced from AWS.
+	if awsclients.BoolValue(resp.RotationEnabled) || isSyncFromAWS(&cr.Spec.ForProvider) {
+		return true, nil
+	}
+
 	// Compare secret values
 	s, err := e.client.GetSecretValueWithContext(ctx, &svcsdk.GetSecretValueInput{
 		SecretId: awsclients.String(meta.GetExternalName(cr)),
@@ -323,6 +479,7 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	if err != nil {
 		return awsclients.Wrap(err, errDescribe)
 	}
+	e.described = resp
 	add, remove := DiffTags(cr.Spec.ForProvider.Tags, resp.Tags)
 	if len(remove) != 0 {
 		if _, err := e.client.UntagResourceWithContext(ctx, &svcsdk.UntagResourceInput{
@@ -359,15 +516,17 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 		}
 	}
 
-	payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
-	if err != nil {
-		return err
-	}
-	switch {
-	case cr.Spec.ForProvider.StringSecretRef != nil:
-		obj.SecretString = awsclients.String(string(payload))
-	case cr.Spec.ForProvider.BinarySecretRef != nil:
-		obj.SecretBinary = payload
+	if !awsclients.BoolValue(resp.RotationEnabled) && !isSyncFromAWS(&cr.Spec.ForProvider) {
+		payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			return err
+		}
+		switch {
+		case cr.Spec.ForProvider.StringSecretRef != nil:
+			obj.SecretString = awsclients.String(string(payload))
+		case cr.Spec.ForProvider.BinarySecretRef != nil:
+			obj.SecretBinary = payload
+		}
 	}
 	obj.SecretId = awsclients.String(meta.GetExternalName(cr))
 	obj.Description = cr.Spec.ForProvider.Description
@@ -375,8 +534,199 @@ func (e *hooks) preUpdate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	return nil
 }
 
+func (e *hooks) postUpdate(ctx context.Context, cr *svcapitypes.Secret, _ *svcsdk.UpdateSecretOutput, upd managed.ExternalUpdate, err error) (managed.ExternalUpdate, error) {
+	if err != nil {
+		return upd, err
+	}
+	// NOTE: Rotation is configured after the value is updated so that the
+	// rotation function starts from the latest version of the secret.
+	// Updating the value does not change the replication or rotation
+	// configuration, so the secret described in preUpdate is still current.
+	resp := e.described
+	if err := e.updateReplicas(ctx, cr, resp); err != nil {
+		return upd, err
+	}
+	if isRotationUpToDate(&cr.Spec.ForProvider, resp) {
+		return upd, nil
+	}
+	if cr.Spec.ForProvider.RotationRules == nil {
+		_, err := e.client.CancelRotateSecretWithContext(ctx, &svcsdk.CancelRotateSecretInput{
+			SecretId: awsclients.String(meta.GetExternalName(cr)),
+		})
+		return upd, awsclients.Wrap(err, errCancelRotateSecret)
+	}
+	_, err = e.client.RotateSecretWithContext(ctx, &svcsdk.RotateSecretInput{
+		SecretId:          awsclients.String(meta.GetExternalName(cr)),
+		RotationLambdaARN: cr.Spec.ForProvider.RotationLambdaARN,
+		RotationRules: &svcsdk.RotationRulesType{
+			AutomaticallyAfterDays: cr.Spec.ForProvider.RotationRules.AutomaticallyAfterDays,
+			ScheduleExpression:     cr.Spec.ForProvider.RotationRules.ScheduleExpression,
+			Duration:               cr.Spec.ForProvider.RotationRules.Duration,
+		},
+		RotateImmediately: cr.Spec.ForProvider.RotateImmediately,
+	})
+	return upd, awsclients.Wrap(err, errRotateSecret)
+}
+
+func (e *hooks) updateReplicas(ctx context.Context, cr *svcapitypes.Secret, resp *svcsdk.DescribeSecretOutput) error {
+	add, remove := diffReplicas(cr.Spec.ForProvider.AddReplicaRegions, resp.ReplicationStatus, e.sameReplicaKey)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveRegionsFromReplicationWithContext(ctx, &svcsdk.RemoveRegionsFromReplicationInput{
+			SecretId:             awsclients.String(meta.GetExternalName(cr)),
+			RemoveReplicaRegions: remove,
+		}); err != nil {
+			return awsclients.Wrap(err, errRemoveReplicas)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.ReplicateSecretToRegionsWithContext(ctx, &svcsdk.ReplicateSecretToRegionsInput{
+			SecretId:                    awsclients.String(meta.GetExternalName(cr)),
+			AddReplicaRegions:           add,
+			ForceOverwriteReplicaSecret: cr.Spec.ForProvider.ForceOverwriteReplicaSecret,
+		}); err != nil {
+			return awsclients.Wrap(err, errReplicateSecret)
+		}
+	}
+	return nil
+}
+
+// diffReplicas returns the regions the secret should be replicated to and
+// the ones it should be removed from. The KMS key of a replica cannot be
+// changed, so a replica whose key differs from the desired one is removed
+// first and only added again once the removal finished and the region is no
+// longer reported. The KMS key is only compared if it is given in the spec.
+func diffReplicas(spec []*svcapitypes.ReplicaRegionType, current []*svcsdk.ReplicationStatusType, sameKey func(region, desired, current string) bool) (add []*svcsdk.ReplicaRegionType, remove []*string) {
+	currentKeys := make(map[string]string, len(current))
+	for _, r := range current {
+		currentKeys[awsclients.StringValue(r.Region)] = awsclients.StringValue(r.KmsKeyId)
+	}
+	desired := make(map[string]struct{}, len(spec))
+	for _, r := range spec {
+		region := awsclients.StringValue(r.Region)
+		desired[region] = struct{}{}
+		key, ok := currentKeys[region]
+		if !ok {
+			add = append(add, &svcsdk.ReplicaRegionType{Region: r.Region, KmsKeyId: r.KMSKeyID})
+			continue
+		}
+		if r.KMSKeyID == nil {
+			continue
+		}
+		if !sameKey(region, awsclients.StringValue(r.KMSKeyID), key) {
+			remove = append(remove, r.Region)
+		}
+	}
+	for _, r := range current {
+		if _, ok := desired[awsclients.StringValue(r.Region)]; !ok {
+			remove = append(remove, r.Region)
+		}
+	}
+	return add, remove
+}
+
+// resolveReplicaKeys resolves the desired KMS keys of the replicas to their
+// metadata. ReplicationStatus may report a key by its ID or ARN instead of the
+// alias it was given by, so the keys are looked up once per reconcile instead
+// of every time they are compared. Key ARNs are not looked up.
+func (e *hooks) resolveReplicaKeys(ctx context.Context, cr *svcapitypes.Secret) error {
+	e.replicaKeys = make(map[string]*svcsdkkms.KeyMetadata, len(cr.Spec.ForProvider.AddReplicaRegions))
+	for _, r := range cr.Spec.ForProvider.AddReplicaRegions {
+		if r.KMSKeyID == nil {
+			continue
+		}
+		region := awsclients.StringValue(r.Region)
+		if key, ok := parseKeyARN(awsclients.StringValue(r.KMSKeyID)); ok {
+			e.replicaKeys[region] = key
+			continue
+		}
+		kms, err := e.newKMSClient(ctx, cr, region)
+		if err != nil {
+			return err
+		}
+		resp, err := kms.DescribeKeyWithContext(ctx, &svcsdkkms.DescribeKeyInput{KeyId: r.KMSKeyID})
+		if err != nil {
+			return awsclients.Wrap(err, errDescribeReplicaKey)
+		}
+		e.replicaKeys[region] = resp.KeyMetadata
+	}
+	return nil
+}
+
+// parseKeyARN returns the metadata of a KMS key that is given by its ARN.
+func parseKeyARN(keyID string) (*svcsdkkms.KeyMetadata, bool) {
+	a, err := awsarn.Parse(keyID)
+	if err != nil || a.Service != "kms" || !strings.HasPrefix(a.Resource, "key/") {
+		return nil, false
+	}
+	return &svcsdkkms.KeyMetadata{
+		Arn:   awsclients.String(keyID),
+		KeyId: awsclients.String(strings.TrimPrefix(a.Resource, "key/")),
+	}, true
+}
+
+// sameReplicaKey reports whether the KMS key of a replica in the given region
+// is the desired one, which is the case if it is reported as given in the spec
+// or by the ID or ARN the desired key was resolved to.
+func (e *hooks) sameReplicaKey(region, desired, current string) bool {
+	if desired == current {
+		return true
+	}
+	key := e.replicaKeys[region]
+	if key == nil {
+		return false
+	}
+	return current == awsclients.StringValue(key.Arn) || current == awsclients.StringValue(key.KeyId)
+}
+
+// newKMSClient returns a function that creates KMS clients with the AWS
+// configuration of the supplied secret.
+func newKMSClient(kube client.Client) func(ctx context.Context, cr *svcapitypes.Secret, region string) (kmsiface.KMSAPI, error) {
+	return func(ctx context.Context, cr *svcapitypes.Secret, region string) (kmsiface.KMSAPI, error) {
+		sess, err := awsclients.GetConfigV1(ctx, kube, cr, region)
+		if err != nil {
+			return nil, err
+		}
+		return svcsdkkms.New(sess), nil
+	}
+}
+
+// isRotationUpToDate returns whether the rotation configuration of the secret
+// in AWS matches the desired one. Only the rotation rules that are given in
+// the spec are compared since AWS fills in the rest.
+func isRotationUpToDate(spec *svcapitypes.SecretParameters, resp *svcsdk.DescribeSecretOutput) bool {
+	if spec.RotationRules == nil {
+		return !awsclients.BoolValue(resp.RotationEnabled)
+	}
+	if !awsclients.BoolValue(resp.RotationEnabled) {
+		return false
+	}
+	if spec.RotationLambdaARN != nil && awsclients.StringValue(spec.RotationLambdaARN) != awsclients.StringValue(resp.RotationLambdaARN) {
+		return false
+	}
+	current := resp.RotationRules
+	if current == nil {
+		current = &svcsdk.RotationRulesType{}
+	}
+	rules := spec.RotationRules
+	switch {
+	case rules.AutomaticallyAfterDays != nil && awsclients.Int64Value(rules.AutomaticallyAfterDays) != awsclients.Int64Value(current.AutomaticallyAfterDays):
+		return false
+	case rules.ScheduleExpression != nil && awsclients.StringValue(rules.ScheduleExpression) != awsclients.StringValue(current.ScheduleExpression):
+		return false
+	case rules.Duration != nil && awsclients.StringValue(rules.Duration) != awsclients.StringValue(current.Duration):
+		return false
+	}
+	return true
+}
+
 func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcsdk.CreateSecretInput) error {
+	obj.Name = awsclients.String(meta.GetExternalName(cr))
 	payload, err := e.getPayload(ctx, &cr.Spec.ForProvider)
+	// NOTE: Secrets synced from AWS are created without a value if there
+	// is no Kubernetes secret to take the initial one from.
+	if kerrors.IsNotFound(err) && isSyncFromAWS(&cr.Spec.ForProvider) {
+		return nil
+	}
 	if err != nil {
 		return err
 	}
@@ -386,7 +736,6 @@ func (e *hooks) preCreate(ctx context.Context, cr *svcapitypes.Secret, obj *svcs
 	case cr.Spec.ForProvider.BinarySecretRef != nil:
 		obj.SecretBinary = payload
 	}
-	obj.Name = awsclients.String(meta.GetExternalName(cr))
 	return nil
 }
 
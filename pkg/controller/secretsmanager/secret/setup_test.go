@@ -0,0 +1,885 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	svcsdkkms "github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	svcsdk "github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	svcapitypes "github.com/crossplane-contrib/provider-aws/apis/secretsmanager/v1beta1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+var (
+	rotationLambdaARN = "arn:aws:lambda:us-east-1:123456789012:function:rotate"
+	secretName        = "example-secret"
+	secretNamespace   = "crossplane-system"
+	versionID         = "version-2"
+
+	errBoom = errors.New("boom")
+)
+
+type mockSecretsManagerClient struct {
+	secretsmanageriface.SecretsManagerAPI
+
+	MockGetSecretValue     func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error)
+	MockRotateSecret       func(*svcsdk.RotateSecretInput) (*svcsdk.RotateSecretOutput, error)
+	MockCancelRotateSecret func(*svcsdk.CancelRotateSecretInput) (*svcsdk.CancelRotateSecretOutput, error)
+}
+
+func (m *mockSecretsManagerClient) GetSecretValueWithContext(_ context.Context, in *svcsdk.GetSecretValueInput, _ ...request.Option) (*svcsdk.GetSecretValueOutput, error) {
+	return m.MockGetSecretValue(in)
+}
+
+func (m *mockSecretsManagerClient) RotateSecretWithContext(_ context.Context, in *svcsdk.RotateSecretInput, _ ...request.Option) (*svcsdk.RotateSecretOutput, error) {
+	return m.MockRotateSecret(in)
+}
+
+func (m *mockSecretsManagerClient) CancelRotateSecretWithContext(_ context.Context, in *svcsdk.CancelRotateSecretInput, _ ...request.Option) (*svcsdk.CancelRotateSecretOutput, error) {
+	return m.MockCancelRotateSecret(in)
+}
+
+type mockKMSClient struct {
+	kmsiface.KMSAPI
+
+	MockDescribeKey func(*svcsdkkms.DescribeKeyInput) (*svcsdkkms.DescribeKeyOutput, error)
+}
+
+func (m *mockKMSClient) DescribeKeyWithContext(_ context.Context, in *svcsdkkms.DescribeKeyInput, _ ...request.Option) (*svcsdkkms.DescribeKeyOutput, error) {
+	return m.MockDescribeKey(in)
+}
+
+type secretModifier func(*svcapitypes.Secret)
+
+func withRotation(rules *svcapitypes.RotationRules) secretModifier {
+	return func(r *svcapitypes.Secret) {
+		r.Spec.ForProvider.RotationLambdaARN = &rotationLambdaARN
+		r.Spec.ForProvider.RotationRules = rules
+	}
+}
+
+func withSyncFromAWS(key *string) secretModifier {
+	return func(r *svcapitypes.Secret) {
+		r.Spec.ForProvider.SyncDirection = awsclients.String(svcapitypes.SyncDirectionFromAWS)
+		r.Spec.ForProvider.StringSecretRef = &svcapitypes.SecretReference{
+			Name:      secretName,
+			Namespace: secretNamespace,
+			Key:       key,
+		}
+	}
+}
+
+func secret(m ...secretModifier) *svcapitypes.Secret {
+	cr := &svcapitypes.Secret{}
+	meta.SetExternalName(cr, "example")
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func TestGetAWSSecretData(t *testing.T) {
+	type args struct {
+		ref *svcapitypes.SecretReference
+		out *svcsdk.GetSecretValueOutput
+	}
+	type want struct {
+		data map[string][]byte
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SingleKey": {
+			args: args{
+				ref: &svcapitypes.SecretReference{Key: awsclients.String("password")},
+				out: &svcsdk.GetSecretValueOutput{SecretString: awsclients.String(`{"password":"s3cr3t"}`)},
+			},
+			want: want{
+				data: map[string][]byte{"password": []byte(`{"password":"s3cr3t"}`)},
+			},
+		},
+		"PerKeyExtraction": {
+			args: args{
+				ref: &svcapitypes.SecretReference{},
+				out: &svcsdk.GetSecretValueOutput{SecretString: awsclients.String(`{"username":"admin","port":5432,"ssl":true,"hosts":["a","b"]}`)},
+			},
+			want: want{
+				data: map[string][]byte{
+					"username": []byte("admin"),
+					"port":     []byte("5432"),
+					"ssl":      []byte("true"),
+					"hosts":    []byte(`["a","b"]`),
+				},
+			},
+		},
+		"NotJSON": {
+			args: args{
+				ref: &svcapitypes.SecretReference{},
+				out: &svcsdk.GetSecretValueOutput{SecretString: awsclients.String("s3cr3t")},
+			},
+			want: want{
+				err: errors.Wrap(errors.New("invalid character 's' looking for beginning of value"), errParseSecretValue),
+			},
+		},
+		"NoValue": {
+			args: args{
+				ref: &svcapitypes.SecretReference{},
+				out: &svcsdk.GetSecretValueOutput{},
+			},
+			want: want{
+				err: errors.New(errNoAWSValue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := getAWSSecretData(tc.args.ref, tc.args.out)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.data, data); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncFromAWS(t *testing.T) {
+	type args struct {
+		kube   client.Client
+		client secretsmanageriface.SecretsManagerAPI
+		cr     *svcapitypes.Secret
+	}
+	type want struct {
+		secret *corev1.Secret
+		err    error
+	}
+
+	value := &svcsdk.GetSecretValueOutput{
+		SecretString: awsclients.String(`{"username":"admin","password":"s3cr3t"}`),
+		VersionId:    &versionID,
+	}
+	current := func() *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        secretName,
+				Namespace:   secretNamespace,
+				Annotations: map[string]string{svcapitypes.AnnotationKeyVersionID: versionID},
+			},
+			Data: map[string][]byte{
+				"username": []byte("admin"),
+				"password": []byte("s3cr3t"),
+			},
+		}
+	}
+	var got *corev1.Secret
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"CreatesSecret": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(kerrors.NewNotFound(schema.GroupResource{}, secretName)),
+					MockCreate: func(_ context.Context, obj client.Object, _ ...client.CreateOption) error {
+						got = obj.(*corev1.Secret)
+						return nil
+					},
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return value, nil
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+			want: want{
+				secret: current(),
+			},
+		},
+		"UpdatesSecretWithNewVersion": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						s := current()
+						s.Annotations[svcapitypes.AnnotationKeyVersionID] = "version-1"
+						s.Data["password"] = []byte("old")
+						s.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+					MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						got = obj.(*corev1.Secret)
+						return nil
+					},
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return value, nil
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+			want: want{
+				secret: current(),
+			},
+		},
+		"UpdatesOnlyReferencedKey": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						s := current()
+						s.Annotations = nil
+						s.Data["value"] = []byte("old")
+						s.DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+					MockUpdate: func(_ context.Context, obj client.Object, _ ...client.UpdateOption) error {
+						got = obj.(*corev1.Secret)
+						return nil
+					},
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return value, nil
+					},
+				},
+				cr: secret(withSyncFromAWS(awsclients.String("value"))),
+			},
+			want: want{
+				secret: func() *corev1.Secret {
+					s := current()
+					s.Data["value"] = []byte(`{"username":"admin","password":"s3cr3t"}`)
+					return s
+				}(),
+			},
+		},
+		"AlreadyInSync": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+						current().DeepCopyInto(obj.(*corev1.Secret))
+						return nil
+					},
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return value, nil
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+		},
+		"NoVersionYet": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(errBoom),
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return nil, awserr.New(svcsdk.ErrCodeResourceNotFoundException, "", nil)
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+		},
+		"FailedUpdate": {
+			args: args{
+				kube: &test.MockClient{
+					MockGet:    test.NewMockGetFn(nil),
+					MockUpdate: test.NewMockUpdateFn(errBoom),
+				},
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(*svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return value, nil
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdateK8sSecret),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &hooks{kube: tc.args.kube, client: tc.args.client}
+			err := e.syncFromAWS(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.secret, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostObserve(t *testing.T) {
+	deleted := metav1.NewTime(time.Unix(0, 0))
+
+	type args struct {
+		client secretsmanageriface.SecretsManagerAPI
+		cr     *svcapitypes.Secret
+	}
+	type want struct {
+		conditions []xpv1.Condition
+		err        error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"SyncFailed": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(_ *svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: secret(withSyncFromAWS(nil)),
+			},
+			want: want{
+				conditions: []xpv1.Condition{xpv1.Available(), SyncFromAWSFailed(awsclients.Wrap(errBoom, errGetSecretValue))},
+			},
+		},
+		"DeletedIsNotSynced": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockGetSecretValue: func(_ *svcsdk.GetSecretValueInput) (*svcsdk.GetSecretValueOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: secret(withSyncFromAWS(nil), func(r *svcapitypes.Secret) { r.SetDeletionTimestamp(&deleted) }),
+			},
+			want: want{
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+		"ToAWSIsNotSynced": {
+			args: args{
+				client: &mockSecretsManagerClient{},
+				cr:     secret(),
+			},
+			want: want{
+				conditions: []xpv1.Condition{xpv1.Available()},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client, kube: &test.MockClient{}}
+			obs, err := h.postObserve(context.Background(), tc.args.cr, &svcsdk.DescribeSecretOutput{}, managed.ExternalObservation{ResourceExists: true}, nil)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if !obs.ResourceExists {
+				t.Errorf("r: want the secret to exist")
+			}
+			if diff := cmp.Diff(tc.want.conditions, tc.args.cr.Status.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsRotationUpToDate(t *testing.T) {
+	type args struct {
+		spec *svcapitypes.SecretParameters
+		resp *svcsdk.DescribeSecretOutput
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"RotationOff": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{},
+				resp: &svcsdk.DescribeSecretOutput{},
+			},
+			want: true,
+		},
+		"RotationShouldBeTurnedOff": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled: awsclients.Bool(true),
+				},
+			},
+			want: false,
+		},
+		"RotationShouldBeTurnedOn": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{
+					CustomSecretParameters: svcapitypes.CustomSecretParameters{
+						RotationRules: &svcapitypes.RotationRules{
+							AutomaticallyAfterDays: awsclients.Int64(30),
+						},
+					},
+				},
+				resp: &svcsdk.DescribeSecretOutput{},
+			},
+			want: false,
+		},
+		"SameRules": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{
+					CustomSecretParameters: svcapitypes.CustomSecretParameters{
+						RotationLambdaARN: &rotationLambdaARN,
+						RotationRules: &svcapitypes.RotationRules{
+							ScheduleExpression: awsclients.String("rate(10 days)"),
+						},
+					},
+				},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: &rotationLambdaARN,
+					RotationRules: &svcsdk.RotationRulesType{
+						AutomaticallyAfterDays: awsclients.Int64(10),
+						ScheduleExpression:     awsclients.String("rate(10 days)"),
+					},
+				},
+			},
+			want: true,
+		},
+		"DifferentLambda": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{
+					CustomSecretParameters: svcapitypes.CustomSecretParameters{
+						RotationLambdaARN: &rotationLambdaARN,
+						RotationRules:     &svcapitypes.RotationRules{},
+					},
+				},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled:   awsclients.Bool(true),
+					RotationLambdaARN: awsclients.String("arn:aws:lambda:us-east-1:123456789012:function:other"),
+				},
+			},
+			want: false,
+		},
+		"DifferentDuration": {
+			args: args{
+				spec: &svcapitypes.SecretParameters{
+					CustomSecretParameters: svcapitypes.CustomSecretParameters{
+						RotationRules: &svcapitypes.RotationRules{
+							ScheduleExpression: awsclients.String("cron(0 8 1 * ? *)"),
+							Duration:           awsclients.String("3h"),
+						},
+					},
+				},
+				resp: &svcsdk.DescribeSecretOutput{
+					RotationEnabled: awsclients.Bool(true),
+					RotationRules: &svcsdk.RotationRulesType{
+						ScheduleExpression: awsclients.String("cron(0 8 1 * ? *)"),
+						Duration:           awsclients.String("2h"),
+					},
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := isRotationUpToDate(tc.args.spec, tc.args.resp)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPostUpdate(t *testing.T) {
+	type args struct {
+		client    *mockSecretsManagerClient
+		cr        *svcapitypes.Secret
+		described *svcsdk.DescribeSecretOutput
+		err       error
+	}
+
+	cases := map[string]struct {
+		args
+		want error
+	}{
+		"UpdateFailed": {
+			args: args{
+				client: &mockSecretsManagerClient{},
+				cr:     secret(withRotation(&svcapitypes.RotationRules{AutomaticallyAfterDays: awsclients.Int64(30)})),
+				err:    errBoom,
+			},
+			want: errBoom,
+		},
+		"RotationUpToDate": {
+			args: args{
+				client: &mockSecretsManagerClient{},
+				cr:     secret(),
+				described: &svcsdk.DescribeSecretOutput{
+					RotationEnabled: awsclients.Bool(false),
+				},
+			},
+		},
+		"TurnOnRotation": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockRotateSecret: func(in *svcsdk.RotateSecretInput) (*svcsdk.RotateSecretOutput, error) {
+						want := &svcsdk.RotateSecretInput{
+							SecretId:          awsclients.String("example"),
+							RotationLambdaARN: &rotationLambdaARN,
+							RotationRules: &svcsdk.RotationRulesType{
+								AutomaticallyAfterDays: awsclients.Int64(30),
+							},
+						}
+						if diff := cmp.Diff(want, in); diff != "" {
+							t.Errorf("RotateSecret: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.RotateSecretOutput{}, nil
+					},
+				},
+				cr:        secret(withRotation(&svcapitypes.RotationRules{AutomaticallyAfterDays: awsclients.Int64(30)})),
+				described: &svcsdk.DescribeSecretOutput{},
+			},
+		},
+		"RotateFailed": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockRotateSecret: func(_ *svcsdk.RotateSecretInput) (*svcsdk.RotateSecretOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr:        secret(withRotation(&svcapitypes.RotationRules{AutomaticallyAfterDays: awsclients.Int64(30)})),
+				described: &svcsdk.DescribeSecretOutput{},
+			},
+			want: awsclients.Wrap(errBoom, errRotateSecret),
+		},
+		"TurnOffRotation": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockCancelRotateSecret: func(in *svcsdk.CancelRotateSecretInput) (*svcsdk.CancelRotateSecretOutput, error) {
+						if diff := cmp.Diff(&svcsdk.CancelRotateSecretInput{SecretId: awsclients.String("example")}, in); diff != "" {
+							t.Errorf("CancelRotateSecret: -want, +got:\n%s", diff)
+						}
+						return &svcsdk.CancelRotateSecretOutput{}, nil
+					},
+				},
+				cr: secret(),
+				described: &svcsdk.DescribeSecretOutput{
+					RotationEnabled: awsclients.Bool(true),
+				},
+			},
+		},
+		"CancelRotateFailed": {
+			args: args{
+				client: &mockSecretsManagerClient{
+					MockCancelRotateSecret: func(_ *svcsdk.CancelRotateSecretInput) (*svcsdk.CancelRotateSecretOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: secret(),
+				described: &svcsdk.DescribeSecretOutput{
+					RotationEnabled: awsclients.Bool(true),
+				},
+			},
+			want: awsclients.Wrap(errBoom, errCancelRotateSecret),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{client: tc.args.client, described: tc.args.described}
+			_, err := h.postUpdate(context.Background(), tc.args.cr, &svcsdk.UpdateSecretOutput{}, managed.ExternalUpdate{}, tc.args.err)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffReplicas(t *testing.T) {
+	sameARN := func(_, desired, current string) bool {
+		return desired == current || desired == "alias/key"
+	}
+	type args struct {
+		spec    []*svcapitypes.ReplicaRegionType
+		current []*svcsdk.ReplicationStatusType
+		sameKey func(region, desired, current string) bool
+	}
+	type want struct {
+		add    []*svcsdk.ReplicaRegionType
+		remove []*string
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"NoReplicas": {},
+		"SameReplicas": {
+			args: args{
+				spec: []*svcapitypes.ReplicaRegionType{
+					{Region: awsclients.String("eu-west-1")},
+					{Region: awsclients.String("eu-central-1"), KMSKeyID: awsclients.String("key")},
+				},
+				current: []*svcsdk.ReplicationStatusType{
+					{Region: awsclients.String("eu-central-1"), KmsKeyId: awsclients.String("key"), Status: awsclients.String("InSync")},
+					{Region: awsclients.String("eu-west-1"), KmsKeyId: awsclients.String("alias/aws/secretsmanager"), Status: awsclients.String("InSync")},
+				},
+				sameKey: sameARN,
+			},
+		},
+		"SameKeyByAlias": {
+			args: args{
+				spec: []*svcapitypes.ReplicaRegionType{
+					{Region: awsclients.String("eu-west-1"), KMSKeyID: awsclients.String("alias/key")},
+				},
+				current: []*svcsdk.ReplicationStatusType{
+					{Region: awsclients.String("eu-west-1"), KmsKeyId: awsclients.String("arn:aws:kms:eu-west-1:123456789012:key/key")},
+				},
+				sameKey: sameARN,
+			},
+		},
+		"AddAndRemove": {
+			args: args{
+				spec: []*svcapitypes.ReplicaRegionType{
+					{Region: awsclients.String("eu-west-1")},
+					{Region: awsclients.String("us-west-2"), KMSKeyID: awsclients.String("key")},
+				},
+				current: []*svcsdk.ReplicationStatusType{
+					{Region: awsclients.String("eu-west-1")},
+					{Region: awsclients.String("eu-central-1")},
+				},
+				sameKey: sameARN,
+			},
+			want: want{
+				add:    []*svcsdk.ReplicaRegionType{{Region: awsclients.String("us-west-2"), KmsKeyId: awsclients.String("key")}},
+				remove: []*string{awsclients.String("eu-central-1")},
+			},
+		},
+		"ChangedKMSKeyIsRemovedFirst": {
+			args: args{
+				spec: []*svcapitypes.ReplicaRegionType{
+					{Region: awsclients.String("eu-west-1"), KMSKeyID: awsclients.String("new")},
+				},
+				current: []*svcsdk.ReplicationStatusType{
+					{Region: awsclients.String("eu-west-1"), KmsKeyId: awsclients.String("old")},
+				},
+				sameKey: sameARN,
+			},
+			want: want{
+				remove: []*string{awsclients.String("eu-west-1")},
+			},
+		},
+		"ChangedKMSKeyIsAddedOnceRemoved": {
+			args: args{
+				spec: []*svcapitypes.ReplicaRegionType{
+					{Region: awsclients.String("eu-west-1"), KMSKeyID: awsclients.String("new")},
+				},
+				sameKey: sameARN,
+			},
+			want: want{
+				add: []*svcsdk.ReplicaRegionType{{Region: awsclients.String("eu-west-1"), KmsKeyId: awsclients.String("new")}},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			add, remove := diffReplicas(tc.args.spec, tc.args.current, tc.args.sameKey)
+			if diff := cmp.Diff(tc.want.add, add); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestResolveReplicaKeys(t *testing.T) {
+	keyARN := "arn:aws:kms:eu-west-1:123456789012:key/key"
+	withReplica := func(keyID *string) secretModifier {
+		return func(r *svcapitypes.Secret) {
+			r.Spec.ForProvider.AddReplicaRegions = []*svcapitypes.ReplicaRegionType{
+				{Region: awsclients.String("eu-west-1"), KMSKeyID: keyID},
+			}
+		}
+	}
+	type args struct {
+		kms kmsiface.KMSAPI
+		cr  *svcapitypes.Secret
+	}
+	type want struct {
+		keys map[string]*svcsdkkms.KeyMetadata
+		err  error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"DefaultKey": {
+			args: args{
+				cr: secret(withReplica(nil)),
+			},
+			want: want{
+				keys: map[string]*svcsdkkms.KeyMetadata{},
+			},
+		},
+		"KeyARNIsNotDescribed": {
+			args: args{
+				cr: secret(withReplica(&keyARN)),
+			},
+			want: want{
+				keys: map[string]*svcsdkkms.KeyMetadata{
+					"eu-west-1": {Arn: &keyARN, KeyId: awsclients.String("key")},
+				},
+			},
+		},
+		"AliasIsDescribed": {
+			args: args{
+				kms: &mockKMSClient{
+					MockDescribeKey: func(_ *svcsdkkms.DescribeKeyInput) (*svcsdkkms.DescribeKeyOutput, error) {
+						return &svcsdkkms.DescribeKeyOutput{KeyMetadata: &svcsdkkms.KeyMetadata{Arn: &keyARN, KeyId: awsclients.String("key")}}, nil
+					},
+				},
+				cr: secret(withReplica(awsclients.String("alias/key"))),
+			},
+			want: want{
+				keys: map[string]*svcsdkkms.KeyMetadata{
+					"eu-west-1": {Arn: &keyARN, KeyId: awsclients.String("key")},
+				},
+			},
+		},
+		"DescribeKeyFailed": {
+			args: args{
+				kms: &mockKMSClient{
+					MockDescribeKey: func(_ *svcsdkkms.DescribeKeyInput) (*svcsdkkms.DescribeKeyOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: secret(withReplica(awsclients.String("alias/key"))),
+			},
+			want: want{
+				keys: map[string]*svcsdkkms.KeyMetadata{},
+				err:  awsclients.Wrap(errBoom, errDescribeReplicaKey),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{newKMSClient: func(_ context.Context, _ *svcapitypes.Secret, _ string) (kmsiface.KMSAPI, error) {
+				return tc.args.kms, nil
+			}}
+			err := h.resolveReplicaKeys(context.Background(), tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.keys, h.replicaKeys); diff != "" {
+				t.Errorf("keys: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSameReplicaKey(t *testing.T) {
+	keyARN := "arn:aws:kms:eu-west-1:123456789012:key/key"
+	type args struct {
+		keys             map[string]*svcsdkkms.KeyMetadata
+		desired, current string
+	}
+
+	cases := map[string]struct {
+		args
+		want bool
+	}{
+		"Equal": {
+			args: args{
+				desired: "key",
+				current: "key",
+			},
+			want: true,
+		},
+		"ARNOfSameKey": {
+			args: args{
+				keys:    map[string]*svcsdkkms.KeyMetadata{"eu-west-1": {Arn: &keyARN, KeyId: awsclients.String("key")}},
+				desired: "alias/key",
+				current: keyARN,
+			},
+			want: true,
+		},
+		"IDOfSameKey": {
+			args: args{
+				keys:    map[string]*svcsdkkms.KeyMetadata{"eu-west-1": {Arn: &keyARN, KeyId: awsclients.String("key")}},
+				desired: "alias/key",
+				current: "key",
+			},
+			want: true,
+		},
+		"DifferentKey": {
+			args: args{
+				keys:    map[string]*svcsdkkms.KeyMetadata{"eu-west-1": {Arn: awsclients.String("arn:aws:kms:eu-west-1:123456789012:key/new")}},
+				desired: "alias/new",
+				current: keyARN,
+			},
+		},
+		"NotResolved": {
+			args: args{
+				desired: "alias/key",
+				current: keyARN,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			h := &hooks{replicaKeys: tc.args.keys}
+			if diff := cmp.Diff(tc.want, h.sameReplicaKey("eu-west-1", tc.args.desired, tc.args.current)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -56,6 +56,16 @@ func GenerateSecret(resp *svcsdk.DescribeSecretOutput) *svcapitypes.Secret {
 	} else {
 		cr.Spec.ForProvider.KMSKeyID = nil
 	}
+	if resp.LastRotatedDate != nil {
+		cr.Status.AtProvider.LastRotatedDate = &metav1.Time{Time: *resp.LastRotatedDate}
+	} else {
+		cr.Status.AtProvider.LastRotatedDate = nil
+	}
+	if resp.NextRotationDate != nil {
+		cr.Status.AtProvider.NextRotationDate = &metav1.Time{Time: *resp.NextRotationDate}
+	} else {
+		cr.Status.AtProvider.NextRotationDate = nil
+	}
 	if resp.ReplicationStatus != nil {
 		f10 := []*svcapitypes.ReplicationStatusType{}
 		for _, f10iter := range resp.ReplicationStatus {
@@ -0,0 +1,302 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameter
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+	awsv1alpha1 "github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ssm"
+	"github.com/crossplane-contrib/provider-aws/pkg/features"
+	"github.com/crossplane-contrib/provider-aws/pkg/utils/controller"
+)
+
+const (
+	errUnexpectedObject = "the managed resource is not a Parameter resource"
+	errGet              = "failed to get the SSM Parameter"
+	errDescribe         = "failed to describe the SSM Parameter"
+	errListTags         = "failed to list tags of the SSM Parameter"
+	errCreate           = "failed to create the SSM Parameter"
+	errUpdate           = "failed to update the SSM Parameter"
+	errAddTags          = "failed to add tags to the SSM Parameter"
+	errRemoveTags       = "failed to remove tags from the SSM Parameter"
+	errDelete           = "failed to delete the SSM Parameter"
+	errIsUpToDate       = "failed to check whether the SSM Parameter is up to date"
+	errGetValueSecret   = "failed to get the Kubernetes secret of the value"
+	errFmtKeyNotFound   = "key %s is not found in referenced Kubernetes secret"
+	errMarshalValue     = "failed to marshal the Kubernetes secret data into JSON"
+)
+
+// SetupParameter adds a controller that reconciles Parameter.
+func SetupParameter(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ParameterGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), awsv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(o.ForControllerRuntime()).
+		WithEventFilter(o.EventFilter(name)).
+		For(&v1alpha1.Parameter{}).
+		Complete(o.NewReconciler(mgr,
+			resource.ManagedKind(v1alpha1.ParameterGroupVersionKind),
+			managed.WithExternalConnecter(o.ExternalConnecter(mgr, name, &connector{kube: mgr.GetClient(), newClientFn: ssm.NewParameterClient})),
+			managed.WithReferenceResolver(managed.NewAPISimpleReferenceResolver(mgr.GetClient())),
+			o.WithPollInterval(name),
+			managed.WithLogger(o.Logger.WithValues("controller", name)),
+			managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+			managed.WithMetricsReconciler(o.MetricsReconciler),
+			managed.WithConnectionPublishers(cps...)))
+}
+
+type connector struct {
+	kube        client.Client
+	newClientFn func(config aws.Config) ssm.ParameterClient
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+	cfg, err := awsclient.GetConfig(ctx, c.kube, mg, cr.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
+	}
+	return &external{client: c.newClientFn(*cfg), kube: c.kube}, nil
+}
+
+type external struct {
+	client ssm.ParameterClient
+	kube   client.Client
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+	if meta.GetExternalName(cr) == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p, m, err := e.describe(ctx, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, resource.Ignore(ssm.IsParameterNotFound, err)
+	}
+	tags, err := e.client.ListTagsForResource(ctx, &awsssm.ListTagsForResourceInput{
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+		ResourceId:   aws.String(meta.GetExternalName(cr)),
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, awsclient.Wrap(err, errListTags)
+	}
+
+	current := cr.Spec.ForProvider.DeepCopy()
+	ssm.LateInitialize(&cr.Spec.ForProvider, *m)
+
+	cr.Status.AtProvider = ssm.GenerateObservation(*p, *m)
+	cr.SetConditions(xpv1.Available())
+
+	// NOTE: A value secret that can not be read must neither block the
+	// reconciliation of the other fields nor the deletion of the parameter,
+	// so the value is only compared if the secret could be read.
+	value := aws.ToString(p.Value)
+	if !meta.WasDeleted(cr) {
+		v, err := e.getValue(ctx, &cr.Spec.ForProvider)
+		if err != nil {
+			cr.SetConditions(ssm.ValueSecretUnavailable(err))
+		} else {
+			value = v
+			cr.SetConditions(ssm.ValueSecretAvailable())
+		}
+	}
+	upToDate, err := ssm.IsUpToDate(cr.Spec.ForProvider, *p, *m, value)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errIsUpToDate)
+	}
+	add, remove := ssm.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        upToDate && len(add) == 0 && len(remove) == 0,
+		ResourceLateInitialized: !cmp.Equal(current, &cr.Spec.ForProvider),
+		ConnectionDetails: managed.ConnectionDetails{
+			v1alpha1.ResourceCredentialsSecretValueKey: []byte(aws.ToString(p.Value)),
+		},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+	value, err := e.getValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	_, err = e.client.PutParameter(ctx, ssm.GeneratePutParameterInput(meta.GetExternalName(cr), &cr.Spec.ForProvider, value))
+	return managed.ExternalCreation{}, awsclient.Wrap(err, errCreate)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+	name := meta.GetExternalName(cr)
+
+	p, m, err := e.describe(ctx, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	value, err := e.getValue(ctx, &cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	upToDate, err := ssm.IsUpToDate(cr.Spec.ForProvider, *p, *m, value)
+	if err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errIsUpToDate)
+	}
+	// NOTE: Every PutParameter call creates a new version of the parameter,
+	// so it is skipped if only the tags changed.
+	if !upToDate {
+		input := ssm.GeneratePutParameterInput(name, &cr.Spec.ForProvider, value)
+		input.Overwrite = aws.Bool(true)
+		// Tags cannot be given when overwriting a parameter.
+		input.Tags = nil
+		if _, err := e.client.PutParameter(ctx, input); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errUpdate)
+		}
+	}
+
+	tags, err := e.client.ListTagsForResource(ctx, &awsssm.ListTagsForResourceInput{
+		ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+		ResourceId:   aws.String(name),
+	})
+	if err != nil {
+		return managed.ExternalUpdate{}, awsclient.Wrap(err, errListTags)
+	}
+	add, remove := ssm.DiffTags(cr.Spec.ForProvider.Tags, tags.TagList)
+	if len(remove) != 0 {
+		if _, err := e.client.RemoveTagsFromResource(ctx, &awsssm.RemoveTagsFromResourceInput{
+			ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+			ResourceId:   aws.String(name),
+			TagKeys:      remove,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errRemoveTags)
+		}
+	}
+	if len(add) != 0 {
+		if _, err := e.client.AddTagsToResource(ctx, &awsssm.AddTagsToResourceInput{
+			ResourceType: ssmtypes.ResourceTypeForTaggingParameter,
+			ResourceId:   aws.String(name),
+			Tags:         add,
+		}); err != nil {
+			return managed.ExternalUpdate{}, awsclient.Wrap(err, errAddTags)
+		}
+	}
+	return managed.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Parameter)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	_, err := e.client.DeleteParameter(ctx, &awsssm.DeleteParameterInput{
+		Name: aws.String(meta.GetExternalName(cr)),
+	})
+	return awsclient.Wrap(resource.Ignore(ssm.IsParameterNotFound, err), errDelete)
+}
+
+// describe returns the decrypted parameter together with its metadata.
+func (e *external) describe(ctx context.Context, name string) (*ssmtypes.Parameter, *ssmtypes.ParameterMetadata, error) {
+	res, err := e.client.GetParameter(ctx, &awsssm.GetParameterInput{
+		Name:           aws.String(name),
+		WithDecryption: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errGet)
+	}
+	desc, err := e.client.DescribeParameters(ctx, &awsssm.DescribeParametersInput{
+		ParameterFilters: []ssmtypes.ParameterStringFilter{{
+			Key:    aws.String("Name"),
+			Option: aws.String("Equals"),
+			Values: []string{name},
+		}},
+	})
+	if err != nil {
+		return nil, nil, awsclient.Wrap(err, errDescribe)
+	}
+	if len(desc.Parameters) == 0 {
+		return nil, nil, awsclient.Wrap(&ssmtypes.ParameterNotFound{}, errDescribe)
+	}
+	return res.Parameter, &desc.Parameters[0], nil
+}
+
+// getValue returns the value of the parameter from the referenced Kubernetes
+// secret.
+func (e *external) getValue(ctx context.Context, p *v1alpha1.ParameterParameters) (string, error) {
+	ref := p.ValueSecretRef
+	sc := &corev1.Secret{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}, sc); err != nil {
+		return "", errors.Wrap(err, errGetValueSecret)
+	}
+	if ref.Key != nil {
+		val, ok := sc.Data[aws.ToString(ref.Key)]
+		if !ok {
+			return "", errors.Errorf(errFmtKeyNotFound, aws.ToString(ref.Key))
+		}
+		return string(val), nil
+	}
+	d := map[string]string{}
+	for k, v := range sc.Data {
+		d[k] = string(v)
+	}
+	payload, err := json.Marshal(d)
+	if err != nil {
+		return "", errors.Wrap(err, errMarshalValue)
+	}
+	return string(payload), nil
+}
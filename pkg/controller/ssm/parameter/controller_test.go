@@ -0,0 +1,566 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parameter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsssm "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/ssm/v1alpha1"
+	awsclient "github.com/crossplane-contrib/provider-aws/pkg/clients"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ssm"
+	"github.com/crossplane-contrib/provider-aws/pkg/clients/ssm/fake"
+)
+
+var (
+	// an arbitrary managed resource
+	unexpectedItem resource.Managed
+	parameterName  = "/app/db/password"
+	parameterARN   = "arn:aws:ssm:us-east-1:123456789012:parameter/app/db/password"
+	value          = "s3cr3t"
+	errBoom        = errors.New("boom")
+)
+
+type args struct {
+	ssm  ssm.ParameterClient
+	kube client.Client
+	cr   resource.Managed
+}
+
+type parameterModifier func(*v1alpha1.Parameter)
+
+func withExternalName(s string) parameterModifier {
+	return func(r *v1alpha1.Parameter) { meta.SetExternalName(r, s) }
+}
+
+func withTier(s string) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Spec.ForProvider.Tier = &s }
+}
+
+func withTags(t ...v1alpha1.Tag) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Spec.ForProvider.Tags = t }
+}
+
+func withObservation(o v1alpha1.ParameterObservation) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Status.AtProvider = o }
+}
+
+func withDeletionTimestamp() parameterModifier {
+	return func(r *v1alpha1.Parameter) {
+		t := metav1.NewTime(time.Unix(0, 0))
+		r.SetDeletionTimestamp(&t)
+	}
+}
+
+func withConditions(c ...xpv1.Condition) parameterModifier {
+	return func(r *v1alpha1.Parameter) { r.Status.ConditionedStatus.Conditions = c }
+}
+
+func parameter(m ...parameterModifier) *v1alpha1.Parameter {
+	cr := &v1alpha1.Parameter{
+		Spec: v1alpha1.ParameterSpec{
+			ForProvider: v1alpha1.ParameterParameters{
+				Type: v1alpha1.ParameterTypeString,
+				ValueSecretRef: v1alpha1.SecretReference{
+					Name:      "db",
+					Namespace: "default",
+					Key:       aws.String("password"),
+				},
+			},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func mockGetSecret(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+	s := obj.(*corev1.Secret)
+	s.Data = map[string][]byte{"password": []byte(value)}
+	return nil
+}
+
+func mockGetParameter(v string) func(context.Context, *awsssm.GetParameterInput, []func(*awsssm.Options)) (*awsssm.GetParameterOutput, error) {
+	return func(context.Context, *awsssm.GetParameterInput, []func(*awsssm.Options)) (*awsssm.GetParameterOutput, error) {
+		return &awsssm.GetParameterOutput{Parameter: &ssmtypes.Parameter{
+			ARN:     aws.String(parameterARN),
+			Type:    ssmtypes.ParameterTypeString,
+			Value:   aws.String(v),
+			Version: 1,
+		}}, nil
+	}
+}
+
+func mockDescribeParameters(context.Context, *awsssm.DescribeParametersInput, []func(*awsssm.Options)) (*awsssm.DescribeParametersOutput, error) {
+	return &awsssm.DescribeParametersOutput{Parameters: []ssmtypes.ParameterMetadata{{
+		Name:     aws.String(parameterName),
+		Type:     ssmtypes.ParameterTypeString,
+		Tier:     ssmtypes.ParameterTierStandard,
+		DataType: aws.String("text"),
+		Version:  1,
+	}}}, nil
+}
+
+func mockListTags(tags ...ssmtypes.Tag) func(context.Context, *awsssm.ListTagsForResourceInput, []func(*awsssm.Options)) (*awsssm.ListTagsForResourceOutput, error) {
+	return func(context.Context, *awsssm.ListTagsForResourceInput, []func(*awsssm.Options)) (*awsssm.ListTagsForResourceOutput, error) {
+		return &awsssm.ListTagsForResourceOutput{TagList: tags}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		cr     resource.Managed
+		result managed.ExternalObservation
+		err    error
+	}
+
+	observation := v1alpha1.ParameterObservation{ARN: parameterARN, Version: 1, Tier: v1alpha1.ParameterTierStandard}
+	lateInitialized := func(r *v1alpha1.Parameter) {
+		r.Spec.ForProvider.Tier = aws.String(v1alpha1.ParameterTierStandard)
+		r.Spec.ForProvider.DataType = aws.String("text")
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"InValidInput": {
+			args: args{
+				cr: unexpectedItem,
+			},
+			want: want{
+				cr:  unexpectedItem,
+				err: errors.New(errUnexpectedObject),
+			},
+		},
+		"NotFound": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockGetParameter: func(context.Context, *awsssm.GetParameterInput, []func(*awsssm.Options)) (*awsssm.GetParameterOutput, error) {
+						return nil, &ssmtypes.ParameterNotFound{}
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName)),
+			},
+		},
+		"GetFailed": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockGetParameter: func(context.Context, *awsssm.GetParameterInput, []func(*awsssm.Options)) (*awsssm.GetParameterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr:  parameter(withExternalName(parameterName)),
+				err: errors.Wrap(errBoom, errGet),
+			},
+		},
+		"UpToDate": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:        mockGetParameter(value),
+					MockDescribeParameters:  mockDescribeParameters,
+					MockListTagsForResource: mockListTags(ssmtypes.Tag{Key: aws.String("team"), Value: aws.String("a")}),
+				},
+				cr: parameter(withExternalName(parameterName), withTier(v1alpha1.ParameterTierStandard), withTags(v1alpha1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), withTier(v1alpha1.ParameterTierStandard), withTags(v1alpha1.Tag{Key: "team", Value: "a"}),
+					lateInitialized, withObservation(observation), withConditions(xpv1.Available(), ssm.ValueSecretAvailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretValueKey: []byte(value),
+					},
+				},
+			},
+		},
+		"ValueChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:        mockGetParameter("old"),
+					MockDescribeParameters:  mockDescribeParameters,
+					MockListTagsForResource: mockListTags(),
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), lateInitialized, withObservation(observation), withConditions(xpv1.Available(), ssm.ValueSecretAvailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretValueKey: []byte("old"),
+					},
+				},
+			},
+		},
+		"TagsChanged": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:        mockGetParameter(value),
+					MockDescribeParameters:  mockDescribeParameters,
+					MockListTagsForResource: mockListTags(),
+				},
+				cr: parameter(withExternalName(parameterName), withTags(v1alpha1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), withTags(v1alpha1.Tag{Key: "team", Value: "a"}),
+					lateInitialized, withObservation(observation), withConditions(xpv1.Available(), ssm.ValueSecretAvailable())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        false,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretValueKey: []byte(value),
+					},
+				},
+			},
+		},
+		"SecretNotFound": {
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:        mockGetParameter(value),
+					MockDescribeParameters:  mockDescribeParameters,
+					MockListTagsForResource: mockListTags(),
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), lateInitialized, withObservation(observation),
+					withConditions(xpv1.Available(), ssm.ValueSecretUnavailable(errors.Wrap(errBoom, errGetValueSecret)))),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretValueKey: []byte(value),
+					},
+				},
+			},
+		},
+		"DeletedSkipsValue": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:        mockGetParameter(value),
+					MockDescribeParameters:  mockDescribeParameters,
+					MockListTagsForResource: mockListTags(),
+				},
+				cr: parameter(withExternalName(parameterName), withDeletionTimestamp()),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), withDeletionTimestamp(), lateInitialized, withObservation(observation),
+					withConditions(xpv1.Available())),
+				result: managed.ExternalObservation{
+					ResourceExists:          true,
+					ResourceUpToDate:        true,
+					ResourceLateInitialized: true,
+					ConnectionDetails: managed.ConnectionDetails{
+						v1alpha1.ResourceCredentialsSecretValueKey: []byte(value),
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ssm, kube: tc.kube}
+			o, err := e.Observe(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, o); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		input *awsssm.PutParameterInput
+		err   error
+	}
+
+	var got *awsssm.PutParameterInput
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockPutParameter: func(_ context.Context, in *awsssm.PutParameterInput, _ []func(*awsssm.Options)) (*awsssm.PutParameterOutput, error) {
+						got = in
+						return &awsssm.PutParameterOutput{Version: 1}, nil
+					},
+				},
+				cr: parameter(withExternalName(parameterName), withTags(v1alpha1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				input: &awsssm.PutParameterInput{
+					Name:  aws.String(parameterName),
+					Value: aws.String(value),
+					Type:  ssmtypes.ParameterTypeString,
+					Tags:  []ssmtypes.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				},
+			},
+		},
+		"FailedPut": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockPutParameter: func(_ context.Context, in *awsssm.PutParameterInput, _ []func(*awsssm.Options)) (*awsssm.PutParameterOutput, error) {
+						got = in
+						return nil, errBoom
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				input: &awsssm.PutParameterInput{
+					Name:  aws.String(parameterName),
+					Value: aws.String(value),
+					Type:  ssmtypes.ParameterTypeString,
+				},
+				err: errors.Wrap(errBoom, errCreate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got = nil
+			e := &external{client: tc.ssm, kube: tc.kube}
+			_, err := e.Create(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got, cmpopts.IgnoreUnexported(awsssm.PutParameterInput{}, ssmtypes.Tag{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		put    *awsssm.PutParameterInput
+		add    []ssmtypes.Tag
+		remove []string
+		err    error
+	}
+
+	var (
+		put    *awsssm.PutParameterInput
+		add    []ssmtypes.Tag
+		remove []string
+	)
+	client := func(v string, tags ...ssmtypes.Tag) *fake.MockParameterClient {
+		return &fake.MockParameterClient{
+			MockGetParameter:        mockGetParameter(v),
+			MockDescribeParameters:  mockDescribeParameters,
+			MockListTagsForResource: mockListTags(tags...),
+			MockPutParameter: func(_ context.Context, in *awsssm.PutParameterInput, _ []func(*awsssm.Options)) (*awsssm.PutParameterOutput, error) {
+				put = in
+				return &awsssm.PutParameterOutput{Version: 2}, nil
+			},
+			MockAddTagsToResource: func(_ context.Context, in *awsssm.AddTagsToResourceInput, _ []func(*awsssm.Options)) (*awsssm.AddTagsToResourceOutput, error) {
+				add = in.Tags
+				return &awsssm.AddTagsToResourceOutput{}, nil
+			},
+			MockRemoveTagsFromResource: func(_ context.Context, in *awsssm.RemoveTagsFromResourceInput, _ []func(*awsssm.Options)) (*awsssm.RemoveTagsFromResourceOutput, error) {
+				remove = in.TagKeys
+				return &awsssm.RemoveTagsFromResourceOutput{}, nil
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"OverwriteValue": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm:  client("old", ssmtypes.Tag{Key: aws.String("team"), Value: aws.String("a")}),
+				cr:   parameter(withExternalName(parameterName), withTags(v1alpha1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				put: &awsssm.PutParameterInput{
+					Name:      aws.String(parameterName),
+					Value:     aws.String(value),
+					Type:      ssmtypes.ParameterTypeString,
+					Overwrite: aws.Bool(true),
+				},
+			},
+		},
+		"OnlyTags": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm:  client(value, ssmtypes.Tag{Key: aws.String("old"), Value: aws.String("x")}),
+				cr:   parameter(withExternalName(parameterName), withTags(v1alpha1.Tag{Key: "team", Value: "a"})),
+			},
+			want: want{
+				add:    []ssmtypes.Tag{{Key: aws.String("team"), Value: aws.String("a")}},
+				remove: []string{"old"},
+			},
+		},
+		"FailedPut": {
+			args: args{
+				kube: &test.MockClient{MockGet: mockGetSecret},
+				ssm: &fake.MockParameterClient{
+					MockGetParameter:       mockGetParameter("old"),
+					MockDescribeParameters: mockDescribeParameters,
+					MockPutParameter: func(context.Context, *awsssm.PutParameterInput, []func(*awsssm.Options)) (*awsssm.PutParameterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				err: errors.Wrap(errBoom, errUpdate),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			put, add, remove = nil, nil, nil
+			e := &external{client: tc.ssm, kube: tc.kube}
+			_, err := e.Update(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.put, put, cmpopts.IgnoreUnexported(awsssm.PutParameterInput{})); diff != "" {
+				t.Errorf("put: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.add, add, cmpopts.IgnoreUnexported(ssmtypes.Tag{})); diff != "" {
+				t.Errorf("add: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.remove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  resource.Managed
+		err error
+	}
+
+	cases := map[string]struct {
+		args
+		want
+	}{
+		"Successful": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockDeleteParameter: func(context.Context, *awsssm.DeleteParameterInput, []func(*awsssm.Options)) (*awsssm.DeleteParameterOutput, error) {
+						return &awsssm.DeleteParameterOutput{}, nil
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"AlreadyDeleted": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockDeleteParameter: func(context.Context, *awsssm.DeleteParameterInput, []func(*awsssm.Options)) (*awsssm.DeleteParameterOutput, error) {
+						return nil, &ssmtypes.ParameterNotFound{}
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr: parameter(withExternalName(parameterName), withConditions(xpv1.Deleting())),
+			},
+		},
+		"Failed": {
+			args: args{
+				ssm: &fake.MockParameterClient{
+					MockDeleteParameter: func(context.Context, *awsssm.DeleteParameterInput, []func(*awsssm.Options)) (*awsssm.DeleteParameterOutput, error) {
+						return nil, errBoom
+					},
+				},
+				cr: parameter(withExternalName(parameterName)),
+			},
+			want: want{
+				cr:  parameter(withExternalName(parameterName), withConditions(xpv1.Deleting())),
+				err: awsclient.Wrap(errBoom, errDelete),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.ssm, kube: tc.kube}
+			err := e.Delete(context.Background(), tc.args.cr)
+
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.args.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -24,4 +24,9 @@ const (
 	// External Secret Stores. See the below design for more details.
 	// https://github.com/crossplane/crossplane/blob/390ddd/design/design-doc-external-secret-stores.md
 	EnableAlphaExternalSecretStores feature.Flag = "EnableAlphaExternalSecretStores"
+
+	// EnableAlphaCredentialProcess enables alpha support for the Process
+	// credentials source of ProviderConfigs, which runs an external
+	// credential_process command in the provider container.
+	EnableAlphaCredentialProcess feature.Flag = "EnableAlphaCredentialProcess"
 )
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+const errGetProviderConfig = "cannot get referenced ProviderConfig"
+
+// ExternalConnecter wraps the supplied ExternalConnecter of the controller
+// with the supplied name with the behaviour that is common to all AWS
+// controllers:
+//
+// - Requests made with the reconcile-requested-at annotation are recorded in
+// the ReconcileStatus of the managed resource once it was observed.
+//
+// - Drift of the external resource is reported in the Drifted condition and
+// in events, and only corrected if the drift action of the managed resource
+// is DriftActionCorrect.
+//
+// - External resources of managed resources that use a read-only
+// ProviderConfig are only observed. They are never created, updated or
+// deleted, and are orphaned when the managed resource is deleted.
+func (o Options) ExternalConnecter(mgr ctrl.Manager, name string, c managed.ExternalConnecter) managed.ExternalConnecter {
+	return &connecter{
+		ExternalConnecter: c,
+		// The ProviderConfig is read from the informer cache, so that checking
+		// whether it is read-only does not add a request to the API server
+		// to every Connect.
+		kube:        mgr.GetCache(),
+		record:      event.NewAPIRecorder(mgr.GetEventRecorderFor(name)),
+		driftAction: o.DriftAction,
+	}
+}
+
+type connecter struct {
+	managed.ExternalConnecter
+	kube        client.Reader
+	record      event.Recorder
+	driftAction DriftAction
+}
+
+func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	readOnly, err := c.isReadOnly(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	ec, err := c.ExternalConnecter.Connect(ctx, mg)
+	if err != nil {
+		return nil, err
+	}
+	return &external{ExternalClient: ec, record: c.record, driftAction: c.driftAction, readOnly: readOnly}, nil
+}
+
+// isReadOnly returns true if the supplied managed resource uses a read-only
+// ProviderConfig.
+func (c *connecter) isReadOnly(ctx context.Context, mg resource.Managed) (bool, error) {
+	ref := mg.GetProviderConfigReference()
+	if ref == nil {
+		return false, nil
+	}
+	pc := &v1beta1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: ref.Name}, pc); err != nil {
+		return false, errors.Wrap(err, errGetProviderConfig)
+	}
+	return pc.Spec.ReadOnly, nil
+}
+
+type external struct {
+	managed.ExternalClient
+	record      event.Recorder
+	driftAction DriftAction
+	readOnly    bool
+}
+
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if e.readOnly {
+		return e.observeReadOnly(ctx, mg)
+	}
+	resetReadOnly(mg)
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	recordRequest(mg)
+	if err != nil || !obs.ResourceExists || meta.WasDeleted(mg) {
+		return obs, err
+	}
+	return e.handleDrift(mg, obs), nil
+}
+
+func (e *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if e.readOnly {
+		return managed.ExternalCreation{}, errors.Errorf(errReadOnly, mg.GetProviderConfigReference().Name, "create")
+	}
+	return e.ExternalClient.Create(ctx, mg)
+}
+
+func (e *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if e.readOnly {
+		return managed.ExternalUpdate{}, errors.Errorf(errReadOnly, mg.GetProviderConfigReference().Name, "update")
+	}
+	return e.ExternalClient.Update(ctx, mg)
+}
+
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	if e.readOnly {
+		return errors.Errorf(errReadOnly, mg.GetProviderConfigReference().Name, "delete")
+	}
+	return e.ExternalClient.Delete(ctx, mg)
+}
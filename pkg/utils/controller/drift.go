@@ -0,0 +1,215 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	awsclients "github.com/crossplane-contrib/provider-aws/pkg/clients"
+)
+
+// AnnotationKeyDriftAction is the annotation that configures the DriftAction
+// of a managed resource. It overrides the drift action of the provider.
+const AnnotationKeyDriftAction = "aws.crossplane.io/drift-action"
+
+// A DriftAction determines what happens if an external resource differs from
+// the desired state of its managed resource.
+type DriftAction string
+
+// Drift actions.
+const (
+	// DriftActionCorrect updates the external resource to match the desired
+	// state. This is the default.
+	DriftActionCorrect DriftAction = "Correct"
+
+	// DriftActionReport only reports the drift in the Drifted condition and
+	// in events. Changes of the desired state are still applied.
+	DriftActionReport DriftAction = "Report"
+)
+
+// TypeDrifted is the type of the condition that indicates whether an external
+// resource differs from the desired state of its managed resource.
+const TypeDrifted xpv1.ConditionType = "Drifted"
+
+// Reasons the external resource did or did not drift.
+const (
+	ReasonDriftDetected xpv1.ConditionReason = "DriftDetected"
+	ReasonNoDrift       xpv1.ConditionReason = "NoDrift"
+)
+
+const (
+	reasonDriftDetected event.Reason = "DriftDetected"
+	reasonCorrectDrift  event.Reason = "CorrectingDrift"
+	reasonDriftResolved event.Reason = "DriftResolved"
+
+	msgUnknownDrift = "The external resource differs from the desired state of the managed resource."
+
+	// maxDriftLength is the maximum length of the drift in conditions and
+	// events. The full drift is logged at debug level by the managed
+	// reconciler.
+	maxDriftLength = 1024
+)
+
+// DriftDetected returns a condition that indicates that the external resource
+// differs from the desired state of its managed resource as described by the
+// supplied diff.
+func DriftDetected(diff string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDriftDetected,
+		Message:            diff,
+	}
+}
+
+// NoDrift returns a condition that indicates that the external resource
+// matches the desired state of its managed resource.
+func NoDrift() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDrifted,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNoDrift,
+	}
+}
+
+// driftActionOf returns the drift action of the supplied managed resource, or
+// the supplied default if it has none.
+func driftActionOf(mg resource.Managed, def DriftAction) DriftAction {
+	if a, ok := mg.GetAnnotations()[AnnotationKeyDriftAction]; ok {
+		return DriftAction(a)
+	}
+	if def == "" {
+		return DriftActionCorrect
+	}
+	return def
+}
+
+// RecordDrift records the supplied drift of the external resource in the
+// status of the supplied managed resource, and returns it formatted for the
+// Diff of an ExternalObservation. Controllers that know which fields of the
+// external resource drifted call it in Observe. The drift of all other
+// managed resources is reported without the fields that drifted.
+func RecordDrift(mg resource.Managed, drift []v1alpha1.FieldDrift) string {
+	if s := reconcileStatusOf(mg); s != nil {
+		s.Drift = drift
+	}
+	return awsclients.FormatDrift(drift)
+}
+
+// isSynced returns true if the desired state of the supplied managed resource
+// did not change since its external resource was last observed to be up to
+// date. Managed resources that were ready before their synced generation was
+// recorded are considered synced.
+func isSynced(mg resource.Managed) bool {
+	s := reconcileStatusOf(mg)
+	if s == nil {
+		return true
+	}
+	if s.SyncedGeneration == 0 && mg.GetCondition(xpv1.TypeReady).Status == corev1.ConditionTrue {
+		s.SyncedGeneration = mg.GetGeneration()
+	}
+	return s.SyncedGeneration == mg.GetGeneration()
+}
+
+// setSynced records that the external resource of the supplied managed
+// resource is up to date with its desired state.
+func setSynced(mg resource.Managed) {
+	if s := reconcileStatusOf(mg); s != nil {
+		s.SyncedGeneration = mg.GetGeneration()
+		s.Drift = nil
+	}
+}
+
+// resetDrift removes the drift recorded during the observation of the
+// supplied managed resource.
+func resetDrift(mg resource.Managed) {
+	if s := reconcileStatusOf(mg); s != nil {
+		s.Drift = nil
+	}
+}
+
+// handleDrift reports the drift of the external resource described by the
+// supplied observation. An external resource that is not up to date only
+// drifted if the desired state of its managed resource did not change since
+// it was last up to date. Otherwise the managed reconciler updates it to the
+// new desired state, or finishes creating it. If the drift action of the
+// managed resource is DriftActionReport, or its ProviderConfig is read-only,
+// the returned observation of drift claims that the external resource is up
+// to date, so that the managed reconciler does not update it.
+func (e *external) handleDrift(mg resource.Managed, obs managed.ExternalObservation) managed.ExternalObservation {
+	if obs.ResourceUpToDate {
+		setSynced(mg)
+		// Only resources that drifted before have a Drifted condition.
+		if mg.GetCondition(TypeDrifted).Status == corev1.ConditionTrue {
+			mg.SetConditions(NoDrift())
+			e.record.Event(mg, event.Normal(reasonDriftResolved, "The external resource matches the desired state of the managed resource."))
+		}
+		return obs
+	}
+
+	// The desired state of managed resources with a read-only
+	// ProviderConfig is never applied, so changes of it are reported like
+	// drift.
+	if !e.readOnly && !isSynced(mg) {
+		resetDrift(mg)
+		return obs
+	}
+
+	diff := obs.Diff
+	if diff == "" {
+		diff = msgUnknownDrift
+	}
+	diff = truncateDrift(diff)
+
+	if !e.readOnly && driftActionOf(mg, e.driftAction) != DriftActionReport {
+		e.record.Event(mg, event.Normal(reasonCorrectDrift, diff))
+		return obs
+	}
+
+	// Only report new drift, not every observation of the same drift.
+	if c := DriftDetected(diff); !mg.GetCondition(TypeDrifted).Equal(c) {
+		mg.SetConditions(c)
+		e.record.Event(mg, event.Warning(reasonDriftDetected, errors.New(diff)))
+	}
+	obs.ResourceUpToDate = true
+	return obs
+}
+
+// truncateDrift shortens the supplied drift to maxDriftLength bytes, without
+// cutting a multi-byte rune in half.
+func truncateDrift(diff string) string {
+	if len(diff) <= maxDriftLength {
+		return diff
+	}
+	n := maxDriftLength
+	for n > 0 && !utf8.RuneStart(diff[n]) {
+		n--
+	}
+	return diff[:n] + "..."
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+)
+
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) { r.events = append(r.events, e) }
+
+func (r *recorder) WithAnnotations(_ ...string) event.Recorder { return r }
+
+func TestObserveDrift(t *testing.T) {
+	errBoom := errors.New("boom")
+	report := map[string]string{AnnotationKeyDriftAction: string(DriftActionReport)}
+
+	type args struct {
+		driftAction DriftAction
+		annotations map[string]string
+		conditions  []xpv1.Condition
+		generation  int64
+		status      v1alpha1.ReconcileStatus
+		deleted     bool
+		obs         managed.ExternalObservation
+		err         error
+	}
+	type want struct {
+		obs        managed.ExternalObservation
+		err        error
+		conditions []xpv1.Condition
+		status     v1alpha1.ReconcileStatus
+		events     []event.Event
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UpToDate": {
+			reason: "Nothing should be reported if the external resource is up to date.",
+			args: args{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"DriftResolved": {
+			reason: "The Drifted condition should be reset once the external resource is up to date.",
+			args: args{
+				conditions: []xpv1.Condition{DriftDetected("diff")},
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{NoDrift()},
+				events:     []event.Event{event.Normal(reasonDriftResolved, "The external resource matches the desired state of the managed resource.")},
+			},
+		},
+		"Correct": {
+			reason: "Drift should be reported in an event and corrected by default.",
+			args: args{
+				obs: managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+				events: []event.Event{event.Normal(reasonCorrectDrift, "diff")},
+			},
+		},
+		"Report": {
+			reason: "Drift should be reported but not corrected if the drift action of the managed resource is Report.",
+			args: args{
+				annotations: report,
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "diff"},
+				conditions: []xpv1.Condition{DriftDetected("diff")},
+				events:     []event.Event{event.Warning(reasonDriftDetected, errors.New("diff"))},
+			},
+		},
+		"ReportByDefault": {
+			reason: "The drift action of the provider should be used if the managed resource has none.",
+			args: args{
+				driftAction: DriftActionReport,
+				obs:         managed.ExternalObservation{ResourceExists: true},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				conditions: []xpv1.Condition{DriftDetected(msgUnknownDrift)},
+				events:     []event.Event{event.Warning(reasonDriftDetected, errors.New(msgUnknownDrift))},
+			},
+		},
+		"CorrectOverridesDefault": {
+			reason: "The drift action of the managed resource should override the one of the provider.",
+			args: args{
+				driftAction: DriftActionReport,
+				annotations: map[string]string{AnnotationKeyDriftAction: string(DriftActionCorrect)},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+				events: []event.Event{event.Normal(reasonCorrectDrift, "diff")},
+			},
+		},
+		"AlreadyReported": {
+			reason: "Drift that was already reported should not be reported again.",
+			args: args{
+				annotations: report,
+				conditions:  []xpv1.Condition{DriftDetected("diff")},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "diff"},
+				conditions: []xpv1.Condition{DriftDetected("diff")},
+			},
+		},
+		"Synced": {
+			reason: "The generation the external resource is up to date with should be recorded, and its drift removed.",
+			args: args{
+				generation: 2,
+				status:     v1alpha1.ReconcileStatus{SyncedGeneration: 1, Drift: []v1alpha1.FieldDrift{{Path: "Name"}}},
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				status: v1alpha1.ReconcileStatus{SyncedGeneration: 2},
+			},
+		},
+		"ReportSpecChanged": {
+			reason: "Changes of the desired state should be applied and not reported as drift if the drift action is Report.",
+			args: args{
+				annotations: report,
+				generation:  2,
+				status:      v1alpha1.ReconcileStatus{SyncedGeneration: 1, Drift: []v1alpha1.FieldDrift{{Path: "Name"}}},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:    managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+				status: v1alpha1.ReconcileStatus{SyncedGeneration: 1},
+			},
+		},
+		"Creating": {
+			reason: "External resources that were never up to date should not be reported as drifted.",
+			args: args{
+				generation: 1,
+				obs:        managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+		},
+		"ReadyBeforeUpgrade": {
+			reason: "Ready managed resources without a synced generation should be considered synced.",
+			args: args{
+				annotations: report,
+				generation:  3,
+				conditions:  []xpv1.Condition{xpv1.Available()},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "diff"},
+				conditions: []xpv1.Condition{xpv1.Available(), DriftDetected("diff")},
+				status:     v1alpha1.ReconcileStatus{SyncedGeneration: 3},
+				events:     []event.Event{event.Warning(reasonDriftDetected, errors.New("diff"))},
+			},
+		},
+		"RecordedDrift": {
+			reason: "Drift recorded by the controller should be kept if the external resource drifted.",
+			args: args{
+				annotations: report,
+				generation:  1,
+				status:      v1alpha1.ReconcileStatus{SyncedGeneration: 1, Drift: []v1alpha1.FieldDrift{{Path: "Name"}}},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "diff"},
+				conditions: []xpv1.Condition{DriftDetected("diff")},
+				status:     v1alpha1.ReconcileStatus{SyncedGeneration: 1, Drift: []v1alpha1.FieldDrift{{Path: "Name"}}},
+				events:     []event.Event{event.Warning(reasonDriftDetected, errors.New("diff"))},
+			},
+		},
+		"NotExists": {
+			reason: "Drift should not be reported if the external resource does not exist.",
+			args: args{
+				annotations: report,
+			},
+			want: want{},
+		},
+		"Deleted": {
+			reason: "Drift should not be reported if the managed resource is being deleted.",
+			args: args{
+				annotations: report,
+				deleted:     true,
+				obs:         managed.ExternalObservation{ResourceExists: true},
+			},
+			want: want{
+				obs: managed.ExternalObservation{ResourceExists: true},
+			},
+		},
+		"ObserveFailed": {
+			reason: "Drift should not be reported if the observation failed.",
+			args: args{
+				annotations: report,
+				err:         errBoom,
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &reconcileManaged{Status: reconcileManagedStatus{ReconcileStatus: tc.args.status}}
+			mg.SetAnnotations(tc.args.annotations)
+			mg.SetConditions(tc.args.conditions...)
+			mg.SetGeneration(tc.args.generation)
+			if tc.args.deleted {
+				now := metav1.Now()
+				mg.SetDeletionTimestamp(&now)
+			}
+			r := &recorder{}
+			e := &external{
+				ExternalClient: managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return tc.args.obs, tc.args.err
+					},
+				},
+				record:      r,
+				driftAction: tc.args.driftAction,
+			}
+
+			obs, err := e.Observe(context.TODO(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, mg.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, mg.Status.ReconcileStatus); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want status, +got status:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRecordDrift(t *testing.T) {
+	drift := []v1alpha1.FieldDrift{{Path: "Name", Desired: `"a"`, Observed: `"b"`}}
+	mg := &reconcileManaged{}
+	if got, want := RecordDrift(mg, drift), `Name: desired "a", observed "b"`; got != want {
+		t.Errorf("RecordDrift(...): want %q, got %q", want, got)
+	}
+	if diff := cmp.Diff(drift, mg.Status.Drift); diff != "" {
+		t.Errorf("RecordDrift(...): -want drift, +got drift:\n%s", diff)
+	}
+}
+
+func TestTruncateDrift(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		diff   string
+		want   string
+	}{
+		"Short": {
+			reason: "Drift that is not too long should not be truncated.",
+			diff:   "Name",
+			want:   "Name",
+		},
+		"Long": {
+			reason: "Drift that is too long should be truncated to maxDriftLength.",
+			diff:   strings.Repeat("a", maxDriftLength+1),
+			want:   strings.Repeat("a", maxDriftLength) + "...",
+		},
+		"MultiByteRune": {
+			reason: "Drift should not be truncated in the middle of a multi-byte rune.",
+			diff:   strings.Repeat("a", maxDriftLength-1) + "é",
+			want:   strings.Repeat("a", maxDriftLength-1) + "...",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, truncateDrift(tc.diff)); diff != "" {
+				t.Errorf("\n%s\ntruncateDrift(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
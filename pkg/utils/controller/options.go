@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package controller contains the options the AWS controllers are set up
+// with.
+package controller
+
+import (
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
+)
+
+// AllControllers matches all controllers in UpdateFilterOptions.
+const AllControllers = "*"
+
+// DefaultUpdateFilterControllers are the controllers that filter update
+// events by default.
+var DefaultUpdateFilterControllers = []string{
+	"securitygroup.ec2.aws.crossplane.io",
+	"rdsinstance.database.aws.crossplane.io",
+	"repositorypolicy.ecr.aws.crossplane.io",
+	"key.kms.aws.crossplane.io",
+	"secret.secretsmanager.aws.crossplane.io",
+}
+
+// Options configure the AWS controllers. They extend the options that are
+// common to all Crossplane controllers.
+type Options struct {
+	controller.Options
+
+	// UpdateFilter configures which update events of managed resources
+	// are filtered.
+	UpdateFilter UpdateFilterOptions
+
+	// PollIntervals override the PollInterval of the controllers of the
+	// kinds they are keyed by, e.g. bucket.s3.aws.crossplane.io. Ready and
+	// synced managed resources of these kinds are polled at the same
+	// interval.
+	PollIntervals map[string]time.Duration
+
+	// DriftAction is the drift action of managed resources that do not
+	// configure one with the AnnotationKeyDriftAction annotation.
+	DriftAction DriftAction
+}
+
+// UpdateFilterOptions configure which update events of managed resources are
+// filtered. See filter.NewUpdateFilter.
+type UpdateFilterOptions struct {
+	// Controllers that filter update events, identified by the lower case
+	// kind and group of the managed resources they reconcile, e.g.
+	// securitygroup.ec2.aws.crossplane.io, or AllControllers.
+	Controllers []string
+
+	// Annotations whose changes are never filtered.
+	Annotations []string
+}
+
+// EventFilter returns the predicate that filters the events of the
+// controller with the supplied name.
+func (o Options) EventFilter(name string) predicate.Predicate {
+	if !o.UpdateFilter.Enabled(name) {
+		return predicate.Funcs{}
+	}
+	log := logging.NewNopLogger()
+	if o.Logger != nil {
+		log = o.Logger.WithValues("controller", name)
+	}
+	return filter.NewUpdateFilter(
+		filter.WithLogger(log),
+		filter.WithAnnotations(AnnotationKeyPollInterval),
+		filter.WithAnnotations(o.UpdateFilter.Annotations...),
+	)
+}
+
+// Enabled returns true if the controller with the supplied name filters
+// update events.
+func (o UpdateFilterOptions) Enabled(name string) bool {
+	for _, c := range o.Controllers {
+		if c == AllControllers || isController(name, c) {
+			return true
+		}
+	}
+	return false
+}
+
+// WithPollInterval returns the ReconcilerOption that configures the poll
+// interval of the controller with the supplied name.
+func (o Options) WithPollInterval(name string) managed.ReconcilerOption {
+	d, override := o.pollInterval(name)
+	if !override {
+		return managed.WithPollInterval(d)
+	}
+	return func(r *managed.Reconciler) {
+		managed.WithPollInterval(d)(r)
+		managed.WithStablePollInterval(d)(r)
+	}
+}
+
+// WithMaxPollInterval returns the ReconcilerOption that configures the poll
+// interval of the controller with the supplied name like WithPollInterval, but
+// polls all managed resources, including ready and synced ones, at least
+// at the supplied interval.
+func (o Options) WithMaxPollInterval(name string, max time.Duration) managed.ReconcilerOption {
+	d, override := o.pollInterval(name)
+	if d > max {
+		d = max
+	}
+	stable := max
+	if override {
+		stable = d
+	}
+	return func(r *managed.Reconciler) {
+		managed.WithPollInterval(d)(r)
+		managed.WithStablePollInterval(stable)(r)
+	}
+}
+
+// pollInterval returns the poll interval of the controller with the supplied
+// name, and whether it is overridden by PollIntervals.
+func (o Options) pollInterval(name string) (time.Duration, bool) {
+	for kind, d := range o.PollIntervals {
+		if isController(name, kind) {
+			return d, true
+		}
+	}
+	return o.PollInterval, false
+}
+
+// isController returns true if the supplied controller name, e.g.
+// managed/securitygroup.ec2.aws.crossplane.io, is the one of the supplied
+// kind, e.g. securitygroup.ec2.aws.crossplane.io.
+func isController(name, kind string) bool {
+	return strings.EqualFold(strings.TrimPrefix(name, "managed/"), kind)
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+)
+
+func TestUpdateFilterOptionsEnabled(t *testing.T) {
+	name := managed.ControllerName("SecurityGroup.ec2.aws.crossplane.io")
+
+	cases := map[string]struct {
+		reason string
+		o      UpdateFilterOptions
+		want   bool
+	}{
+		"NoControllers": {
+			reason: "No controller should filter update events by default.",
+			want:   false,
+		},
+		"Listed": {
+			reason: "A listed controller should filter update events.",
+			o:      UpdateFilterOptions{Controllers: []string{"securitygroup.ec2.aws.crossplane.io"}},
+			want:   true,
+		},
+		"NotListed": {
+			reason: "A controller that is not listed should not filter update events.",
+			o:      UpdateFilterOptions{Controllers: []string{"bucket.s3.aws.crossplane.io"}},
+			want:   false,
+		},
+		"All": {
+			reason: "All controllers should filter update events if AllControllers is listed.",
+			o:      UpdateFilterOptions{Controllers: []string{AllControllers}},
+			want:   true,
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			if got := tc.o.Enabled(name); got != tc.want {
+				t.Errorf("\n%s\nEnabled(...): want %t, got %t", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	mg := func() *fake.Managed {
+		mg := &fake.Managed{}
+		mg.SetConditions(xpv1.Available(), xpv1.ReconcileSuccess())
+		return mg
+	}
+	e := event.UpdateEvent{ObjectOld: mg(), ObjectNew: mg()}
+
+	o := Options{UpdateFilter: UpdateFilterOptions{Controllers: []string{"bucket.s3.aws.crossplane.io"}}}
+	if o.EventFilter("managed/bucket.s3.aws.crossplane.io").Update(e) {
+		t.Errorf("EventFilter(...): want update event of an enabled controller to be filtered")
+	}
+	if !o.EventFilter("managed/queue.sqs.aws.crossplane.io").Update(e) {
+		t.Errorf("EventFilter(...): want update event of a disabled controller to pass")
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	type want struct {
+		d        time.Duration
+		override bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		o      Options
+		want   want
+	}{
+		"Default": {
+			reason: "The poll interval of all controllers should be used if the kind is not overridden.",
+			o: Options{
+				Options:       controller.Options{PollInterval: time.Minute},
+				PollIntervals: map[string]time.Duration{"bucket.s3.aws.crossplane.io": time.Hour},
+			},
+			want: want{d: time.Minute},
+		},
+		"Override": {
+			reason: "The poll interval of the kind should be used if it is overridden.",
+			o: Options{
+				Options:       controller.Options{PollInterval: time.Minute},
+				PollIntervals: map[string]time.Duration{"SecurityGroup.ec2.aws.crossplane.io": 30 * time.Second},
+			},
+			want: want{d: 30 * time.Second, override: true},
+		},
+	}
+
+	for n, tc := range cases {
+		t.Run(n, func(t *testing.T) {
+			d, override := tc.o.pollInterval("managed/securitygroup.ec2.aws.crossplane.io")
+			if d != tc.want.d || override != tc.want.override {
+				t.Errorf("\n%s\npollInterval(...): want %s, %t, got %s, %t", tc.reason, tc.want.d, tc.want.override, d, override)
+			}
+		})
+	}
+}
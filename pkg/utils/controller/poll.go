@@ -0,0 +1,144 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// AnnotationKeyPollInterval is the annotation that configures the poll
+// interval of a managed resource, e.g. 1m or 1h. It overrides the poll
+// interval of its controller, including the one of ready and synced
+// managed resources, within MinPollInterval and the maximum poll interval of
+// the controller, if it has one.
+const AnnotationKeyPollInterval = "aws.crossplane.io/poll-interval"
+
+// MinPollInterval is the shortest poll interval that can be configured with
+// the AnnotationKeyPollInterval annotation, so that AWS is not polled too
+// often.
+const MinPollInterval = 30 * time.Second
+
+// NewReconciler returns a managed reconciler of the supplied kind that is
+// configured with the supplied options. Managed resources that are polled are
+// polled at the interval of their AnnotationKeyPollInterval annotation
+// instead, if they have one.
+func (o Options) NewReconciler(mgr ctrl.Manager, of resource.ManagedKind, opts ...managed.ReconcilerOption) reconcile.Reconciler {
+	return o.newReconciler(mgr, of, 0, opts...)
+}
+
+// NewReconcilerWithMaxPollInterval returns a managed reconciler like
+// NewReconciler that polls all managed resources, including ready and synced
+// ones, at least at the supplied interval, see WithMaxPollInterval. Longer
+// intervals configured by the AnnotationKeyPollInterval annotation are
+// shortened to it.
+func (o Options) NewReconcilerWithMaxPollInterval(mgr ctrl.Manager, of resource.ManagedKind, max time.Duration, opts ...managed.ReconcilerOption) reconcile.Reconciler {
+	name := managed.ControllerName(schema.GroupVersionKind(of).GroupKind().String())
+	return o.newReconciler(mgr, of, max, append(opts, o.WithMaxPollInterval(name, max))...)
+}
+
+func (o Options) newReconciler(mgr ctrl.Manager, of resource.ManagedKind, max time.Duration, opts ...managed.ReconcilerOption) reconcile.Reconciler {
+	log := logging.NewNopLogger()
+	if o.Logger != nil {
+		log = o.Logger.WithValues("controller", managed.ControllerName(schema.GroupVersionKind(of).GroupKind().String()))
+	}
+	return &pollIntervalReconciler{
+		Reconciler: managed.NewReconciler(mgr, of, opts...),
+		// The managed resource is read from the informer cache, which the
+		// managed reconciler has just read it from as well.
+		kube: mgr.GetCache(),
+		newManaged: func() (resource.Managed, error) {
+			ro, err := mgr.GetScheme().New(schema.GroupVersionKind(of))
+			if err != nil {
+				return nil, err
+			}
+			mg, ok := ro.(resource.Managed)
+			if !ok {
+				return nil, errors.Errorf("%s is not a managed resource", schema.GroupVersionKind(of))
+			}
+			return mg, nil
+		},
+		max: max,
+		log: log,
+	}
+}
+
+type pollIntervalReconciler struct {
+	reconcile.Reconciler
+	kube       client.Reader
+	newManaged func() (resource.Managed, error)
+	max        time.Duration
+	log        logging.Logger
+}
+
+func (r *pollIntervalReconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.Reconciler.Reconcile(ctx, req)
+	// The managed reconciler only requeues after an interval when the
+	// managed resource is polled. All other requeues are rate limited.
+	if err != nil || res.RequeueAfter == 0 {
+		return res, err
+	}
+	mg, err := r.newManaged()
+	if err != nil {
+		return res, nil
+	}
+	if err := r.kube.Get(ctx, req.NamespacedName, mg); err != nil {
+		return res, nil
+	}
+	if d, ok := pollIntervalOf(mg, r.log); ok {
+		res.RequeueAfter = clampPollInterval(d, r.max)
+	}
+	return res, nil
+}
+
+// pollIntervalOf returns the poll interval configured by the
+// AnnotationKeyPollInterval annotation of the supplied managed resource, if
+// it has a valid one.
+func pollIntervalOf(mg resource.Managed, log logging.Logger) (time.Duration, bool) {
+	v, ok := mg.GetAnnotations()[AnnotationKeyPollInterval]
+	if !ok {
+		return 0, false
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Info("Ignoring invalid poll interval", "name", mg.GetName(), "pollInterval", v)
+		return 0, false
+	}
+	return d, true
+}
+
+// clampPollInterval returns the supplied poll interval, limited to
+// MinPollInterval and the supplied maximum, if it is not zero.
+func clampPollInterval(d, max time.Duration) time.Duration {
+	if d < MinPollInterval {
+		d = MinPollInterval
+	}
+	if max > 0 && d > max {
+		d = max
+	}
+	return d
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestReconcilePollInterval(t *testing.T) {
+	errBoom := errors.New("boom")
+	withPollInterval := func(v string) test.MockGetFn {
+		return test.NewMockGetFn(nil, func(obj client.Object) error {
+			obj.SetAnnotations(map[string]string{AnnotationKeyPollInterval: v})
+			return nil
+		})
+	}
+
+	type args struct {
+		result reconcile.Result
+		err    error
+		get    test.MockGetFn
+		max    time.Duration
+	}
+	type want struct {
+		result reconcile.Result
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Override": {
+			reason: "The poll interval of the annotation should be used if the managed resource is polled.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    withPollInterval("1h"),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Hour}},
+		},
+		"AboveMax": {
+			reason: "The poll interval of the annotation should be shortened to the maximum poll interval of the controller.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    withPollInterval("1h"),
+				max:    10 * time.Minute,
+			},
+			want: want{result: reconcile.Result{RequeueAfter: 10 * time.Minute}},
+		},
+		"BelowMin": {
+			reason: "The poll interval of the annotation should be extended to MinPollInterval.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    withPollInterval("1s"),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: MinPollInterval}},
+		},
+		"NoAnnotation": {
+			reason: "The poll interval of the controller should be used if the managed resource has no annotation.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    test.NewMockGetFn(nil),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"InvalidAnnotation": {
+			reason: "Invalid poll intervals should be ignored.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    withPollInterval("often"),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+		"NotPolled": {
+			reason: "Requeues that are not polls should not be changed.",
+			args: args{
+				result: reconcile.Result{Requeue: true},
+				get:    withPollInterval("1h"),
+			},
+			want: want{result: reconcile.Result{Requeue: true}},
+		},
+		"ReconcileFailed": {
+			reason: "Errors of the managed reconciler should be returned.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				err:    errBoom,
+				get:    withPollInterval("1h"),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}, err: errBoom},
+		},
+		"GetFailed": {
+			reason: "The poll interval of the controller should be used if the managed resource cannot be read.",
+			args: args{
+				result: reconcile.Result{RequeueAfter: time.Minute},
+				get:    test.NewMockGetFn(errBoom),
+			},
+			want: want{result: reconcile.Result{RequeueAfter: time.Minute}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &pollIntervalReconciler{
+				Reconciler: reconcile.Func(func(_ context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					return tc.args.result, tc.args.err
+				}),
+				kube:       &test.MockClient{MockGet: tc.args.get},
+				newManaged: func() (resource.Managed, error) { return &fake.Managed{}, nil },
+				max:        tc.args.max,
+				log:        logging.NewNopLogger(),
+			}
+
+			got, err := r.Reconcile(context.TODO(), reconcile.Request{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.result, got); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// TypeReadOnly is the type of the condition that indicates whether the
+// managed resource uses a read-only ProviderConfig.
+const TypeReadOnly xpv1.ConditionType = "ReadOnly"
+
+// Reasons the managed resource is or is not read-only.
+const (
+	ReasonReadOnlyProviderConfig xpv1.ConditionReason = "ReadOnlyProviderConfig"
+	ReasonWritable               xpv1.ConditionReason = "Writable"
+)
+
+const (
+	reasonOrphaned event.Reason = "OrphanedExternalResource"
+
+	errReadOnly = "ProviderConfig %q is read-only: cannot %s the external resource"
+
+	msgReadOnly = "The ProviderConfig is read-only. The external resource is only observed and is orphaned when the managed resource is deleted."
+	msgOrphaned = "The ProviderConfig is read-only. The external resource was not deleted."
+)
+
+// ReadOnly returns a condition that indicates that the managed resource uses
+// a read-only ProviderConfig.
+func ReadOnly() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReadOnly,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonReadOnlyProviderConfig,
+		Message:            msgReadOnly,
+	}
+}
+
+// Writable returns a condition that indicates that the managed resource no
+// longer uses a read-only ProviderConfig.
+func Writable() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeReadOnly,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWritable,
+	}
+}
+
+// observeReadOnly observes the external resource of a managed resource that
+// uses a read-only ProviderConfig. A managed resource that is being deleted
+// is reported as not existing without observing it, so that the managed
+// reconciler removes its finalizer and orphans the external resource. Drift is
+// always only reported, so that the managed reconciler never updates the
+// external resource.
+func (e *external) observeReadOnly(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	mg.SetConditions(ReadOnly())
+	recordRequest(mg)
+	if meta.WasDeleted(mg) {
+		e.record.Event(mg, event.Normal(reasonOrphaned, msgOrphaned))
+		return managed.ExternalObservation{}, nil
+	}
+	obs, err := e.ExternalClient.Observe(ctx, mg)
+	if err != nil || !obs.ResourceExists {
+		return obs, err
+	}
+	return e.handleDrift(mg, obs), nil
+}
+
+// resetReadOnly marks managed resources that used a read-only ProviderConfig
+// before as writable.
+func resetReadOnly(mg resource.Managed) {
+	if mg.GetCondition(TypeReadOnly).Status == corev1.ConditionTrue {
+		mg.SetConditions(Writable())
+	}
+}
@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1beta1"
+)
+
+func TestConnectReadOnly(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		kube client.Client
+		ref  *xpv1.Reference
+	}
+	type want struct {
+		readOnly bool
+		err      error
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"ReadOnly": {
+			reason: "Managed resources that use a read-only ProviderConfig should be read-only.",
+			args: args{
+				kube: &test.MockClient{
+					MockGet: test.NewMockGetFn(nil, func(obj client.Object) error {
+						obj.(*v1beta1.ProviderConfig).Spec.ReadOnly = true
+						return nil
+					}),
+				},
+				ref: &xpv1.Reference{Name: "pc"},
+			},
+			want: want{readOnly: true},
+		},
+		"Writable": {
+			reason: "Managed resources that use a ProviderConfig that is not read-only should not be read-only.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(nil)},
+				ref:  &xpv1.Reference{Name: "pc"},
+			},
+			want: want{readOnly: false},
+		},
+		"NoProviderConfig": {
+			reason: "Managed resources without a ProviderConfig reference should not be read-only.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+			},
+			want: want{readOnly: false},
+		},
+		"GetFailed": {
+			reason: "Errors getting the ProviderConfig should be returned.",
+			args: args{
+				kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)},
+				ref:  &xpv1.Reference{Name: "pc"},
+			},
+			want: want{err: errors.Wrap(errBoom, errGetProviderConfig)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetProviderConfigReference(tc.args.ref)
+			c := &connecter{
+				ExternalConnecter: managed.ExternalConnectorFn(func(_ context.Context, _ resource.Managed) (managed.ExternalClient, error) {
+					return managed.ExternalClientFns{}, nil
+				}),
+				kube: tc.args.kube,
+			}
+
+			ec, err := c.Connect(context.TODO(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nConnect(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.want.readOnly, ec.(*external).readOnly); diff != "" {
+				t.Errorf("\n%s\nConnect(...): -want read-only, +got read-only:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveReadOnly(t *testing.T) {
+	type args struct {
+		annotations map[string]string
+		deleted     bool
+		obs         managed.ExternalObservation
+	}
+	type want struct {
+		obs        managed.ExternalObservation
+		observed   bool
+		conditions []xpv1.Condition
+		events     []event.Event
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"UpToDate": {
+			reason: "The ReadOnly condition should be set if the external resource is up to date.",
+			args: args{
+				obs: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				observed:   true,
+				conditions: []xpv1.Condition{ReadOnly()},
+			},
+		},
+		"Drifted": {
+			reason: "Drift should only be reported, even if the drift action of the managed resource is Correct.",
+			args: args{
+				annotations: map[string]string{AnnotationKeyDriftAction: string(DriftActionCorrect)},
+				obs:         managed.ExternalObservation{ResourceExists: true, Diff: "diff"},
+			},
+			want: want{
+				obs:        managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, Diff: "diff"},
+				observed:   true,
+				conditions: []xpv1.Condition{ReadOnly(), DriftDetected("diff")},
+				events:     []event.Event{event.Warning(reasonDriftDetected, errors.New("diff"))},
+			},
+		},
+		"NotExists": {
+			reason: "External resources that do not exist should be reported as such, so that creating them fails.",
+			args:   args{},
+			want: want{
+				observed:   true,
+				conditions: []xpv1.Condition{ReadOnly()},
+			},
+		},
+		"Deleted": {
+			reason: "The external resource of a deleted managed resource should be orphaned without observing it.",
+			args: args{
+				deleted: true,
+				obs:     managed.ExternalObservation{ResourceExists: true},
+			},
+			want: want{
+				conditions: []xpv1.Condition{ReadOnly()},
+				events:     []event.Event{event.Normal(reasonOrphaned, msgOrphaned)},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &fake.Managed{}
+			mg.SetAnnotations(tc.args.annotations)
+			if tc.args.deleted {
+				now := metav1.Now()
+				mg.SetDeletionTimestamp(&now)
+			}
+			observed := false
+			r := &recorder{}
+			e := &external{
+				ExternalClient: managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						observed = true
+						return tc.args.obs, nil
+					},
+				},
+				record:   r,
+				readOnly: true,
+			}
+
+			obs, err := e.Observe(context.TODO(), mg)
+			if err != nil {
+				t.Errorf("\n%s\nObserve(...): unexpected error: %s", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.obs, obs); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.observed, observed); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observed, +got observed:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, mg.Conditions, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want conditions, +got conditions:\n%s", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.events, r.events); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want events, +got events:\n%s", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWriteReadOnly(t *testing.T) {
+	mg := &fake.Managed{}
+	mg.SetProviderConfigReference(&xpv1.Reference{Name: "pc"})
+	e := &external{ExternalClient: managed.ExternalClientFns{}, readOnly: true}
+
+	if _, err := e.Create(context.TODO(), mg); err == nil || err.Error() != `ProviderConfig "pc" is read-only: cannot create the external resource` {
+		t.Errorf("Create(...): unexpected error: %v", err)
+	}
+	if _, err := e.Update(context.TODO(), mg); err == nil || err.Error() != `ProviderConfig "pc" is read-only: cannot update the external resource` {
+		t.Errorf("Update(...): unexpected error: %v", err)
+	}
+	if err := e.Delete(context.TODO(), mg); err == nil || err.Error() != `ProviderConfig "pc" is read-only: cannot delete the external resource` {
+		t.Errorf("Delete(...): unexpected error: %v", err)
+	}
+}
+
+func TestObserveWritable(t *testing.T) {
+	mg := &fake.Managed{}
+	mg.SetConditions(ReadOnly())
+	e := &external{
+		ExternalClient: managed.ExternalClientFns{
+			ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+				return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}, nil
+			},
+		},
+		record: &recorder{},
+	}
+	if _, err := e.Observe(context.TODO(), mg); err != nil {
+		t.Errorf("Observe(...): unexpected error: %s", err)
+	}
+	if diff := cmp.Diff([]xpv1.Condition{Writable()}, mg.Conditions, test.EquateConditions()); diff != "" {
+		t.Errorf("Observe(...): -want conditions, +got conditions:\n%s", diff)
+	}
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
+)
+
+type reconcileStatusGetter interface {
+	GetReconcileStatus() *v1alpha1.ReconcileStatus
+}
+
+// reconcileStatusOf returns the ReconcileStatus embedded in the status of
+// the supplied managed resource, or nil if it has none.
+func reconcileStatusOf(mg resource.Managed) *v1alpha1.ReconcileStatus {
+	v := reflect.ValueOf(mg)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	s := v.Elem().FieldByName("Status")
+	if !s.IsValid() || !s.CanAddr() {
+		return nil
+	}
+	g, ok := s.Addr().Interface().(reconcileStatusGetter)
+	if !ok {
+		return nil
+	}
+	return g.GetReconcileStatus()
+}
+
+// recordRequest records the request made with the reconcile-requested-at
+// annotation of the supplied managed resource, if any, in its status. The
+// managed reconciler persists the status after every observation, including
+// failed ones. The time is only recorded for new requests, so it is the one
+// of the first observation after the request.
+func recordRequest(mg resource.Managed) {
+	requestedAt, ok := mg.GetAnnotations()[filter.AnnotationKeyReconcileRequestedAt]
+	if !ok {
+		return
+	}
+	s := reconcileStatusOf(mg)
+	if s == nil || (s.LastHandledReconcileAt == requestedAt && s.LastHandledReconcileTime != nil) {
+		return
+	}
+	now := metav1.Now()
+	s.LastHandledReconcileAt = requestedAt
+	s.LastHandledReconcileTime = &now
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/resource/fake"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane-contrib/provider-aws/apis"
+	"github.com/crossplane-contrib/provider-aws/apis/v1alpha1"
+	"github.com/crossplane-contrib/provider-aws/pkg/controller/filter"
+)
+
+// reconcileManaged is a managed resource whose status embeds the
+// ReconcileStatus, like all AWS managed resources.
+type reconcileManaged struct {
+	fake.Managed
+	Status reconcileManagedStatus
+}
+
+type reconcileManagedStatus struct {
+	v1alpha1.ReconcileStatus
+}
+
+func TestObserveReconcileRequest(t *testing.T) {
+	errBoom := errors.New("boom")
+	earlier := metav1.Unix(0, 0)
+
+	type want struct {
+		status    v1alpha1.ReconcileStatus
+		unchanged bool
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason      string
+		annotations map[string]string
+		status      v1alpha1.ReconcileStatus
+		err         error
+		want        want
+	}{
+		"NotRequested": {
+			reason: "Nothing should be recorded if no reconcile was requested.",
+			want:   want{},
+		},
+		"Requested": {
+			reason:      "The requested reconcile should be recorded once the resource was observed.",
+			annotations: map[string]string{filter.AnnotationKeyReconcileRequestedAt: "2"},
+			status:      v1alpha1.ReconcileStatus{LastHandledReconcileAt: "1", LastHandledReconcileTime: &earlier},
+			want:        want{status: v1alpha1.ReconcileStatus{LastHandledReconcileAt: "2"}},
+		},
+		"AlreadyHandled": {
+			reason:      "The time of a handled request should not change.",
+			annotations: map[string]string{filter.AnnotationKeyReconcileRequestedAt: "1"},
+			status:      v1alpha1.ReconcileStatus{LastHandledReconcileAt: "1", LastHandledReconcileTime: &earlier},
+			want: want{
+				status:    v1alpha1.ReconcileStatus{LastHandledReconcileAt: "1"},
+				unchanged: true,
+			},
+		},
+		"ObserveFailed": {
+			reason:      "The requested reconcile should be recorded even if the observation failed.",
+			annotations: map[string]string{filter.AnnotationKeyReconcileRequestedAt: "1"},
+			err:         errBoom,
+			want: want{
+				status: v1alpha1.ReconcileStatus{LastHandledReconcileAt: "1"},
+				err:    errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mg := &reconcileManaged{Status: reconcileManagedStatus{ReconcileStatus: tc.status}}
+			mg.SetAnnotations(tc.annotations)
+
+			e := &external{
+				ExternalClient: managed.ExternalClientFns{
+					ObserveFn: func(_ context.Context, _ resource.Managed) (managed.ExternalObservation, error) {
+						return managed.ExternalObservation{}, tc.err
+					},
+				},
+				record: event.NewNopRecorder(),
+			}
+			_, err := e.Observe(context.TODO(), mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s", tc.reason, diff)
+			}
+			got := mg.Status.ReconcileStatus
+			if diff := cmp.Diff(tc.want.status, got, cmpopts.IgnoreFields(v1alpha1.ReconcileStatus{}, "LastHandledReconcileTime")); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want status, +got status:\n%s", tc.reason, diff)
+			}
+			if tc.want.status.LastHandledReconcileAt != "" && got.LastHandledReconcileTime == nil {
+				t.Errorf("\n%s\nObserve(...): want handled time, got none", tc.reason)
+			}
+			if got.LastHandledReconcileTime != nil && tc.want.unchanged != got.LastHandledReconcileTime.Equal(&earlier) {
+				t.Errorf("\n%s\nObserve(...): want unchanged handled time %t, got %s", tc.reason, tc.want.unchanged, got.LastHandledReconcileTime)
+			}
+		})
+	}
+}
+
+func TestReconcileStatusOf(t *testing.T) {
+	if s := reconcileStatusOf(&fake.Managed{}); s != nil {
+		t.Errorf("reconcileStatusOf(...): want nil for resources without a ReconcileStatus, got %v", s)
+	}
+	mg := &reconcileManaged{}
+	if s := reconcileStatusOf(mg); s != &mg.Status.ReconcileStatus {
+		t.Errorf("reconcileStatusOf(...): want the embedded ReconcileStatus, got %v", s)
+	}
+}
+
+func TestAllManagedHaveReconcileStatus(t *testing.T) {
+	s := runtime.NewScheme()
+	if err := apis.AddToScheme(s); err != nil {
+		t.Fatalf("AddToScheme(...): %s", err)
+	}
+	for gvk := range s.AllKnownTypes() {
+		o, err := s.New(gvk)
+		if err != nil {
+			t.Fatalf("New(%s): %s", gvk, err)
+		}
+		mg, ok := o.(resource.Managed)
+		if !ok {
+			continue
+		}
+		if reconcileStatusOf(mg) == nil {
+			t.Errorf("%s: the status of the managed resource does not embed the ReconcileStatus", gvk)
+		}
+	}
+}